@@ -0,0 +1,154 @@
+/* Copyright 2021 The Tiyo authors
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+/**
+ * User, group and permission administration
+ */
+class Admin
+{
+    constructor()
+    {
+        this.templates = {};
+        ['users', 'groups', 'permissions'].forEach((name) => {
+            this.templates[name] = Handlebars.compile($('#' + name + 'tpl').html());
+        });
+    }
+
+    request(method, url, data, callback)
+    {
+        $.ajax({
+            url: url,
+            type: method,
+            contentType: 'application/json; charset=utf-8',
+            dataType: 'json',
+            data: typeof(data) === 'undefined' ? undefined : JSON.stringify(data),
+            success: callback,
+        }).fail((error) => {
+            var message = error.responseJSON ? error.responseJSON.message : error.statusText;
+            $('#admin-message p').text(message);
+            $('#admin-message').show();
+        });
+    }
+
+    list(name)
+    {
+        this.request('GET', '/api/v1/' + name, undefined, (data) => {
+            $('#' + name).html(this.templates[name]({list: data.message}));
+        });
+    }
+
+    load()
+    {
+        $('#admin-message').hide();
+        ['users', 'groups', 'permissions'].forEach((name) => this.list(name));
+    }
+
+    names(value)
+    {
+        return value.split(',').map((v) => v.trim()).filter((v) => v !== '');
+    }
+
+    invite()
+    {
+        this.request('POST', '/api/v1/users', {
+            email: $('#invite-email').val().trim(),
+            groups: this.names($('#invite-groups').val()),
+        }, (data) => {
+            $('#invite-link p').text('Send this link to the user: ' + window.location.origin + data.message.invite);
+            $('#invite-link').show();
+            $('#invite-email').val('');
+            this.load();
+        });
+    }
+
+    assignGroups(id)
+    {
+        var groups = prompt('Groups, comma separated');
+        if (groups === null) {
+            return;
+        }
+        this.request('PUT', '/api/v1/users/' + id + '/groups', {groups: this.names(groups)}, () => this.load());
+    }
+
+    changePassword(id)
+    {
+        var password = prompt('New password');
+        if (password === null) {
+            return;
+        }
+        this.request('PUT', '/api/v1/users/' + id + '/password', {password: password}, () => this.load());
+    }
+
+    resetTotp(email)
+    {
+        if (!confirm('Clear the second factor of ' + email + '? They will set up a new one at their next sign in.')) {
+            return;
+        }
+        this.request('POST', '/api/v1/totp/reset', {email: email}, () => this.load());
+    }
+
+    unlock(id)
+    {
+        this.request('PUT', '/api/v1/users/' + id + '/unlock', undefined, () => this.load());
+    }
+
+    revokeSessions(id)
+    {
+        if (confirm('Sign the user out of every session?')) {
+            this.request('DELETE', '/api/v1/users/' + id + '/sessions', undefined, () => this.load());
+        }
+    }
+
+    setDisabled(id, disabled)
+    {
+        this.request('PUT', '/api/v1/users/' + id + '/disabled', {disabled: disabled}, () => this.load());
+    }
+
+    deleteUser(id)
+    {
+        if (confirm('Delete user?')) {
+            this.request('DELETE', '/api/v1/users/' + id, undefined, () => this.load());
+        }
+    }
+
+    createGroup()
+    {
+        this.request('POST', '/api/v1/groups', {
+            name: $('#group-name').val().trim(),
+            permissions: this.names($('#group-permissions').val()),
+        }, () => {
+            $('#group-name').val('');
+            $('#group-permissions').val('');
+            this.load();
+        });
+    }
+
+    deleteGroup(name)
+    {
+        if (confirm('Delete group ' + name + '?')) {
+            this.request('DELETE', '/api/v1/groups/' + encodeURIComponent(name), undefined, () => this.load());
+        }
+    }
+
+    createPermission()
+    {
+        this.request('POST', '/api/v1/permissions', {name: $('#permission-name').val().trim()}, () => {
+            $('#permission-name').val('');
+            this.load();
+        });
+    }
+
+    deletePermission(name)
+    {
+        if (confirm('Delete permission ' + name + '?')) {
+            this.request('DELETE', '/api/v1/permissions/' + encodeURIComponent(name), undefined, () => this.load());
+        }
+    }
+}
+
+var admin = new Admin();
+$(document).ready(() => admin.load());
@@ -157,7 +157,7 @@ function put(b, c, k, v) {
         v = $('#value').val();
     }
 
-    $.put(
+    return $.put(
         "/api/v1/bucket",
         JSON.stringify({ bucket: b, child: c, key: k, value: v })
     );
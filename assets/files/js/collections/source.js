@@ -15,6 +15,14 @@ class Source {
         '      <td><label for="sourcename">Name</label></td>'+
         '      <td><input id="sourcename" value="" /></td>'+
         '    </tr>'+
+        '    <tr class="nats">'+
+        '      <td><label for="sourcesubject">Subject</label></td>'+
+        '      <td><input id="sourcesubject" value="" /></td>'+
+        '    </tr>'+
+        '    <tr class="nats">'+
+        '      <td><label for="sourceserver">Server</label></td>'+
+        '      <td><input id="sourceserver" value="" placeholder="default" /></td>'+
+        '    </tr>'+
         '  </table>'+
         '  <div style="float: right;">'+
         '    <a class="uk-button-small cancel">cancel</a>'+
@@ -60,6 +68,9 @@ class Source {
     attributes(view, event, x, y) {
         var element = $('.sourceProperties');
         $('#sourcename').val(view.model.attributes.name);
+        $('#sourcesubject').val(view.model.attributes.subject);
+        $('#sourceserver').val(view.model.attributes.server);
+        element.find('.nats').toggle(view.model.attributes.sourcetype == 'nats');
         element.css({
             "position": "absolute",
             "display": "block",
@@ -70,6 +81,10 @@ class Source {
         element.find('.done').click((e) => {
             view.model.attributes.name = $('#sourcename').val();
             view.model.attr()['.label'].text = $('#sourcename').val();
+            if (view.model.attributes.sourcetype == 'nats') {
+                view.model.attributes.subject = $('#sourcesubject').val();
+                view.model.attributes.server = $('#sourceserver').val();
+            }
 
             element.css({
                 "display": "none",
@@ -104,6 +119,8 @@ joint.shapes.container.Source = joint.shapes.devs.Model.extend({
 
         name: "",
         sourcetype: "",
+        subject: "",
+        server: "",
         position: { x: 50, y: 50 },
         size: { width: 50, height: 50 },
         inPorts: ['a', 'b', 'c'],
@@ -229,6 +229,10 @@ function loadMenu() {
                     case "credentials":
                         openCredentialsPopup(pipeline.graph);
                         break;
+                    case "samples":
+                        pipeline.appelement = pipeline.graph;
+                        pipeline.showSamplePattern();
+                        break;
                 }
                 break;
         }
@@ -90,8 +90,11 @@ class Pipeline {
 
         var title = $('.editable.pipelinetitle').text();
         if (title != "Untitled" && this.graph.toJSON().cells.length > 0) {
-            put('pipeline', null, title, btoa(JSON.stringify(this.graph.toJSON())));
-            createFileStore(title);
+            // the file store may only be created once the pipeline is saved
+            // and its owner recorded
+            put('pipeline', null, title, btoa(JSON.stringify(this.graph.toJSON()))).done(
+                () => createFileStore(title)
+            );
             Cookies.set('pipeline', title);
             this.pipeline = title;
             success("Pipeline saved");
@@ -805,6 +808,22 @@ class Pipeline {
         // Not implemented
     }
 
+    /**
+     * Set the regular expression used to group files into samples
+     */
+    showSamplePattern() {
+        var attributes = this.appelement.attributes;
+        UIkit.modal.prompt(
+            'Sample pattern (the first capture group names the sample):',
+            attributes.samplepattern || '^([^_.]+)',
+            {stack: true}
+        ).then(function(pattern) {
+            if (pattern !== null) {
+                attributes.samplepattern = pattern.trim();
+            }
+        });
+    }
+
     /**
      * Cancel environment editing
      */
@@ -0,0 +1,90 @@
+[[template "header.html" .]]
+<div class="uk-section uk-section-muted">
+    <div class="uk-container">
+        <h2><a href="/">Tiyo</a> - Users and groups</h2>
+        <div id="admin-message" class="uk-alert-danger" uk-alert hidden><p></p></div>
+
+        <div class="uk-card uk-card-default uk-card-body uk-margin">
+            <h3 class="uk-card-title">Users</h3>
+            <table class="uk-table uk-table-divider uk-table-small">
+                <thead>
+                    <tr><th>Email</th><th>Groups</th><th>Status</th><th></th></tr>
+                </thead>
+                <tbody id="users"></tbody>
+            </table>
+            <form class="uk-grid-small" uk-grid onsubmit="admin.invite(); return false;">
+                <div class="uk-width-1-2@s"><input class="uk-input" id="invite-email" type="text" placeholder="Email"></div>
+                <div class="uk-width-1-4@s"><input class="uk-input" id="invite-groups" type="text" placeholder="Groups, comma separated"></div>
+                <div class="uk-width-1-4@s"><button class="uk-button uk-button-primary uk-width-1-1">Invite</button></div>
+            </form>
+            <div id="invite-link" class="uk-alert-success uk-margin" uk-alert hidden><p></p></div>
+        </div>
+
+        <div class="uk-card uk-card-default uk-card-body uk-margin">
+            <h3 class="uk-card-title">Groups</h3>
+            <table class="uk-table uk-table-divider uk-table-small">
+                <thead>
+                    <tr><th>Name</th><th>Permissions</th><th></th></tr>
+                </thead>
+                <tbody id="groups"></tbody>
+            </table>
+            <form class="uk-grid-small" uk-grid onsubmit="admin.createGroup(); return false;">
+                <div class="uk-width-1-2@s"><input class="uk-input" id="group-name" type="text" placeholder="Name"></div>
+                <div class="uk-width-1-4@s"><input class="uk-input" id="group-permissions" type="text" placeholder="Permissions, comma separated"></div>
+                <div class="uk-width-1-4@s"><button class="uk-button uk-button-primary uk-width-1-1">Save group</button></div>
+            </form>
+        </div>
+
+        <div class="uk-card uk-card-default uk-card-body uk-margin">
+            <h3 class="uk-card-title">Permissions</h3>
+            <table class="uk-table uk-table-divider uk-table-small">
+                <tbody id="permissions"></tbody>
+            </table>
+            <form class="uk-grid-small" uk-grid onsubmit="admin.createPermission(); return false;">
+                <div class="uk-width-3-4@s"><input class="uk-input" id="permission-name" type="text" placeholder="Name"></div>
+                <div class="uk-width-1-4@s"><button class="uk-button uk-button-primary uk-width-1-1">Create permission</button></div>
+            </form>
+        </div>
+    </div>
+</div>
+
+<script id="userstpl" type="x-tmpl-mustache">
+{{#each list}}
+<tr>
+    <td>{{email}}</td>
+    <td>{{#each groups}}<span class="uk-label">{{.}}</span> {{/each}}</td>
+    <td>{{#if disabled}}Disabled{{else}}{{#if locked}}Locked{{else}}{{#if invited}}Invited{{else}}Active{{/if}}{{/if}}{{/if}}{{#unless totp}} (no second factor){{/unless}}</td>
+    <td class="uk-text-right">
+        <a onclick="admin.assignGroups('{{id}}')" uk-tooltip="Groups" uk-icon="users"></a>
+        <a onclick="admin.changePassword('{{id}}')" uk-tooltip="Password" uk-icon="lock"></a>
+        {{#if email}}<a onclick="admin.resetTotp('{{email}}')" uk-tooltip="Reset second factor" uk-icon="phone"></a>{{/if}}
+        {{#if locked}}<a onclick="admin.unlock('{{id}}')" uk-tooltip="Unlock" uk-icon="unlock"></a>{{/if}}
+        <a onclick="admin.revokeSessions('{{id}}')" uk-tooltip="Sign out everywhere" uk-icon="sign-out"></a>
+        <a onclick="admin.setDisabled('{{id}}', {{#if disabled}}false{{else}}true{{/if}})" uk-tooltip="{{#if disabled}}Enable{{else}}Disable{{/if}}" uk-icon="{{#if disabled}}play{{else}}ban{{/if}}"></a>
+        <a onclick="admin.deleteUser('{{id}}')" uk-tooltip="Delete" uk-icon="trash"></a>
+    </td>
+</tr>
+{{/each}}
+</script>
+
+<script id="groupstpl" type="x-tmpl-mustache">
+{{#each list}}
+<tr>
+    <td>{{name}}</td>
+    <td>{{#each permissions}}<span class="uk-label">{{permission}}</span> {{/each}}</td>
+    <td class="uk-text-right"><a onclick="admin.deleteGroup('{{name}}')" uk-icon="trash"></a></td>
+</tr>
+{{/each}}
+</script>
+
+<script id="permissionstpl" type="x-tmpl-mustache">
+{{#each list}}
+<tr>
+    <td>{{permission}}</td>
+    <td class="uk-text-right"><a onclick="admin.deletePermission('{{permission}}')" uk-icon="trash"></a></td>
+</tr>
+{{/each}}
+</script>
+
+<script src="/static/js/admin.js"></script>
+</html>
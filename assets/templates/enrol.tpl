@@ -0,0 +1,41 @@
+[[template "header.html" .]]
+<div class="uk-section uk-section-muted uk-flex uk-flex-middle uk-animation-fade" uk-height-viewport>
+    <div class="uk-width-1-1">
+        <div class="uk-container">
+            <div class="uk-grid-margin uk-grid uk-grid-stack" uk-grid>
+                <div class="uk-width-1-1@m">
+                    <div class="uk-margin uk-width-large uk-margin-auto uk-card uk-card-default uk-card-body uk-box-shadow-large">
+                        <h3 class="uk-card-title uk-text-center">Tiyo</h3>
+                        [[if .Recovery]]
+                        <p>Two factor authentication is set up. Keep these recovery codes somewhere safe. Each may be used once in place of a code if you lose your authenticator.</p>
+                        <ul class="uk-list uk-list-divider uk-text-center">
+                            [[range .Recovery]]<li><code>[[.]]</code></li>[[end]]
+                        </ul>
+                        <div class="uk-margin">
+                            <a class="uk-button uk-button-primary uk-button-large uk-width-1-1" href="/">Continue</a>
+                        </div>
+                        [[else]]
+                        <p>Scan this code with your authenticator app, then enter the code it shows to finish signing in.</p>
+                        <div class="uk-text-center">
+                            <img src="[[.QRCode]]" alt="[[.URL]]" width="200" height="200">
+                        </div>
+                        <p class="uk-text-small uk-text-center">Or enter the key <code>[[.Secret]]</code></p>
+                        <form action="/enrol" method="POST">
+                            <div class="uk-margin">
+                                <div class="uk-inline uk-width-1-1">
+                                    <span class="uk-form-icon" uk-icon="icon: phone"></span>
+                                    <input class="uk-input uk-form-large" type="text" name="otp" autocomplete="one-time-code">
+                                </div>
+                            </div>
+                            <div class="uk-margin">
+                                <button class="uk-button uk-button-primary uk-button-large uk-width-1-1">Confirm</button>
+                            </div>
+                        </form>
+                        [[end]]
+                    </div>
+                </div>
+            </div>
+        </div>
+    </div>
+</div>
+[[template "footer.html" .]]
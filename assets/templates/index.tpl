@@ -22,6 +22,7 @@
                             <ul class="uk-nav uk-navbar-dropdown-nav edit">
                                 <li><a><span data-uk-icon="icon: file"></span> Environment</a></li>
                                 <li><a><span data-uk-icon="icon: file-edit"></span> Credentials</a></li>
+                                <li><a><span data-uk-icon="icon: grid"></span> Samples</a></li>
                             </ul>
                         </div>
                     </li>
@@ -58,6 +59,9 @@
             <li>
                 <a href="/buckets">Buckets</a>
             </li>
+            <li>
+                <a href="/admin">Users and groups</a>
+            </li>
         </ul>
     </nav>
     <ul uk-accordion="multiple: true; collapsible: false;">
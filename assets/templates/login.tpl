@@ -6,6 +6,9 @@
                 <div class="uk-width-1-1@m">
                     <div class="uk-margin uk-width-large uk-margin-auto uk-card uk-card-default uk-card-body uk-box-shadow-large">
                         <h3 class="uk-card-title uk-text-center">Tiyo</h3>
+                        [[if .Error]]
+                        <div class="uk-alert-danger" uk-alert><p>[[.Error]]</p></div>
+                        [[end]]
                         <form action="/login" method="POST">
                             <div class="uk-margin">
                                 <div class="uk-inline uk-width-1-1">
@@ -19,10 +22,21 @@
                                     <input class="uk-input uk-form-large" type="password" name="password">
                                 </div>
                             </div>
+                            <div class="uk-margin">
+                                <div class="uk-inline uk-width-1-1">
+                                    <span class="uk-form-icon" uk-icon="icon: phone"></span>
+                                    <input class="uk-input uk-form-large" type="text" name="otp" autocomplete="one-time-code" placeholder="Authenticator or recovery code">
+                                </div>
+                            </div>
                             <div class="uk-margin">
                                 <button class="uk-button uk-button-primary uk-button-large uk-width-1-1">Login</button>
                             </div>
                         </form>
+                        [[if .OIDC]]
+                        <div class="uk-margin">
+                            <a class="uk-button uk-button-default uk-button-large uk-width-1-1" href="/oidc/login">Sign in with single sign on</a>
+                        </div>
+                        [[end]]
                     </div>
                 </div>
             </div>
@@ -7,6 +7,7 @@ replace github.com/coreos/go-systemd => github.com/coreos/go-systemd/v22 v22.1.0
 require (
 	github.com/boltdb/bolt v1.3.1
 	github.com/containerd/containerd v1.5.8 // indirect
+	github.com/coreos/go-oidc/v3 v3.1.0
 	github.com/coreos/go-systemd v0.0.0-20190321100706-95778dfbb74e
 	github.com/crewjam/saml v0.4.6
 	github.com/docker/docker v20.10.1+incompatible
@@ -19,14 +20,27 @@ require (
 	github.com/go-git/go-git/v5 v5.11.0
 	github.com/google/uuid v1.3.0
 	github.com/gorilla/mux v1.8.0 // indirect
+	github.com/gorilla/securecookie v1.1.1
+	github.com/gorilla/sessions v1.2.0
 	github.com/moby/term v0.0.0-20201216013528-df9cb8a40635 // indirect
 	github.com/morikuni/aec v1.0.0 // indirect
+	github.com/nats-io/nats-server/v2 v2.2.6
+	github.com/nats-io/nats.go v1.11.0
 	github.com/opencontainers/image-spec v1.0.2 // indirect
 	github.com/pquerna/otp v1.3.0
+	github.com/prometheus/client_golang v1.11.0
 	github.com/rjeczalik/notify v0.9.2
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/sirupsen/logrus v1.9.0
+	go.opentelemetry.io/otel v1.11.0
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.11.0
+	go.opentelemetry.io/otel/sdk v1.11.0
+	go.opentelemetry.io/otel/trace v1.11.0
 	golang.org/x/crypto v0.16.0
+	golang.org/x/oauth2 v0.0.0-20211104180415-d3ed0bb246c8
+	gopkg.in/natefinch/lumberjack.v2 v2.0.0
 	k8s.io/api v0.20.6
 	k8s.io/apimachinery v0.20.6
 	k8s.io/client-go v0.20.6
+	k8s.io/klog/v2 v2.80.1 // indirect
 )
@@ -10,15 +10,8 @@ import (
 	"os"
 
 	"github.com/notapipeline/tiyo/pkg/command"
-	log "github.com/sirupsen/logrus"
 )
 
 func main() {
-	log.SetFormatter(&log.TextFormatter{
-		DisableColors:   true,
-		FullTimestamp:   true,
-		TimestampFormat: "2006-01-02 15:04:05",
-	})
-
 	os.Exit(command.Run(os.Args[1:]))
 }
@@ -0,0 +1,378 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/notapipeline/tiyo/pkg/config"
+)
+
+// Assemble : A client for the assemble API
+type Assemble struct {
+	*Client
+}
+
+// ScanResult : The contents of a bucket
+type ScanResult struct {
+
+	// The child buckets of the scanned bucket
+	Buckets []string `json:"buckets"`
+
+	// The key/value pairs held in the scanned bucket
+	Keys map[string]string `json:"keys"`
+}
+
+// NewAssemble : Create a client for the assemble server named in the config
+func NewAssemble(c *config.Config, options ...Option) *Assemble {
+	return &Assemble{
+		Client: newClient(c, c.AssembleServer(), c.Assemble.Cacert, options...),
+	}
+}
+
+// Buckets : List the top level buckets
+func (assemble *Assemble) Buckets(ctx context.Context) ([]string, error) {
+	buckets := make([]string, 0)
+	_, err := assemble.call(ctx, http.MethodGet, "/bucket", nil, &buckets)
+	return buckets, err
+}
+
+// CreateBucket : Create a bucket, or a child of bucket if child is not empty
+func (assemble *Assemble) CreateBucket(ctx context.Context, bucket string, child string) error {
+	_, err := assemble.call(ctx, http.MethodPost, "/bucket", map[string]string{
+		"bucket": bucket,
+		"child":  child,
+	}, nil)
+	return err
+}
+
+// DeleteBucket : Delete a bucket, or a child of bucket if child is not empty
+func (assemble *Assemble) DeleteBucket(ctx context.Context, bucket string, child string) error {
+	_, err := assemble.call(ctx, http.MethodDelete, "/bucket"+escape(bucket, child), nil, nil)
+	return err
+}
+
+// Get : Get the value held against a key
+func (assemble *Assemble) Get(ctx context.Context, bucket string, child string, key string) (string, error) {
+	var value string
+	_, err := assemble.call(ctx, http.MethodGet, "/bucket"+escape(bucket, child, key), nil, &value)
+	return value, err
+}
+
+// Put : Store a value against a key
+func (assemble *Assemble) Put(ctx context.Context, bucket string, child string, key string, value string) error {
+	_, err := assemble.call(ctx, http.MethodPut, "/bucket", map[string]string{
+		"bucket": bucket,
+		"child":  child,
+		"key":    key,
+		"value":  value,
+	}, nil)
+	return err
+}
+
+// DeleteKey : Delete a key
+func (assemble *Assemble) DeleteKey(ctx context.Context, bucket string, child string, key string) error {
+	_, err := assemble.call(ctx, http.MethodDelete, "/bucket"+escape(bucket, child, key), nil, nil)
+	return err
+}
+
+// Scan : Get the contents of a bucket with keys beginning with prefix
+func (assemble *Assemble) Scan(ctx context.Context, bucket string, child string, prefix string) (*ScanResult, error) {
+	result := ScanResult{}
+	if _, err := assemble.call(ctx, http.MethodGet, "/scan"+escape(bucket, child, prefix), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Count : Count the keys in a bucket
+func (assemble *Assemble) Count(ctx context.Context, bucket string, child string) (int, error) {
+	var count int
+	_, err := assemble.call(ctx, http.MethodGet, "/count"+escape(bucket, child), nil, &count)
+	return count, err
+}
+
+// Containers : List the containers available to the sidebar
+func (assemble *Assemble) Containers(ctx context.Context) ([]string, error) {
+	containers := make([]string, 0)
+	_, err := assemble.call(ctx, http.MethodGet, "/containers", nil, &containers)
+	return containers, err
+}
+
+// PopQueue : Take the next item for a pod off the queue
+//
+// key is `container:version:hostname`. item is usually an *api.QueueItem
+// and is left untouched if the queue is empty, in which case the status
+// code is 202.
+func (assemble *Assemble) PopQueue(ctx context.Context, pipelineName string, key string, item interface{}) (int, error) {
+	code, content, err := assemble.send(ctx, http.MethodGet, "/popqueue"+escape(pipelineName, key), nil)
+	if err != nil {
+		return code, err
+	}
+	if code >= http.StatusBadRequest {
+		return code, failure(code, content)
+	}
+	if code != http.StatusOK {
+		return code, nil
+	}
+	return code, decode(content, item)
+}
+
+// PerpetualQueue : Move up to maxItems waiting files and events onto the queue
+func (assemble *Assemble) PerpetualQueue(ctx context.Context, pipelineName string, maxItems int) error {
+	_, err := assemble.call(ctx, http.MethodPost, "/perpetualqueue", map[string]interface{}{
+		"pipeline": pipelineName,
+		"maxitems": maxItems,
+	}, nil)
+	return err
+}
+
+// Running : List the executing items of a pipeline
+//
+// items is usually a *[]api.RunningItem
+func (assemble *Assemble) Running(ctx context.Context, pipelineName string, items interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/running"+escape(pipelineName), nil, items)
+	return err
+}
+
+// GetRunning : Get a single executing item
+//
+// item is usually an *api.RunningItem. Returns an *Error with code 404 if
+// the item is no longer running.
+func (assemble *Assemble) GetRunning(ctx context.Context, pipelineName string, id string, item interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/running"+escape(pipelineName, id), nil, item)
+	return err
+}
+
+// Cancel : Cancel an executing item
+func (assemble *Assemble) Cancel(ctx context.Context, pipelineName string, id string) error {
+	_, err := assemble.call(ctx, http.MethodPost, "/cancel", map[string]string{
+		"pipeline": pipelineName,
+		"id":       id,
+	}, nil)
+	return err
+}
+
+// Complete : Record the final status of a queue item
+//
+// request is usually an *api.CompleteRequest
+func (assemble *Assemble) Complete(ctx context.Context, request interface{}) (int, error) {
+	return assemble.call(ctx, http.MethodPost, "/complete", request, nil)
+}
+
+// Submit : Push an explicit list of inputs through a pipeline
+//
+// request is usually an *api.SubmitRequest and submission an *api.Submission
+func (assemble *Assemble) Submit(ctx context.Context, request interface{}, submission interface{}) error {
+	_, err := assemble.call(ctx, http.MethodPost, "/submit", request, submission)
+	return err
+}
+
+// GetSubmission : Get a submission and the state of its inputs
+func (assemble *Assemble) GetSubmission(ctx context.Context, pipelineName string, id string, submission interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/submission"+escape(pipelineName, id), nil, submission)
+	return err
+}
+
+// PostEvent : Queue an event against a pipeline
+//
+// If source is not empty, the event is routed to the commands linked to
+// that source. payload must encode to JSON. Returns the ID of the event.
+func (assemble *Assemble) PostEvent(ctx context.Context, pipelineName string, source string, payload interface{}) (string, error) {
+	var (
+		path string = "/events" + escape(pipelineName)
+		id   string
+	)
+	if source != "" {
+		path += "?source=" + url.QueryEscape(source)
+	}
+	_, err := assemble.call(ctx, http.MethodPost, path, payload, &id)
+	return id, err
+}
+
+// GetEvent : Get an event and its state
+//
+// event is usually an *api.Event
+func (assemble *Assemble) GetEvent(ctx context.Context, pipelineName string, id string, event interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/events"+escape(pipelineName, id), nil, event)
+	return err
+}
+
+// PostLogs : Store a batch of command output
+//
+// request is usually an *api.LogRequest
+func (assemble *Assemble) PostLogs(ctx context.Context, request interface{}) (int, error) {
+	return assemble.call(ctx, http.MethodPost, "/logs", request, nil)
+}
+
+// Logs : List the logs held for a pipeline
+func (assemble *Assemble) Logs(ctx context.Context, pipelineName string, logs interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/logs"+escape(pipelineName), nil, logs)
+	return err
+}
+
+// GetLog : Get the output of a single queue item
+func (assemble *Assemble) GetLog(ctx context.Context, pipelineName string, id string, log interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/logs"+escape(pipelineName, id), nil, log)
+	return err
+}
+
+// Stats : Get resource usage statistics for a pipeline, or a single command if command is not empty
+func (assemble *Assemble) Stats(ctx context.Context, pipelineName string, command string, stats interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/stats"+escape(pipelineName, command), nil, stats)
+	return err
+}
+
+// Analytics : Get queue and throughput analytics for a pipeline
+func (assemble *Assemble) Analytics(ctx context.Context, pipelineName string, analytics interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/analytics"+escape(pipelineName), nil, analytics)
+	return err
+}
+
+// Samples : Get the progress of every sample in a pipeline
+func (assemble *Assemble) Samples(ctx context.Context, pipelineName string, samples interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/samples"+escape(pipelineName), nil, samples)
+	return err
+}
+
+// GetSample : Get the progress of a single sample
+func (assemble *Assemble) GetSample(ctx context.Context, pipelineName string, sample string, out interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/samples"+escape(pipelineName, sample), nil, out)
+	return err
+}
+
+// Schedules : List the schedules of a pipeline
+func (assemble *Assemble) Schedules(ctx context.Context, pipelineName string, schedules interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/schedules"+escape(pipelineName), nil, schedules)
+	return err
+}
+
+// SaveSchedule : Create or update a schedule
+//
+// schedule is usually an *api.Schedule and is updated with the stored schedule
+func (assemble *Assemble) SaveSchedule(ctx context.Context, pipelineName string, schedule interface{}) error {
+	_, err := assemble.call(ctx, http.MethodPost, "/schedules"+escape(pipelineName), schedule, schedule)
+	return err
+}
+
+// DeleteSchedule : Delete a schedule
+func (assemble *Assemble) DeleteSchedule(ctx context.Context, pipelineName string, id string) error {
+	_, err := assemble.call(ctx, http.MethodDelete, "/schedules"+escape(pipelineName, id), nil, nil)
+	return err
+}
+
+// PauseSchedule : Stop a schedule from triggering
+func (assemble *Assemble) PauseSchedule(ctx context.Context, pipelineName string, id string) error {
+	_, err := assemble.call(ctx, http.MethodPost, "/schedules"+escape(pipelineName, id, "pause"), nil, nil)
+	return err
+}
+
+// ResumeSchedule : Allow a paused schedule to trigger
+func (assemble *Assemble) ResumeSchedule(ctx context.Context, pipelineName string, id string) error {
+	_, err := assemble.call(ctx, http.MethodPost, "/schedules"+escape(pipelineName, id, "resume"), nil, nil)
+	return err
+}
+
+// TriggerSchedule : Trigger a schedule immediately
+func (assemble *Assemble) TriggerSchedule(ctx context.Context, pipelineName string, id string) error {
+	_, err := assemble.call(ctx, http.MethodPost, "/schedules"+escape(pipelineName, id, "trigger"), nil, nil)
+	return err
+}
+
+// Status : Get the status of the infrastructure of a pipeline
+func (assemble *Assemble) Status(ctx context.Context, pipelineName string) (*Result, error) {
+	return assemble.result(ctx, http.MethodGet, "/status"+escape(pipelineName), nil)
+}
+
+// Execute : Build the infrastructure for a pipeline and start its queue
+func (assemble *Assemble) Execute(ctx context.Context, pipelineName string) (*Result, error) {
+	return assemble.result(ctx, http.MethodPost, "/execute", map[string]string{"pipeline": pipelineName})
+}
+
+// StartFlow : Start the queue of a pipeline
+func (assemble *Assemble) StartFlow(ctx context.Context, pipelineName string) (*Result, error) {
+	return assemble.result(ctx, http.MethodPost, "/startflow", map[string]string{"pipeline": pipelineName})
+}
+
+// StopFlow : Stop the queue of a pipeline
+func (assemble *Assemble) StopFlow(ctx context.Context, pipelineName string) (*Result, error) {
+	return assemble.result(ctx, http.MethodPost, "/stopflow", map[string]string{"pipeline": pipelineName})
+}
+
+// DestroyFlow : Destroy the infrastructure of a pipeline
+func (assemble *Assemble) DestroyFlow(ctx context.Context, pipelineName string) (*Result, error) {
+	return assemble.result(ctx, http.MethodPost, "/destroyflow", map[string]string{"pipeline": pipelineName})
+}
+
+// Encrypt : Encrypt a value with the assemble passphrase
+func (assemble *Assemble) Encrypt(ctx context.Context, value string) (string, error) {
+	var encrypted string
+	_, err := assemble.call(ctx, http.MethodPost, "/encrypt", map[string]string{"value": value}, &encrypted)
+	return encrypted, err
+}
+
+// Decrypt : Decrypt a value encrypted by assemble
+//
+// token is the encrypted passphrase held by flow. Hosts signing requests
+// with a machine token use the machine endpoint, which does not require
+// the admin scope.
+func (assemble *Assemble) Decrypt(ctx context.Context, value string, token string) (string, error) {
+	var (
+		decrypted string
+		path      string = "/decrypt"
+	)
+	if assemble.token == "" && assemble.machine() != "" {
+		path = "/machine/decrypt"
+	}
+	_, err := assemble.call(ctx, http.MethodPost, path, map[string]string{
+		"value": value,
+		"token": token,
+	}, &decrypted)
+	return decrypted, err
+}
+
+// MachineToken : A machine token issued to a host by assemble
+type MachineToken struct {
+
+	// The address the token is valid for
+	Address string `json:"address"`
+
+	// The token the host signs requests with
+	Token string `json:"token"`
+
+	// When the token stops working
+	Expires time.Time `json:"expires"`
+}
+
+// EnrolMachine : Issue a machine token to the host at address
+//
+// address may be empty to enrol the host making the request. Requires a
+// token granted the admin scope.
+func (assemble *Assemble) EnrolMachine(ctx context.Context, address string) (*MachineToken, error) {
+	token := MachineToken{}
+	_, err := assemble.call(ctx, http.MethodPost, "/machines", map[string]string{"address": address}, &token)
+	return &token, err
+}
+
+// RotateMachine : Replace the machine token the client signs requests with
+//
+// The current token keeps working for a while so requests already signed
+// with it are not refused.
+func (assemble *Assemble) RotateMachine(ctx context.Context) (*MachineToken, error) {
+	token := MachineToken{}
+	_, err := assemble.call(ctx, http.MethodPost, "/machine/rotate", nil, &token)
+	return &token, err
+}
+
+// Machine : Get the machine token the client signs requests with
+func (assemble *Assemble) Machine(ctx context.Context, machine interface{}) error {
+	_, err := assemble.call(ctx, http.MethodGet, "/machine", nil, machine)
+	return err
+}
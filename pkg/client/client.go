@@ -0,0 +1,369 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package client : Go client for the assemble and flow APIs
+//
+// Every call between tiyo components is made through this package. Each
+// client carries its own TLS configuration, so `skipVerify` and the
+// certificates named in the config apply without changing
+// http.DefaultTransport, and every request carries the trace context and
+// request ID held in its context. The token configured for the running
+// command, or TIYO_TOKEN, is sent as a bearer token. Hosts without a token
+// which have been enrolled with `tiyo machine` sign each request with
+// their machine token instead.
+//
+// Requests which fail to reach the server, or which receive 502, 503 or
+// 504, are retried with exponential backoff until the retries are used up
+// or the context is done. Any other response outside 2xx is returned as an
+// *Error.
+//
+// Request and response bodies which are defined by the api package, for
+// example queue items, are passed as interface{} values so the api package
+// is free to use this client to reach flow.
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/notapipeline/tiyo/pkg/config"
+	"github.com/notapipeline/tiyo/pkg/logging"
+	"github.com/notapipeline/tiyo/pkg/tracing"
+	log "github.com/sirupsen/logrus"
+)
+
+// RETRIES : The default number of times a request is attempted
+const RETRIES = 5
+
+// BACKOFF : The default wait before the first retry. This doubles on each retry.
+const BACKOFF = 250 * time.Millisecond
+
+// API_PREFIX : The path all API endpoints sit beneath
+const API_PREFIX = "/api/v1"
+
+// Result : The envelope returned by most endpoints
+//
+// This has the same shape as api.Result and may be converted to it.
+type Result struct {
+	Code    int         `json:"code"`
+	Result  string      `json:"result"`
+	Message interface{} `json:"message"`
+}
+
+// Error : A response outside the 2xx range
+type Error struct {
+
+	// The HTTP status code of the response
+	Code int
+
+	// The message returned by the server, if any
+	Message string
+}
+
+// Error : Describe the failed response
+func (err *Error) Error() string {
+	if err.Message == "" {
+		return fmt.Sprintf("request failed with status %d", err.Code)
+	}
+	return fmt.Sprintf("request failed with status %d - %s", err.Code, err.Message)
+}
+
+// transports : Transports by TLS configuration
+var transports = struct {
+	sync.Mutex
+	cache map[string]*http.Transport
+}{
+	cache: make(map[string]*http.Transport),
+}
+
+// Client : The transport shared by the assemble and flow clients
+type Client struct {
+
+	// The scheme, host and port of the server
+	server string
+
+	// Sent as a bearer token on every request if not empty
+	token string
+
+	// Gets the machine token signing every request if no bearer token is
+	// set. Called for each request so a rotated token is picked up.
+	machine func() string
+
+	// The number of times a request is attempted
+	retries int
+
+	// The wait before the first retry
+	backoff time.Duration
+
+	// The underlying HTTP client
+	client *http.Client
+}
+
+// Option : Change the behaviour of a client
+type Option func(*Client)
+
+// WithToken : Authenticate every request with a bearer token
+func WithToken(token string) Option {
+	return func(client *Client) {
+		client.token = token
+	}
+}
+
+// WithMachineToken : Sign every request with a machine token
+func WithMachineToken(token string) Option {
+	return func(client *Client) {
+		client.machine = func() string { return token }
+	}
+}
+
+// WithRetries : Change how many times a request is attempted and the wait before the first retry
+func WithRetries(retries int, backoff time.Duration) Option {
+	return func(client *Client) {
+		if retries < 1 {
+			retries = 1
+		}
+		client.retries = retries
+		client.backoff = backoff
+	}
+}
+
+// WithTimeout : Change the time allowed for each attempt
+func WithTimeout(timeout time.Duration) Option {
+	return func(client *Client) {
+		client.client.Timeout = timeout
+	}
+}
+
+// newClient : Create a client for the server at address
+//
+// cacert is the certificate the server presents. If set, it is trusted in
+// addition to the system roots.
+//
+// Requests carry the token configured for the running command unless
+// WithToken is given, or are signed with the machine token of the host
+// if there is no token. The machine token is read again for each request,
+// so long running components keep working once `tiyo machine rotate`
+// replaces it.
+func newClient(c *config.Config, address string, cacert string, options ...Option) *Client {
+	client := Client{
+		server:  strings.TrimSuffix(address, "/"),
+		token:   c.ClientToken(),
+		machine: c.MachineToken,
+		retries: RETRIES,
+		backoff: BACKOFF,
+		client: &http.Client{
+			Timeout:   config.TIMEOUT,
+			Transport: logging.Transport(tracing.Transport(transport(c.UseInsecureTLS, cacert))),
+		},
+	}
+	for _, option := range options {
+		option(&client)
+	}
+	return &client
+}
+
+// transport : Get the transport for a TLS configuration
+//
+// Transports are shared between clients with the same configuration so
+// clients may be created freely without each holding its own connections.
+func transport(insecure bool, cacert string) *http.Transport {
+	var key string = fmt.Sprintf("%t:%s", insecure, cacert)
+
+	transports.Lock()
+	defer transports.Unlock()
+	if existing, ok := transports.cache[key]; ok {
+		return existing
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: insecure,
+	}
+	if cacert != "" {
+		if pool := certPool(cacert); pool != nil {
+			tlsConfig.RootCAs = pool
+		}
+	}
+
+	created := http.DefaultTransport.(*http.Transport).Clone()
+	created.TLSClientConfig = tlsConfig
+	transports.cache[key] = created
+	return created
+}
+
+// certPool : Get the system roots with the certificate in filename added
+//
+// Returns nil if the certificate cannot be read
+func certPool(filename string) *x509.CertPool {
+	pem, err := ioutil.ReadFile(filename)
+	if err != nil {
+		log.Warn("Cannot read certificate ", filename, " ", err)
+		return nil
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		log.Warn("No certificates found in ", filename)
+		return nil
+	}
+	return pool
+}
+
+// Server : Get the address of the server this client talks to
+func (client *Client) Server() string {
+	return client.server
+}
+
+// send : Send a request, retrying on failure, and get the status code and body of the response
+//
+// body is encoded as JSON unless nil
+func (client *Client) send(ctx context.Context, method string, path string, body interface{}) (int, []byte, error) {
+	var (
+		data     []byte
+		err      error
+		response *http.Response
+		wait     time.Duration = client.backoff
+	)
+	if body != nil {
+		if data, err = json.Marshal(body); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		var request *http.Request
+		request, err = http.NewRequestWithContext(ctx, method, client.server+API_PREFIX+path, bytes.NewReader(data))
+		if err != nil {
+			return 0, nil, err
+		}
+		if body != nil {
+			request.Header.Set("Content-Type", "application/json; charset=utf-8")
+		}
+		request.Header.Set("Accept", "application/json")
+		if client.token != "" {
+			request.Header.Set("Authorization", "Bearer "+client.token)
+		} else if machine := client.machine(); machine != "" {
+			signRequest(request, machine, data)
+		}
+
+		response, err = client.client.Do(request)
+		if err == nil && !retry(response.StatusCode) {
+			break
+		}
+
+		var code int
+		if err == nil {
+			response.Body.Close()
+			code = response.StatusCode
+			err = &Error{Code: code}
+		}
+		if attempt >= client.retries || ctx.Err() != nil {
+			return code, nil, err
+		}
+
+		logging.Entry(ctx).Debug("Retrying ", method, " ", path, " in ", wait, " - ", err)
+		select {
+		case <-ctx.Done():
+			return 0, nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+
+	defer response.Body.Close()
+	content, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return response.StatusCode, nil, err
+	}
+	return response.StatusCode, content, nil
+}
+
+// retry : Should a response with this status code be retried
+func retry(code int) bool {
+	return code == http.StatusBadGateway || code == http.StatusServiceUnavailable || code == http.StatusGatewayTimeout
+}
+
+// call : Send a request and decode the message of the result into out
+//
+// out may be nil if the message is not required
+func (client *Client) call(ctx context.Context, method string, path string, body interface{}, out interface{}) (int, error) {
+	code, content, err := client.send(ctx, method, path, body)
+	if err != nil {
+		return code, err
+	}
+	if code >= http.StatusBadRequest {
+		return code, failure(code, content)
+	}
+	// only 200 and 201 carry a message worth decoding
+	if out == nil || (code != http.StatusOK && code != http.StatusCreated) || len(content) == 0 {
+		return code, nil
+	}
+	if err := json.Unmarshal(content, &Result{Message: out}); err != nil {
+		return code, err
+	}
+	return code, nil
+}
+
+// result : Send a request and get the complete result, even if the request failed
+func (client *Client) result(ctx context.Context, method string, path string, body interface{}) (*Result, error) {
+	code, content, err := client.send(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	result := Result{}
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, err
+	}
+	if result.Code == 0 {
+		result.Code = code
+	}
+	if code >= http.StatusBadRequest {
+		return &result, failure(code, content)
+	}
+	return &result, nil
+}
+
+// failure : Build an error from a failed response
+func failure(code int, content []byte) error {
+	result := Result{}
+	if err := json.Unmarshal(content, &result); err == nil {
+		if message, ok := result.Message.(string); ok {
+			return &Error{Code: code, Message: message}
+		}
+	}
+	return &Error{Code: code}
+}
+
+// escape : Join path segments, escaping each one
+func escape(segments ...string) string {
+	var path string
+	for _, segment := range segments {
+		if segment == "" {
+			continue
+		}
+		path += "/" + url.PathEscape(segment)
+	}
+	return path
+}
+
+// decode : Decode a response body which is not wrapped in a result
+func decode(content []byte, out interface{}) error {
+	if out == nil || len(content) == 0 {
+		return nil
+	}
+	return json.Unmarshal(content, out)
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/notapipeline/tiyo/pkg/config"
+)
+
+func TestMachineTokenReloaded(t *testing.T) {
+	os.Unsetenv(config.TOKEN_ENV)
+	os.Unsetenv(config.MACHINE_TOKEN_ENV)
+
+	var accepted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		timestamp, _ := strconv.ParseInt(r.Header.Get(HEADER_TIMESTAMP), 10, 64)
+		if r.Header.Get(HEADER_SIGNATURE) != Sign(accepted, r.Method, r.URL.RequestURI(), timestamp, r.Header.Get(HEADER_NONCE), nil) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := &config.Config{ConfigBase: t.TempDir()}
+	client := newClient(c, server.URL, "")
+
+	for _, secret := range []string{"first", "rotated"} {
+		accepted = base64.StdEncoding.EncodeToString([]byte(`{"validFor": "10.1.2.3", "secret": "` + secret + `"}`))
+		if err := ioutil.WriteFile(c.MachineTokenFile(), []byte(accepted+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.call(context.Background(), http.MethodGet, "/machine", nil, nil); err != nil {
+			t.Fatalf("request signed with %s token refused: %s", secret, err)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/notapipeline/tiyo/pkg/config"
+)
+
+// Flow : A client for the flow API
+type Flow struct {
+	*Client
+}
+
+// Pod : Identifies the syphon executor making a request
+type Pod struct {
+
+	// The hostname of the pod
+	Pod string `json:"pod"`
+
+	// The container the syphon executor runs in
+	Container string `json:"container"`
+}
+
+// NewFlow : Create a client for the flow server named in the config
+func NewFlow(c *config.Config, options ...Option) *Flow {
+	return &Flow{
+		Client: newClient(c, c.FlowServer(), c.Flow.Cacert, options...),
+	}
+}
+
+// Register : Report the status of a syphon executor
+//
+// When status is Ready and a command is waiting, the status code is 200
+// and item, usually an *api.QueueItem, holds the command to execute.
+// Otherwise the status code is 202 or 204.
+func (flow *Flow) Register(ctx context.Context, pod Pod, status string, item interface{}) (int, error) {
+	return flow.call(ctx, http.MethodPost, "/register", map[string]string{
+		"pod":       pod.Pod,
+		"container": pod.Container,
+		"status":    status,
+	}, item)
+}
+
+// Heartbeat : Check if an executing item has been cancelled
+func (flow *Flow) Heartbeat(ctx context.Context, pod Pod, id string) (bool, error) {
+	var message string
+	if _, err := flow.call(ctx, http.MethodPost, "/heartbeat", map[string]string{
+		"pod":       pod.Pod,
+		"container": pod.Container,
+		"id":        id,
+	}, &message); err != nil {
+		return false, err
+	}
+	return message == "cancel", nil
+}
+
+// Complete : Report the final status of a queue item
+//
+// request is usually an *api.CompleteRequest with Pod and Container set
+func (flow *Flow) Complete(ctx context.Context, request interface{}) (int, error) {
+	return flow.call(ctx, http.MethodPost, "/complete", request, nil)
+}
+
+// Logs : Ship a batch of command output
+//
+// request must carry the pod and container alongside the fields of an api.LogRequest
+func (flow *Flow) Logs(ctx context.Context, request interface{}) (int, error) {
+	return flow.call(ctx, http.MethodPost, "/logs", request, nil)
+}
+
+// Execute : Build the infrastructure for a pipeline and start its queue
+func (flow *Flow) Execute(ctx context.Context, pipelineName string) (*Result, error) {
+	return flow.Forward(ctx, "execute", map[string]string{"pipeline": pipelineName})
+}
+
+// Status : Get the status of the infrastructure of a pipeline
+func (flow *Flow) Status(ctx context.Context, pipelineName string) (*Result, error) {
+	return flow.Forward(ctx, "status", map[string]string{"pipeline": pipelineName})
+}
+
+// Start : Start the queue of a pipeline
+func (flow *Flow) Start(ctx context.Context, pipelineName string) (*Result, error) {
+	return flow.Forward(ctx, "start", map[string]string{"pipeline": pipelineName})
+}
+
+// Stop : Stop the queue of a pipeline
+func (flow *Flow) Stop(ctx context.Context, pipelineName string) (*Result, error) {
+	return flow.Forward(ctx, "stop", map[string]string{"pipeline": pipelineName})
+}
+
+// Destroy : Destroy the infrastructure of a pipeline
+func (flow *Flow) Destroy(ctx context.Context, pipelineName string) (*Result, error) {
+	return flow.Forward(ctx, "destroy", map[string]string{"pipeline": pipelineName})
+}
+
+// Forward : Post content to one of the pipeline control endpoints and get the complete result
+//
+// endpoint is one of execute|status|start|stop|destroy. The result is
+// returned with any error so the response of flow can be relayed as is.
+func (flow *Flow) Forward(ctx context.Context, endpoint string, content map[string]string) (*Result, error) {
+	return flow.result(ctx, http.MethodPost, escape(endpoint), content)
+}
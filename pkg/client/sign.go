@@ -0,0 +1,78 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Headers carried by requests signed with a machine token
+const (
+	// The address the machine token was issued to, naming the machine
+	HEADER_MACHINE string = "X-Auth-Machine"
+
+	// The unix time the request was signed at
+	HEADER_TIMESTAMP string = "X-Auth-Timestamp"
+
+	// A random value making each signature unique
+	HEADER_NONCE string = "X-Auth-Nonce"
+
+	// The hex encoded signature of the request
+	HEADER_SIGNATURE string = "X-Auth-Signature"
+)
+
+// Sign : Get the signature of a request under a machine token
+//
+// The signature is the hex encoded HMAC-SHA256, keyed by the token, of the
+// method, the path and query, the unix timestamp, the nonce and the hex
+// encoded SHA-256 digest of the body, each on its own line.
+func Sign(token string, method string, uri string, timestamp int64, nonce string, body []byte) string {
+	digest := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, []byte(token))
+	fmt.Fprintf(mac, "%s\n%s\n%d\n%s\n%s", method, uri, timestamp, nonce, hex.EncodeToString(digest[:]))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// MachineAddress : Get the address a machine token was issued to
+//
+// Returns an empty string if the token cannot be read.
+func MachineAddress(token string) string {
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		ValidFor string `json:"validFor"`
+	}
+	if err := json.Unmarshal(data, &claims); err != nil {
+		return ""
+	}
+	return claims.ValidFor
+}
+
+// signRequest : Add the machine, timestamp, nonce and signature headers to a request
+func signRequest(request *http.Request, token string, body []byte) {
+	var (
+		timestamp int64  = time.Now().Unix()
+		random    []byte = make([]byte, 16)
+	)
+	rand.Read(random)
+	var nonce string = hex.EncodeToString(random)
+
+	request.Header.Set(HEADER_MACHINE, MachineAddress(token))
+	request.Header.Set(HEADER_TIMESTAMP, fmt.Sprintf("%d", timestamp))
+	request.Header.Set(HEADER_NONCE, nonce)
+	request.Header.Set(HEADER_SIGNATURE, Sign(token, request.Method, request.URL.RequestURI(), timestamp, nonce, body))
+}
@@ -5,7 +5,8 @@
 // file, You can obtain one at https://mozilla.org/MPL/2.0/.
 
 // Package command serves as the main entry point to the tiyo application
-// and its relevant primary sub-commands `assemble`, `flow`, `fill` and `syphon`
+// and its relevant primary sub-commands `assemble`, `flow`, `fill`, `syphon`
+// and `machine`
 package command
 
 import (
@@ -16,6 +17,8 @@ import (
 	"github.com/notapipeline/tiyo/pkg/config"
 	"github.com/notapipeline/tiyo/pkg/fill"
 	"github.com/notapipeline/tiyo/pkg/flow"
+	"github.com/notapipeline/tiyo/pkg/logging"
+	"github.com/notapipeline/tiyo/pkg/machine"
 	"github.com/notapipeline/tiyo/pkg/server"
 	"github.com/notapipeline/tiyo/pkg/syphon"
 	log "github.com/sirupsen/logrus"
@@ -40,6 +43,7 @@ var acceptedCommands = []string{
 	"flow",
 	"crypt",
 	"syphon",
+	"machine",
 	"help",
 	"version",
 }
@@ -49,7 +53,10 @@ var acceptedCommands = []string{
 // By default, the logging level is info but this can
 // be controlled by the environment variable TIYO_LOG
 // if a finer level of control is required.
+//
+// Setting TIYO_LOG_FORMAT to `json` writes logs as JSON
 func SetupLog() {
+	logging.Setup()
 	var level string = os.Getenv("TIYO_LOG")
 	if level == "" {
 		level = "info"
@@ -108,7 +115,7 @@ func Run(args []string) int {
 		Usage()
 		return 0
 	case "machine":
-		return 0
+		instance = machine.NewMachine()
 	case "version":
 		fmt.Printf("%s version %s\n", filepath.Base(os.Args[0]), VERSION)
 		return 0
@@ -147,6 +147,27 @@ type Config struct {
 	// Config for SAML 2fa
 	SAML *SAML `json:"saml"`
 
+	// Config for OpenID Connect single sign on
+	OIDC *OIDC `json:"oidc"`
+
+	// Groups and provisioning of users signing in through SAML or OIDC
+	SSO SSO `json:"sso"`
+
+	// Browser session configuration
+	Sessions Sessions `json:"sessions"`
+
+	// NATS message broker configuration
+	Nats Nats `json:"nats"`
+
+	// Prometheus metrics configuration
+	Metrics Metrics `json:"metrics"`
+
+	// OpenTelemetry tracing configuration
+	Tracing Tracing `json:"tracing"`
+
+	// Bearer tokens accepted by the assemble and flow APIs, by name
+	Tokens map[string]Token `json:"tokens"`
+
 	// Base directory for configuration files - default /etc/tiyo
 	ConfigBase string
 
@@ -217,6 +238,30 @@ func NewConfig() (*Config, error) {
 		config.SAML = &SAML{}
 	}
 
+	if config.SAML.GroupsAttribute == "" {
+		config.SAML.GroupsAttribute = "Groups"
+	}
+
+	if config.OIDC == nil {
+		config.OIDC = &OIDC{}
+	}
+
+	if config.Sessions.IdleTimeout == 0 {
+		config.Sessions.IdleTimeout = 60
+	}
+
+	if config.Nats.Host == "" {
+		config.Nats.Host = "127.0.0.1"
+	}
+
+	if config.Nats.Port == 0 {
+		config.Nats.Port = 4222
+	}
+
+	if config.Tracing.SampleRatio <= 0 || config.Tracing.SampleRatio > 1 {
+		config.Tracing.SampleRatio = 1
+	}
+
 	var home string
 	if home, err = os.UserHomeDir(); err != nil {
 		// assume running as root
@@ -241,6 +286,10 @@ func NewConfig() (*Config, error) {
 		}
 	}
 
+	if err = config.CheckTokens(); err != nil {
+		return nil, err
+	}
+
 	return &config, nil
 }
 
@@ -0,0 +1,17 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+// Metrics : Configuration for the prometheus metrics endpoints
+//
+// Assemble and flow always serve metrics on `/metrics` of their own
+// listener. Syphon has no listener so its endpoint is optional.
+type Metrics struct {
+
+	// The port syphon serves `/metrics` on. If 0, syphon does not serve metrics.
+	Syphon int `json:"syphon"`
+}
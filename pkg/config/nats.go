@@ -0,0 +1,31 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import "fmt"
+
+// Nats : Configuration for NATS message broker sources and sinks
+type Nats struct {
+
+	// The default server URL used by any NATS source which does not
+	// specify its own. Defaults to nats://host:port.
+	URL string `json:"url"`
+
+	// The host of the default server when no URL is given - default 127.0.0.1
+	Host string `json:"host"`
+
+	// The port of the default server when no URL is given - default 4222
+	Port int `json:"port"`
+}
+
+// NatsServer : Get the default NATS server URL
+func (config *Config) NatsServer() string {
+	if config.Nats.URL != "" {
+		return config.Nats.URL
+	}
+	return fmt.Sprintf("nats://%s:%d", config.Nats.Host, config.Nats.Port)
+}
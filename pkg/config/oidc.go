@@ -0,0 +1,110 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+// OIDC : OpenID Connect single sign on
+//
+// Assemble signs users in with the authorization code flow protected by
+// PKCE. The issuer is found through discovery so only its URL and the
+// client registered with it are configured.
+type OIDC struct {
+
+	// The issuer URL. Discovery is read from
+	// {issuer}/.well-known/openid-configuration
+	Issuer string `json:"issuer"`
+
+	// The client ID registered with the issuer
+	ClientID string `json:"client_id"`
+
+	// The client secret. May be empty for public clients
+	ClientSecret string `json:"client_secret,omitempty"`
+
+	// The URL the issuer returns users to. Defaults to /oidc/callback
+	// on the assemble server
+	RedirectURL string `json:"redirect_url,omitempty"`
+
+	// Scopes requested in addition to openid. Defaults to email and profile
+	Scopes []string `json:"scopes,omitempty"`
+
+	// The claim holding the email of the user. Defaults to email
+	EmailClaim string `json:"email_claim,omitempty"`
+
+	// The claim holding the group names of the user. Defaults to groups
+	GroupsClaim string `json:"groups_claim,omitempty"`
+
+	// The HTTP client used to reach the issuer. Replaced to reach a mock issuer
+	Client *http.Client `json:"-"`
+
+	// The provider found through discovery
+	Provider *oidc.Provider `json:"-"`
+
+	// The OAuth2 client of the provider
+	OAuth2 *oauth2.Config `json:"-"`
+
+	// Verifies ID tokens issued to the client
+	Verifier *oidc.IDTokenVerifier `json:"-"`
+}
+
+// Context : Get a context carrying the HTTP client used to reach the issuer
+func (o *OIDC) Context(ctx context.Context) context.Context {
+	if o.Client == nil {
+		return ctx
+	}
+	return oidc.ClientContext(ctx, o.Client)
+}
+
+// ConfigureOIDC : Discover the OIDC issuer and configure the client
+func (config *Config) ConfigureOIDC() error {
+	log.Infof("Setting up OIDC configuration")
+
+	if config.OIDC.Issuer == "" || config.OIDC.ClientID == "" {
+		return fmt.Errorf("OIDC requires issuer and client_id")
+	}
+	if config.OIDC.EmailClaim == "" {
+		config.OIDC.EmailClaim = "email"
+	}
+	if config.OIDC.GroupsClaim == "" {
+		config.OIDC.GroupsClaim = "groups"
+	}
+	if len(config.OIDC.Scopes) == 0 {
+		config.OIDC.Scopes = []string{"email", "profile"}
+	}
+	if config.OIDC.RedirectURL == "" {
+		config.OIDC.RedirectURL = config.AssembleServer() + "/oidc/callback"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	provider, err := oidc.NewProvider(config.OIDC.Context(ctx), config.OIDC.Issuer)
+	if err != nil {
+		log.Warnf("Failed to configure OIDC: %s", err)
+		config.OIDC.Provider = nil
+		return fmt.Errorf("Failed to configure OIDC: %s", err)
+	}
+
+	config.OIDC.Provider = provider
+	config.OIDC.OAuth2 = &oauth2.Config{
+		ClientID:     config.OIDC.ClientID,
+		ClientSecret: config.OIDC.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  config.OIDC.RedirectURL,
+		Scopes:       append([]string{oidc.ScopeOpenID}, config.OIDC.Scopes...),
+	}
+	config.OIDC.Verifier = provider.Verifier(&oidc.Config{ClientID: config.OIDC.ClientID})
+	log.Infof("Successfully configured OIDC")
+	return nil
+}
@@ -19,10 +19,11 @@ import (
 )
 
 type SAML struct {
-	IDPMetadata string             `json:"idp_metadata"`
-	PrivateKey  []byte             `json:"private_key"`
-	Certificate []byte             `json:"certificate"`
-	SamlSP      *samlsp.Middleware `json:"-"`
+	IDPMetadata     string             `json:"idp_metadata"`
+	PrivateKey      []byte             `json:"private_key"`
+	Certificate     []byte             `json:"certificate"`
+	GroupsAttribute string             `json:"groups_attribute,omitempty"`
+	SamlSP          *samlsp.Middleware `json:"-"`
 }
 
 func (config *Config) ConfigureSAML() error {
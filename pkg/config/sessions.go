@@ -0,0 +1,18 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+// Sessions : Configuration for browser sessions on assemble
+//
+// Sessions always end 12 hours after sign in. They also end once unused
+// for the idle timeout.
+type Sessions struct {
+
+	// Minutes a session may go unused before it ends - default 60. If
+	// negative, sessions only end after 12 hours.
+	IdleTimeout int `json:"idleTimeout"`
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+package config
+
+// SSO : How users signing in through SAML or OIDC are given local groups
+//
+// The group values sent by the identity provider are mapped to local
+// groups, which carry the admin role and any pipeline roles given to
+// them. Values which do not map to a local group are ignored.
+type SSO struct {
+
+	// Local group names by identity provider value. If empty, values
+	// are taken as the names of local groups.
+	Groups map[string][]string `json:"groups,omitempty"`
+
+	// A local group given to every user signing in through SSO
+	DefaultGroup string `json:"default_group,omitempty"`
+
+	// Create local users on first sign in. Otherwise users must be
+	// registered before they can sign in.
+	Provision bool `json:"provision"`
+}
+
+// MapGroups : Get the names of the local groups for identity provider values
+func (sso *SSO) MapGroups(values []string) []string {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	for _, value := range values {
+		if len(sso.Groups) == 0 {
+			add(value)
+			continue
+		}
+		for _, name := range sso.Groups[value] {
+			add(name)
+		}
+	}
+	add(sso.DefaultGroup)
+	return names
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Scopes accepted by the assemble and flow APIs
+const (
+	// Read buckets, logs, statistics and status
+	SCOPE_READ string = "read"
+
+	// Change buckets, schedules and submissions
+	SCOPE_WRITE string = "write"
+
+	// Take and complete queue items and ship events and logs
+	SCOPE_QUEUE string = "queue"
+
+	// Control the infrastructure of pipelines and decrypt values
+	SCOPE_ADMIN string = "admin"
+)
+
+// TOKEN_PLACEHOLDER : Begins the token secrets in the example config, which
+// must be replaced before use
+const TOKEN_PLACEHOLDER string = "CHANGE_ME"
+
+// TOKEN_ENV : Environment variable holding the token a client presents
+const TOKEN_ENV string = "TIYO_TOKEN"
+
+// MACHINE_TOKEN_ENV : Environment variable holding the machine token a client signs requests with
+const MACHINE_TOKEN_ENV string = "TIYO_MACHINE_TOKEN"
+
+// MACHINE_TOKEN_FILE : The file in ConfigBase `tiyo machine` keeps the machine token in
+const MACHINE_TOKEN_FILE string = "machine.token"
+
+// Token : A bearer token accepted by the assemble and flow APIs
+//
+// Tokens are keyed in the config by the component which presents them,
+// normally `flow`, `fill`, `syphon` and `assemble` (which calls flow),
+// plus any names given to tokens for scripts.
+type Token struct {
+
+	// The secret presented in the Authorization header
+	Token string `json:"token"`
+
+	// The scopes granted to the token. One or more of read, write, queue and admin
+	Scopes []string `json:"scopes"`
+}
+
+// Has : Does the token grant scope
+func (token *Token) Has(scope string) bool {
+	for _, s := range token.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientToken : Get the token this component presents to the APIs
+//
+// TIYO_TOKEN takes precedence over the token named for the running command
+func (config *Config) ClientToken() string {
+	if token := os.Getenv(TOKEN_ENV); token != "" {
+		return token
+	}
+	if token, ok := config.Tokens[Designate]; ok {
+		return token.Token
+	}
+	return ""
+}
+
+// CheckTokens : Check the tokens needed by the running component are set
+//
+// Assemble and flow refuse to start if any configured token has no secret,
+// as the component presenting it could never authenticate, or still holds
+// the placeholder from the example config. Flow must also
+// hold the token it hands to syphon. Every component must have a token to
+// present, from the config, TIYO_TOKEN or a machine token. Flow has no
+// machine records to check signatures against, so assemble and syphon,
+// which call flow, must have a bearer token.
+func (config *Config) CheckTokens() error {
+	switch Designate {
+	case "assemble", "flow":
+		for name, token := range config.Tokens {
+			if token.Token == "" || strings.HasPrefix(token.Token, TOKEN_PLACEHOLDER) {
+				return fmt.Errorf("No secret set for token %s - set tokens.%s.token in the config to a random string such as the output of `openssl rand -hex 32`", name, name)
+			}
+		}
+		if _, ok := config.Tokens["syphon"]; !ok && Designate == "flow" {
+			return fmt.Errorf("No token for syphon - set tokens.syphon.token in the config")
+		}
+	case "fill", "syphon":
+	default:
+		return nil
+	}
+
+	if Designate == "assemble" || Designate == "syphon" {
+		if config.ClientToken() == "" {
+			return fmt.Errorf("No token for %s - set tokens.%s.token in the config or %s. Flow does not accept machine tokens", Designate, Designate, TOKEN_ENV)
+		}
+		return nil
+	}
+	if config.ClientToken() == "" && config.MachineToken() == "" {
+		return fmt.Errorf("No token for %s - set tokens.%s.token in the config, %s or enrol the host with `tiyo machine enrol`", Designate, Designate, TOKEN_ENV)
+	}
+	return nil
+}
+
+// FindToken : Find the configured token matching secret
+//
+// Returns the name of the token and the token or nil if no token matches
+func (config *Config) FindToken(secret string) (string, *Token) {
+	if secret == "" {
+		return "", nil
+	}
+	for name, token := range config.Tokens {
+		if token.Token == "" {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(token.Token), []byte(secret)) == 1 {
+			found := token
+			return name, &found
+		}
+	}
+	return "", nil
+}
+
+// MachineTokenFile : Get the path of the file holding the machine token of this host
+func (config *Config) MachineTokenFile() string {
+	return filepath.Join(config.ConfigBase, MACHINE_TOKEN_FILE)
+}
+
+// MachineToken : Get the machine token this host signs requests with
+//
+// TIYO_MACHINE_TOKEN takes precedence over the token written by `tiyo machine`.
+// Returns an empty string if the host has not been enrolled.
+func (config *Config) MachineToken() string {
+	if token := os.Getenv(MACHINE_TOKEN_ENV); token != "" {
+		return token
+	}
+	data, err := ioutil.ReadFile(config.MachineTokenFile())
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
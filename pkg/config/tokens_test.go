@@ -0,0 +1,84 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckTokens(t *testing.T) {
+	var (
+		secret  Token = Token{Token: "secret", Scopes: []string{SCOPE_READ}}
+		empty   Token = Token{Scopes: []string{SCOPE_READ}}
+		example Token = Token{Token: TOKEN_PLACEHOLDER + "_flow", Scopes: []string{SCOPE_READ}}
+	)
+
+	tests := []struct {
+		name      string
+		designate string
+		tokens    map[string]Token
+
+		// Set as TIYO_TOKEN
+		env string
+
+		// Written to the machine token file
+		machine string
+
+		valid bool
+	}{
+		{name: "assemble with token", designate: "assemble", tokens: map[string]Token{"assemble": secret}, valid: true},
+		{name: "assemble with empty token", designate: "assemble", tokens: map[string]Token{"assemble": secret, "script": empty}},
+		{name: "assemble with placeholder", designate: "assemble", tokens: map[string]Token{"assemble": example}},
+		{name: "assemble with machine token only", designate: "assemble", machine: "machine"},
+		{name: "flow with syphon token", designate: "flow", tokens: map[string]Token{"flow": secret, "syphon": secret}, valid: true},
+		{name: "flow without syphon token", designate: "flow", tokens: map[string]Token{"flow": secret}},
+		{name: "flow with machine token", designate: "flow", tokens: map[string]Token{"syphon": secret}, machine: "machine", valid: true},
+		{name: "syphon from environment", designate: "syphon", env: "secret", valid: true},
+		{name: "syphon with machine token only", designate: "syphon", machine: "machine"},
+		{name: "fill with machine token", designate: "fill", machine: "machine", valid: true},
+		{name: "fill without token", designate: "fill"},
+		{name: "other commands", designate: "machine", valid: true},
+	}
+
+	var designate string = Designate
+	defer func() { Designate = designate }()
+	environment, set := os.LookupEnv(TOKEN_ENV)
+	defer func() {
+		if set {
+			os.Setenv(TOKEN_ENV, environment)
+		} else {
+			os.Unsetenv(TOKEN_ENV)
+		}
+	}()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Designate = test.designate
+			os.Setenv(TOKEN_ENV, test.env)
+			config := Config{
+				ConfigBase: t.TempDir(),
+				Tokens:     test.tokens,
+			}
+			if test.machine != "" {
+				if err := ioutil.WriteFile(filepath.Join(config.ConfigBase, MACHINE_TOKEN_FILE), []byte(test.machine), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := config.CheckTokens()
+			if test.valid && err != nil {
+				t.Errorf("expected tokens to be accepted, got %s", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected tokens to be refused")
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+// Tracing : Configuration for exporting OpenTelemetry traces
+//
+// Trace context is always propagated between components. Spans are only
+// exported when an endpoint is set.
+type Tracing struct {
+
+	// The host:port of an OTLP/HTTP collector, for example localhost:4318
+	Endpoint string `json:"endpoint"`
+
+	// Send spans to the collector over plain HTTP
+	Insecure bool `json:"insecure"`
+
+	// The fraction of new traces to record between 0 and 1 - default 1
+	SampleRatio float64 `json:"sampleRatio"`
+}
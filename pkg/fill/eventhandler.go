@@ -7,23 +7,27 @@
 package fill
 
 import (
-	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
-	"fmt"
-	"net/http"
 	"path/filepath"
 
+	"github.com/google/uuid"
+	"github.com/notapipeline/tiyo/pkg/client"
 	"github.com/notapipeline/tiyo/pkg/config"
+	"github.com/notapipeline/tiyo/pkg/logging"
+	"github.com/notapipeline/tiyo/pkg/tracing"
 	"github.com/rjeczalik/notify"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // MAXCLIENTS : The maximum number of http clients created by fill application
 const MAXCLIENTS int = 100
 
-// A channel to write requests into to be handled by the client goroutine
-var requests chan *http.Request = make(chan *http.Request)
+// A channel to write requests into to be handled by the client goroutines
+var requests chan func() = make(chan func())
 
 // FilledFileEvent : A file event to be sent to AssembleServer
 type FilledFileEvent struct {
@@ -47,6 +51,9 @@ type FilledFileEvent struct {
 	// Can be a reduced config containing only assemble and
 	// the sequence base directory
 	Config *config.Config
+
+	// Client for the assemble API
+	Client *client.Assemble
 }
 
 // NewFillEvent : Create a new fill event stream
@@ -58,6 +65,7 @@ func NewFillEvent(config *config.Config, bucket string, filename string) *Filled
 		Closed:   false,
 		Deleted:  false,
 		Config:   config,
+		Client:   client.NewAssemble(config),
 	}
 	return &event
 }
@@ -96,45 +104,47 @@ func (event *FilledFileEvent) State(notification notify.Event) *FilledFileEvent
 
 // Store : Store the event in the BoltDB
 func (event *FilledFileEvent) Store() {
-	server := event.Config.AssembleServer()
-	data := event.JSONBody(event.Bucket, event.Filename)
-	request, err := http.NewRequest(
-		http.MethodPut,
-		fmt.Sprintf("%s/api/v1/bucket", server),
-		bytes.NewBuffer(data))
-	if err != nil {
-		log.Fatal(err)
+	ctx := event.trace(context.Background(), "store")
+	requests <- func() {
+		if err := event.Client.Put(ctx, "files", filepath.Base(event.Bucket), event.Filename, event.Value()); err != nil {
+			logging.Entry(ctx).Error("Failed to store ", event.Filename, " ", err)
+		}
 	}
-	request.Header.Set("Content-Type", "application/json; charset=utf-8")
-	request.Header.Set("Connection", "close")
-	request.Close = true
-	requests <- request
 }
 
 // Delete : Deletes an item from the boltdb - triggered on file deleted
 func (event *FilledFileEvent) Delete() {
-	server := event.Config.AssembleServer()
-	request, err := http.NewRequest(
-		http.MethodDelete,
-		fmt.Sprintf("%s/api/v1/bucket/%s/%s", server, event.Bucket, event.Filename), nil)
-	if err != nil {
-		log.Fatal(err)
+	ctx := event.trace(context.Background(), "delete")
+	requests <- func() {
+		if err := event.Client.DeleteKey(ctx, "files", filepath.Base(event.Bucket), event.Filename); err != nil {
+			logging.Entry(ctx).Error("Failed to delete ", event.Filename, " ", err)
+		}
 	}
-	request.Header.Set("Content-Type", "application/json; charset=utf-8")
-	request.Header.Set("Connection", "close")
-	request.Close = true
-	requests <- request
 }
 
-// JSONBody : Construct the JSON object to send as part of the request
-func (event *FilledFileEvent) JSONBody(bucket string, key string) []byte {
-	bucket = filepath.Base(bucket)
-	values := map[string]string{
-		"bucket": "files",
-		"child":  bucket,
-		"key":    key,
-		"value":  "",
+// trace : Start the trace following a file through the pipeline
+//
+// The span ends once the request is handed to the client. The client
+// records its own span for sending it.
+//
+// Each request is given a new request ID and stored files begin a new run.
+func (event *FilledFileEvent) trace(ctx context.Context, action string) context.Context {
+	ctx = logging.WithRequestID(ctx, uuid.New().String())
+	if action == "store" {
+		ctx = logging.StartRun(ctx)
 	}
+	ctx = logging.With(ctx, log.Fields{logging.FIELD_PIPELINE: filepath.Base(event.Bucket)})
+	logging.Entry(ctx).Debug("Sending ", action, " for ", event.Filename)
+
+	ctx, span := tracing.Start(ctx, "fill "+action, trace.WithAttributes(
+		attribute.String("tiyo.bucket", filepath.Base(event.Bucket)),
+		attribute.String("tiyo.file", event.Filename)))
+	defer span.End()
+	return ctx
+}
+
+// Value : Get the value stored against the file
+func (event *FilledFileEvent) Value() string {
 	value := make(map[string]interface{})
 	if event.Opened && !event.Closed {
 		value["status"] = "loading"
@@ -142,12 +152,11 @@ func (event *FilledFileEvent) JSONBody(bucket string, key string) []byte {
 		value["status"] = "ready"
 	}
 
-	if _, ok := value["status"]; ok {
-		data, _ := json.Marshal(value)
-		values["value"] = base64.StdEncoding.EncodeToString([]byte(data))
+	if _, ok := value["status"]; !ok {
+		return ""
 	}
-	jsonValue, _ := json.Marshal(values)
-	return jsonValue
+	data, _ := json.Marshal(value)
+	return base64.StdEncoding.EncodeToString([]byte(data))
 }
 
 // Filler : Struct for managing multiple event paths
@@ -200,33 +209,10 @@ func (filler *Filler) Add(bucket string, dirname string, filename string, notifi
 
 // Read requests off the channel and send them to the assemble server
 //
-// Starts MAX_CLIENTS http clients and tries to send each request up to 5 times
-// to ensure delivery of the payload.
+// MAXCLIENTS of these run at once. The client retries each request to
+// ensure delivery of the payload.
 func (filler *Filler) requestMaker() {
-	var client = &http.Client{
-		Timeout: config.TIMEOUT,
-	}
-	var (
-		maxRetries int = 5
-		retries    int = maxRetries
-		err        error
-		response   *http.Response
-	)
-	for {
-		select {
-		case request := <-requests:
-			for retries > 0 {
-				response, err = client.Do(request)
-				if err == nil {
-					break
-				}
-				retries--
-			}
-			if response != nil {
-				if err := response.Body.Close(); err != nil {
-					log.Println(err)
-				}
-			}
-		}
+	for send := range requests {
+		send()
 	}
 }
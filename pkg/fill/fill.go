@@ -24,6 +24,7 @@ import (
 
 	"github.com/notapipeline/tiyo/pkg/config"
 	"github.com/notapipeline/tiyo/pkg/pipeline"
+	"github.com/notapipeline/tiyo/pkg/tracing"
 	"github.com/rjeczalik/notify"
 	log "github.com/sirupsen/logrus"
 )
@@ -138,6 +139,7 @@ func (fill *Fill) Run() int {
 		log.Error("Error loading config file: ", err)
 		return 1
 	}
+	defer tracing.Init(fill.Config, config.Designate)()
 
 	fill.Pipeline, err = pipeline.GetPipeline(fill.Config, fill.Name)
 	if err != nil {
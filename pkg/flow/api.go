@@ -8,6 +8,7 @@
 package flow
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -32,6 +33,13 @@ type API struct {
 	config *config.Config
 }
 
+// Handlers refusing requests not granted each scope
+var (
+	requireRead  gin.HandlerFunc = server.RequireScope(config.SCOPE_READ)
+	requireQueue gin.HandlerFunc = server.RequireScope(config.SCOPE_QUEUE)
+	requireAdmin gin.HandlerFunc = server.RequireScope(config.SCOPE_ADMIN)
+)
+
 // NewAPI Create a new Flow API object
 func NewAPI() *API {
 	api := API{}
@@ -43,29 +51,41 @@ func NewAPI() *API {
 //
 // Flow api offers integrations for the Syphon application to
 // communicate and for assemble to trigger the application
-// deployments.
+// deployments. Every endpoint requires a bearer token from
+// the config granted the scope named against it.
 func (api *API) Serve(config *config.Config) {
 	log.Info("starting flow server - ", config.FlowServer())
 	api.config = config
 
 	server := server.NewServer()
+	routes := server.API(config)
+
 	// Used by syphon to regiser a container as ready/busy
-	server.Engine().POST("/api/v1/register", api.Register)
+	routes.POST("/register", requireQueue, api.Register)
+
+	// Used by syphon to check if an executing item has been cancelled
+	routes.POST("/heartbeat", requireQueue, api.Heartbeat)
+
+	// Used by syphon to report the final status of an item
+	routes.POST("/complete", requireQueue, api.Complete)
+
+	// Used by syphon to ship command output
+	routes.POST("/logs", requireQueue, api.Logs)
 
 	// Execute the pipeline and build infrastructure
-	server.Engine().POST("/api/v1/execute", api.Execute)
+	routes.POST("/execute", requireAdmin, api.Execute)
 
 	// Get the status
-	server.Engine().POST("/api/v1/status", api.Status)
+	routes.POST("/status", requireRead, api.Status)
 
 	// Start the queue
-	server.Engine().POST("/api/v1/start", api.Start)
+	routes.POST("/start", requireAdmin, api.Start)
 
 	// Stop the queue
-	server.Engine().POST("/api/v1/stop", api.Stop)
+	routes.POST("/stop", requireAdmin, api.Stop)
 
 	// destroy all infrastructure related to the pipeline
-	server.Engine().POST("/api/v1/destroy", api.Destroy)
+	routes.POST("/destroy", requireAdmin, api.Destroy)
 
 	host := fmt.Sprintf("%s:%d", config.Flow.Host, config.Flow.Port)
 	log.Info(host)
@@ -91,7 +111,7 @@ func (api *API) Serve(config *config.Config) {
 // If syphon registers busy, no command should be sent back.
 func (api *API) Register(c *gin.Context) {
 	var flow *Flow
-	var request map[string]interface{} = api.podRequest(c)
+	var request map[string]interface{} = api.podRequest(c, "status")
 	if request == nil {
 		return
 	}
@@ -106,13 +126,126 @@ func (api *API) Register(c *gin.Context) {
 		return
 	}
 	log.Debug("Flow queue = ", flow.Queue)
-	var result *serverApi.Result = flow.Queue.Register(request)
+	var result *serverApi.Result = flow.Queue.Register(c.Request.Context(), request)
+	c.JSON(result.Code, result)
+}
+
+// Heartbeat : Endpoint for Syphon executors to check on an executing queue item
+//
+// If the item has been cancelled in assemble, the response message will be
+// "cancel" and syphon should terminate the command. Otherwise the message
+// is "continue".
+func (api *API) Heartbeat(c *gin.Context) {
+	var flow *Flow
+	var request map[string]interface{} = api.podRequest(c, "id")
+	if request == nil {
+		return
+	}
+
+	if flow = api.flowFromPodName(request["pod"].(string)); flow == nil || flow.Queue == nil {
+		result := serverApi.Result{
+			Code:    404,
+			Result:  "Error",
+			Message: "Not found - try again later",
+		}
+		c.JSON(result.Code, result)
+		return
+	}
+
+	result := serverApi.Result{
+		Code:    200,
+		Result:  "OK",
+		Message: "continue",
+	}
+	if flow.Queue.Heartbeat(c.Request.Context(), request["id"].(string)) {
+		log.Info("Relaying cancellation of ", request["id"], " to ", request["pod"])
+		result.Message = "cancel"
+	}
+	c.JSON(result.Code, result)
+}
+
+// Complete : Endpoint for Syphon executors to report the final status of a queue item
+func (api *API) Complete(c *gin.Context) {
+	var flow *Flow
+	var request map[string]interface{} = api.podRequest(c, "id", "status")
+	if request == nil {
+		return
+	}
+
+	if flow = api.flowFromPodName(request["pod"].(string)); flow == nil || flow.Queue == nil {
+		result := serverApi.Result{
+			Code:    404,
+			Result:  "Error",
+			Message: "Not found - try again later",
+		}
+		c.JSON(result.Code, result)
+		return
+	}
+
+	// re-read the request to pick up exit code and usage
+	complete := serverApi.CompleteRequest{}
+	data, _ := json.Marshal(request)
+	if err := json.Unmarshal(data, &complete); err != nil {
+		log.Error("Invalid usage reported for ", request["id"], " ", err)
+		complete = serverApi.CompleteRequest{
+			ID:     request["id"].(string),
+			Status: request["status"].(string),
+		}
+	}
+
+	result := serverApi.Result{
+		Code:    flow.Queue.Complete(c.Request.Context(), &complete),
+		Result:  "OK",
+		Message: "",
+	}
+	c.JSON(result.Code, result)
+}
+
+// Logs : Endpoint for Syphon executors to ship the output of a queue item
+//
+// The chunks are relayed to assemble for storage against the pipeline
+func (api *API) Logs(c *gin.Context) {
+	var flow *Flow
+	request := struct {
+		Pod       string               `json:"pod"`
+		Container string               `json:"container"`
+		ID        string               `json:"id"`
+		Final     bool                 `json:"final"`
+		Chunks    []serverApi.LogChunk `json:"chunks"`
+	}{}
+
+	if err := c.ShouldBindJSON(&request); err != nil || request.Pod == "" || request.ID == "" {
+		result := serverApi.NewResult()
+		result.Code = 400
+		result.Result = "Error"
+		result.Message = "pod and id are required"
+		c.JSON(result.Code, result)
+		return
+	}
+
+	if flow = api.flowFromPodName(request.Pod); flow == nil || flow.Queue == nil {
+		result := serverApi.Result{
+			Code:    404,
+			Result:  "Error",
+			Message: "Not found - try again later",
+		}
+		c.JSON(result.Code, result)
+		return
+	}
+
+	result := serverApi.Result{
+		Code:    flow.Queue.Logs(c.Request.Context(), request.ID, request.Final, request.Chunks),
+		Result:  "OK",
+		Message: "",
+	}
 	c.JSON(result.Code, result)
 }
 
 // podRequest : Unpack a request from a pod and validate the input returning a map containing the verified fields
-func (api *API) podRequest(c *gin.Context) map[string]interface{} {
-	expected := []string{"pod", "container", "status"}
+//
+// pod and container are always expected, any additional fields are appended to these.
+func (api *API) podRequest(c *gin.Context, fields ...string) map[string]interface{} {
+	expected := append([]string{"pod", "container"}, fields...)
 	request := make(map[string]interface{})
 	if err := c.ShouldBind(&request); err != nil {
 		for _, expect := range expected {
@@ -0,0 +1,220 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/notapipeline/tiyo/pkg/pipeline"
+	"github.com/notapipeline/tiyo/pkg/server/api"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Broker : Manages NATS subscriptions and publications for a pipeline
+//
+// NATS sources which link into a command are subscribed to and each
+// message received is converted into a queue event for that source.
+// Commands which link out to a NATS source have their completion and
+// failure events published to the source subject.
+type Broker struct {
+	sync.Mutex
+
+	// The queue the broker submits events through
+	queue *Queue
+
+	// Connections to each NATS server in use, keyed by URL
+	connections map[string]*nats.Conn
+
+	// Active subscriptions
+	subscriptions []*nats.Subscription
+}
+
+// BrokerEvent : The message published to NATS sinks
+type BrokerEvent struct {
+
+	// The pipeline the command belongs to
+	Pipeline string `json:"pipeline"`
+
+	// The jointJS ID of the command
+	CommandID string `json:"commandId"`
+
+	// The name of the command
+	Command string `json:"command"`
+
+	// The queue item ID
+	ID string `json:"id"`
+
+	// The key of the queue item, either a file key or event:<id>
+	Key string `json:"key"`
+
+	// The final status of the queue item - one of complete|failed|cancelled
+	Status string `json:"status"`
+
+	// The pod which executed the item
+	Pod string `json:"pod"`
+
+	// The time the event was published
+	Time time.Time `json:"time"`
+}
+
+// NewBroker : Create a new broker for the given queue
+func NewBroker(queue *Queue) *Broker {
+	broker := Broker{
+		queue:         queue,
+		connections:   make(map[string]*nats.Conn),
+		subscriptions: make([]*nats.Subscription, 0),
+	}
+	return &broker
+}
+
+// Subscribe : Subscribe to every NATS source feeding a command in the pipeline
+func (broker *Broker) Subscribe() {
+	broker.Lock()
+	defer broker.Unlock()
+	if len(broker.subscriptions) > 0 {
+		return
+	}
+
+	for _, source := range broker.queue.Pipeline.Sources {
+		if source.Type != pipeline.SOURCE_NATS || len(broker.queue.Pipeline.GetSourceTargets(source)) == 0 {
+			continue
+		}
+
+		if source.Subject == "" {
+			log.Warn("Not subscribing to NATS source ", source.Name, " - no subject defined")
+			continue
+		}
+
+		connection, err := broker.connect(source)
+		if err != nil {
+			log.Error("Failed to connect to NATS for source ", source.Name, " ", err)
+			continue
+		}
+
+		var sourceID string = source.ID
+		subscription, err := connection.Subscribe(source.Subject, func(message *nats.Msg) {
+			broker.receive(sourceID, message)
+		})
+		if err != nil {
+			log.Error("Failed to subscribe to ", source.Subject, " ", err)
+			continue
+		}
+		log.Info("Subscribed to NATS subject ", source.Subject, " for source ", source.Name)
+		broker.subscriptions = append(broker.subscriptions, subscription)
+	}
+}
+
+// Unsubscribe : Remove all subscriptions held by the broker
+func (broker *Broker) Unsubscribe() {
+	broker.Lock()
+	defer broker.Unlock()
+	for _, subscription := range broker.subscriptions {
+		if err := subscription.Unsubscribe(); err != nil {
+			log.Error("Failed to unsubscribe from ", subscription.Subject, " ", err)
+		}
+	}
+	broker.subscriptions = make([]*nats.Subscription, 0)
+}
+
+// Close : Remove all subscriptions and close the connections held by the broker
+//
+// Connections are drained so messages already received are handled and
+// messages already published are flushed before closing.
+func (broker *Broker) Close() {
+	broker.Unsubscribe()
+
+	broker.Lock()
+	defer broker.Unlock()
+	for server, connection := range broker.connections {
+		if err := connection.Drain(); err != nil {
+			log.Error("Failed to drain connection to ", server, " ", err)
+			connection.Close()
+		}
+	}
+	broker.connections = make(map[string]*nats.Conn)
+}
+
+// Publish : Publish the final status of a queue item to any NATS sinks of its command
+func (broker *Broker) Publish(item *api.RunningItem, status string) {
+	command := broker.queue.Pipeline.GetCommand(item.CommandID)
+	if command == nil {
+		return
+	}
+
+	broker.Lock()
+	defer broker.Unlock()
+
+	for _, sink := range broker.queue.Pipeline.GetSinks(command, pipeline.SOURCE_NATS) {
+		if sink.Subject == "" {
+			continue
+		}
+
+		connection, err := broker.connect(sink)
+		if err != nil {
+			log.Error("Failed to connect to NATS for sink ", sink.Name, " ", err)
+			continue
+		}
+
+		data, _ := json.Marshal(BrokerEvent{
+			Pipeline:  broker.queue.Pipeline.Name,
+			CommandID: command.ID,
+			Command:   command.Name,
+			ID:        item.ID,
+			Key:       item.Key,
+			Status:    status,
+			Pod:       item.Pod,
+			Time:      time.Now(),
+		})
+		if err := connection.Publish(sink.Subject, data); err != nil {
+			log.Error("Failed to publish to ", sink.Subject, " ", err)
+		}
+	}
+}
+
+// receive : Convert a NATS message into a queue event for the source
+//
+// Messages which are not valid JSON are wrapped in an object containing
+// the subject and message data.
+func (broker *Broker) receive(sourceID string, message *nats.Msg) {
+	var data []byte = message.Data
+	if !json.Valid(data) {
+		data, _ = json.Marshal(map[string]string{
+			"subject": message.Subject,
+			"data":    string(message.Data),
+		})
+	}
+
+	if _, err := broker.queue.Client.PostEvent(context.Background(), broker.queue.Pipeline.Name, sourceID, json.RawMessage(data)); err != nil {
+		log.Error("Failed to queue event from NATS subject ", message.Subject, " - ", err)
+	}
+}
+
+// connect : Get or create a connection to the server used by a source
+func (broker *Broker) connect(source *pipeline.Source) (*nats.Conn, error) {
+	var server string = source.Server
+	if server == "" {
+		server = broker.queue.Config.NatsServer()
+	}
+
+	if connection, ok := broker.connections[server]; ok && !connection.IsClosed() {
+		return connection, nil
+	}
+
+	connection, err := nats.Connect(server,
+		nats.Name("tiyo-flow-"+broker.queue.Pipeline.DNSName),
+		nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, err
+	}
+	broker.connections[server] = connection
+	return connection, nil
+}
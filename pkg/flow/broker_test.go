@@ -0,0 +1,226 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package flow
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	natsd "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/notapipeline/tiyo/pkg/client"
+	"github.com/notapipeline/tiyo/pkg/config"
+	"github.com/notapipeline/tiyo/pkg/pipeline"
+	"github.com/notapipeline/tiyo/pkg/server/api"
+)
+
+// postedEvent : An event received by the mock assemble server
+type postedEvent struct {
+	path    string
+	source  string
+	payload map[string]interface{}
+}
+
+// startNats : Start an in-process NATS server on a random port
+func startNats(t *testing.T) *natsd.Server {
+	server, err := natsd.NewServer(&natsd.Options{
+		Host:   "127.0.0.1",
+		Port:   -1,
+		NoLog:  true,
+		NoSigs: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Start()
+	if !server.ReadyForConnections(5 * time.Second) {
+		t.Fatal("NATS server did not start")
+	}
+	t.Cleanup(server.Shutdown)
+	return server
+}
+
+// startAssemble : Start a mock assemble server recording posted events
+func startAssemble(t *testing.T, events chan<- postedEvent) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := postedEvent{
+			path:   r.URL.Path,
+			source: r.URL.Query().Get("source"),
+		}
+		data, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &event.payload); err != nil {
+			t.Error("event payload is not a JSON object: ", string(data))
+		}
+		events <- event
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"code":201,"message":"event-1"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestQueue : Build a queue for a pipeline of nats(in) -> command -> nats(out)
+func newTestQueue(t *testing.T, natsURL string, assembleURL string) *Queue {
+	host, port, err := net.SplitHostPort(assembleURL[len("http://"):])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.Config{}
+	cfg.Assemble.Host = host
+	cfg.Assemble.Port, _ = strconv.Atoi(port)
+	cfg.Nats.URL = natsURL
+
+	var in, out pipeline.LinkInterface = &pipeline.PathLink{
+		Link: pipeline.Link{ID: "link-in", Type: "file", Source: "source-in", Target: "command"},
+	}, &pipeline.PathLink{
+		Link: pipeline.Link{ID: "link-out", Type: "file", Source: "command", Target: "source-out"},
+	}
+
+	queue := Queue{
+		Config: &cfg,
+		Pipeline: &pipeline.Pipeline{
+			Name:    "test",
+			DNSName: "test",
+			Commands: map[string]*pipeline.Command{
+				"command": {ID: "command", Name: "command"},
+			},
+			Sources: map[string]*pipeline.Source{
+				"source-in":  {ID: "source-in", Name: "in", Type: pipeline.SOURCE_NATS, Subject: "tiyo.in"},
+				"source-out": {ID: "source-out", Name: "out", Type: pipeline.SOURCE_NATS, Subject: "tiyo.out"},
+			},
+			Links: map[string]*pipeline.LinkInterface{
+				"link-in":  &in,
+				"link-out": &out,
+			},
+		},
+		Client: client.NewAssemble(&cfg, client.WithToken(""), client.WithMachineToken(""), client.WithRetries(0, 0)),
+	}
+	queue.Broker = NewBroker(&queue)
+	return &queue
+}
+
+func TestBrokerReceive(t *testing.T) {
+	server := startNats(t)
+	events := make(chan postedEvent, 2)
+	queue := newTestQueue(t, server.ClientURL(), startAssemble(t, events).URL)
+
+	queue.Broker.Subscribe()
+	defer queue.Broker.Close()
+
+	publisher, err := nats.Connect(server.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer publisher.Close()
+
+	publisher.Publish("tiyo.in", []byte(`{"sample":"one"}`))
+	publisher.Publish("tiyo.in", []byte(`not json`))
+	publisher.Flush()
+
+	for _, expected := range []map[string]interface{}{
+		{"sample": "one"},
+		{"subject": "tiyo.in", "data": "not json"},
+	} {
+		select {
+		case event := <-events:
+			if event.path != "/api/v1/events/test" {
+				t.Errorf("event posted to %s, expected /api/v1/events/test", event.path)
+			}
+			if event.source != "source-in" {
+				t.Errorf("event posted for source %q, expected source-in", event.source)
+			}
+			for key, value := range expected {
+				if event.payload[key] != value {
+					t.Errorf("event payload %s = %v, expected %v", key, event.payload[key], value)
+				}
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+	}
+}
+
+func TestBrokerPublish(t *testing.T) {
+	server := startNats(t)
+	queue := newTestQueue(t, server.ClientURL(), startAssemble(t, make(chan postedEvent, 1)).URL)
+	defer queue.Broker.Close()
+
+	subscriber, err := nats.Connect(server.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscriber.Close()
+
+	subscription, err := subscriber.SubscribeSync("tiyo.out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	subscriber.Flush()
+
+	queue.Broker.Publish(&api.RunningItem{
+		ID:        "item",
+		Key:       "sample/file.txt",
+		CommandID: "command",
+		Pod:       "command-0",
+	}, "complete")
+
+	message, err := subscription.NextMsg(5 * time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var event BrokerEvent
+	if err := json.Unmarshal(message.Data, &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.Pipeline != "test" || event.CommandID != "command" || event.ID != "item" ||
+		event.Key != "sample/file.txt" || event.Status != "complete" || event.Pod != "command-0" {
+		t.Errorf("unexpected event published: %+v", event)
+	}
+}
+
+func TestBrokerClose(t *testing.T) {
+	server := startNats(t)
+	queue := newTestQueue(t, server.ClientURL(), startAssemble(t, make(chan postedEvent, 1)).URL)
+
+	queue.Broker.Subscribe()
+	if len(queue.Broker.connections) != 1 {
+		t.Fatalf("expected one connection, found %d", len(queue.Broker.connections))
+	}
+
+	var connections []*nats.Conn
+	for _, connection := range queue.Broker.connections {
+		connections = append(connections, connection)
+	}
+
+	queue.Stop()
+
+	if len(queue.Broker.subscriptions) != 0 {
+		t.Errorf("expected no subscriptions after stop, found %d", len(queue.Broker.subscriptions))
+	}
+	if len(queue.Broker.connections) != 0 {
+		t.Errorf("expected no connections after stop, found %d", len(queue.Broker.connections))
+	}
+	for _, connection := range connections {
+		deadline := time.Now().Add(5 * time.Second)
+		for !connection.IsClosed() && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		if !connection.IsClosed() {
+			t.Error("connection still open after stop")
+		}
+	}
+}
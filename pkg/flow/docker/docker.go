@@ -77,10 +77,14 @@ func (docker *Docker) ContainerExists(tag string) (bool, error) {
 	)
 
 	log.Info("Checking registry for ", name, " ", version)
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
 		InsecureSkipVerify: docker.Config.UseInsecureTLS,
 	}
-	http.DefaultClient.Timeout = 10 * time.Second
+	client := &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: transport,
+	}
 
 	// API is the easiest but maybe not the most versatile method of checking
 	// This will be potentially very different for artifactory/nexus/quay and
@@ -88,7 +92,7 @@ func (docker *Docker) ContainerExists(tag string) (bool, error) {
 	// different endpoint.
 	for {
 		log.Debug("Making request to ", address)
-		response, err = http.Get(address)
+		response, err = client.Get(address)
 		if err, ok := err.(net.Error); ok && err.Timeout() || errors.Is(err, context.DeadlineExceeded) {
 			// This is probably temporary and a retry
 			// will allow it to succeed.
@@ -17,20 +17,21 @@
 package flow
 
 import (
-	"bytes"
-	"crypto/tls"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/notapipeline/tiyo/pkg/client"
+	"github.com/notapipeline/tiyo/pkg/tracing"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/notapipeline/tiyo/pkg/config"
@@ -38,7 +39,6 @@ import (
 	kube "github.com/notapipeline/tiyo/pkg/flow/kubernetes"
 
 	"github.com/notapipeline/tiyo/pkg/pipeline"
-	"github.com/notapipeline/tiyo/pkg/server/api"
 )
 
 // Flow : Main structure of the Flow subsystem
@@ -65,6 +65,9 @@ type Flow struct {
 	// The queue system for managing event handoff from assemble to syphon
 	Queue *Queue
 
+	// Client for the assemble API
+	Assemble *client.Assemble
+
 	// Is the pipeline being executed
 	IsExecuting bool
 
@@ -129,7 +132,13 @@ func (flow *Flow) Create(instance *pipeline.Command) error {
 		if err := flow.WriteConfig(); err != nil {
 			return flow.Cleanup(path, owd, err)
 		}
+		start := time.Now()
 		err = flow.Docker.Create(instance)
+		var result string = "success"
+		if err != nil {
+			result = "failure"
+		}
+		buildDuration.WithLabelValues(flow.Pipeline.Name, result).Observe(time.Since(start).Seconds())
 		if err != nil {
 			return flow.Cleanup(path, owd, err)
 		}
@@ -142,7 +151,7 @@ func (flow *Flow) Create(instance *pipeline.Command) error {
 func (flow *Flow) Cleanup(path string, owd string, err error) error {
 	os.Chdir(owd)
 	if e := os.RemoveAll(path); e != nil {
-		log.Error("Failed to clean up %s - manual intervention required\n", path)
+		log.Errorf("Failed to clean up %s - manual intervention required", path)
 	}
 	return err
 }
@@ -221,15 +230,19 @@ func (flow *Flow) WriteConfig() error {
 		ClientSecure: flow.Config.Flow.Cacert != "" && flow.Config.Flow.Cakey != "",
 	}
 	config := struct {
-		SequenceBaseDir string      `json:"sequenceBaseDir"`
-		UseInsecureTLS  bool        `json:"skipVerify"`
-		Flow            config.Host `json:"flow"`
-		AppName         string      `json:"appname"`
+		SequenceBaseDir string         `json:"sequenceBaseDir"`
+		UseInsecureTLS  bool           `json:"skipVerify"`
+		Flow            config.Host    `json:"flow"`
+		AppName         string         `json:"appname"`
+		Metrics         config.Metrics `json:"metrics"`
+		Tracing         config.Tracing `json:"tracing"`
 	}{
 		SequenceBaseDir: flow.Config.SequenceBaseDir,
 		UseInsecureTLS:  flow.Config.UseInsecureTLS,
 		Flow:            host,
 		AppName:         filepath.Base(path),
+		Metrics:         flow.Config.Metrics,
+		Tracing:         flow.Config.Tracing,
 	}
 	bytes, err := json.Marshal(config)
 	if err != nil {
@@ -249,6 +262,8 @@ func (flow *Flow) Setup(pipelineName string) bool {
 	if !flow.LoadPipeline(pipelineName) {
 		return false
 	}
+	flow.Assemble = client.NewAssemble(flow.Config)
+
 	// Create the queue
 	flow.Queue = NewQueue(flow.Config, flow.Pipeline, flow.Pipeline.BucketName)
 
@@ -293,6 +308,12 @@ func (flow *Flow) Execute() {
 		}
 	}
 
+	// containers read their token from a secret so it stays out of the images
+	if err := flow.Kubernetes.CreateTokenSecret(); err != nil {
+		log.Error(err)
+		return
+	}
+
 	// Create the pipeline runtime engine
 	// Each of these needs a level of error reporting enabling
 	// other than "panic"
@@ -314,40 +335,9 @@ func (flow *Flow) Execute() {
 // Find : Find a pipeline by name and return a new Flow object with the pipeline embedded
 func (flow *Flow) Find(name string, config *config.Config) *Flow {
 	log.Debug("Searching for pipeline matching ", name)
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{
-		InsecureSkipVerify: config.UseInsecureTLS,
-	}
 
 	// really wants to be a "keys" list rather than a full scan
-	var url string = fmt.Sprintf("%s/api/v1/scan/pipeline", config.AssembleServer())
-	request, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		log.Error(err)
-		return nil
-	}
-
-	client := &http.Client{
-		Timeout: config.TIMEOUT,
-	}
-	response, err := client.Do(request)
-	if err != nil {
-		log.Error(err)
-		return nil
-	}
-
-	defer response.Body.Close()
-	message := struct {
-		Code    int                    `json:"code"`
-		Result  string                 `json:"result"`
-		Message map[string]interface{} `json:"message"`
-	}{}
-
-	body, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		log.Error(err)
-		return nil
-	}
-	err = json.Unmarshal(body, &message)
+	pipelines, err := client.NewAssemble(config).Scan(context.Background(), "pipeline", "", "")
 	if err != nil {
 		log.Error(err)
 		return nil
@@ -355,7 +345,7 @@ func (flow *Flow) Find(name string, config *config.Config) *Flow {
 
 	var newFlow *Flow = NewFlow()
 	newFlow.Config = config
-	for key := range message.Message["keys"].(map[string]interface{}) {
+	for key := range pipelines.Keys {
 		log.Debug(key)
 		var pipelineName string = pipeline.Sanitize(key, "-")
 		log.Debug(pipelineName, " ", name)
@@ -383,6 +373,7 @@ func (flow *Flow) Start() {
 func (flow *Flow) Destroy() {
 	flow.Stop()
 	log.Warn("Destroying flow for ", flow.Pipeline.Name)
+	pods.forget(flow.Pipeline.Name)
 	for _, item := range flow.Pipeline.Containers {
 		switch item.SetType {
 		case "statefulset":
@@ -418,6 +409,7 @@ func (flow *Flow) Run() int {
 		log.Error("issue loading config file: ", err)
 		return 1
 	}
+	defer tracing.Init(flow.Config, config.Designate)()
 
 	log.Info("Setting working directory to ", flow.Config.DbDir)
 	os.Chdir(flow.Config.DbDir)
@@ -455,92 +447,18 @@ func (flow *Flow) triggerServices() {
 
 			log.Info("Triggering service command for ", instance.Name)
 			var commandKey string = instance.GetContainer(true) + ":" + container.Name
-			var contents map[string]string = map[string]string{
-				"bucket": "queue",
-				"child":  flow.Pipeline.BucketName,
-				"key":    commandKey,
-				"value":  instance.ID,
-			}
-			data, _ := json.Marshal(contents)
-
-			var address string = flow.Config.AssembleServer() + "/api/v1/bucket"
-			request, err := http.NewRequest(
-				http.MethodPut,
-				address,
-				bytes.NewBuffer(data))
-
-			if err != nil {
-				log.Error(err)
-			}
-			request.Header.Set("Content-Type", "application/json; charset=utf-8")
-			request.Header.Set("Connection", "close")
-			request.Close = true
-
-			client := &http.Client{
-				Timeout: config.TIMEOUT,
-			}
-			response, err := client.Do(request)
-			if err != nil {
-				log.Error(err)
-			} else if response.StatusCode > 204 {
-				log.Error("Received unknown response code ", response.StatusCode, " for address ", address)
+			if err := flow.Assemble.Put(context.Background(), "queue", flow.Pipeline.BucketName, commandKey, instance.ID); err != nil {
+				log.Error("Failed to queue service command ", commandKey, " ", err)
+				continue
 			}
 			log.Info("Queued command ", commandKey)
 		}
 	}
 }
 
-type DecryptBody struct {
-	Value string `json:"value"`
-	Token string `json:"token"`
-}
-
 // Decrypt : Sends a string back to assemble for decryption
 func (flow *Flow) Decrypt(what string) (string, error) {
-	var message string = ""
-	var content DecryptBody = DecryptBody{
-		Value: what,
-		Token: flow.Config.GetPassphrase("flow"),
-	}
-	data, _ := json.Marshal(content)
-
-	var address string = flow.Config.AssembleServer() + "/api/v1/decrypt"
-	request, err := http.NewRequest(
-		http.MethodPost,
-		address,
-		bytes.NewBuffer(data))
-
-	if err != nil {
-		return message, err
-	}
-
-	request.Header.Set("Content-Type", "application/json; charset=utf-8")
-	request.Header.Set("Connection", "close")
-	request.Close = true
-
-	client := &http.Client{
-		Timeout: config.TIMEOUT,
-	}
-	response, err := client.Do(request)
-	if err != nil {
-		return message, err
-	} else if response.StatusCode != 200 {
-		return message, fmt.Errorf("Failed to decrypt password - %d ", response.StatusCode)
-	}
-
-	var body []byte
-	defer response.Body.Close()
-	body, err = ioutil.ReadAll(response.Body)
-	if err != nil {
-		return message, err
-	}
-
-	result := api.Result{}
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return message, err
-	}
-	return result.Message.(string), nil
+	return flow.Assemble.Decrypt(context.Background(), what, flow.Config.GetPassphrase("flow"))
 }
 
 // Clones a git repository for each container in the set that has a git repo described
@@ -46,6 +46,7 @@ func (kube *Kubernetes) GetDeploymentContainers(instances []*pipeline.Command) [
 			Image:           instance.Tag,
 			ImagePullPolicy: corev1.PullAlways,
 			Ports:           kube.GetContainerPorts(instance),
+			Env:             kube.GetTokenEnv(),
 		}
 		containers = append(containers, container)
 	}
@@ -7,14 +7,12 @@
 package kubernetes
 
 import (
-	"encoding/json"
-	"io/ioutil"
-	"net/http"
+	"context"
 	"strings"
 
+	"github.com/notapipeline/tiyo/pkg/client"
 	"github.com/notapipeline/tiyo/pkg/config"
 	"github.com/notapipeline/tiyo/pkg/pipeline"
-	serverApi "github.com/notapipeline/tiyo/pkg/server/api"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
@@ -59,6 +57,9 @@ type Kubernetes struct {
 
 	// The pipeline for the current build
 	Pipeline *pipeline.Pipeline
+
+	// Client for the assemble API
+	Assemble *client.Assemble
 }
 
 // NewKubernetes : Create a new Kubernetes engine
@@ -67,6 +68,7 @@ func NewKubernetes(config *config.Config, pipeline *pipeline.Pipeline) (*Kuberne
 	kube := Kubernetes{
 		Pipeline: pipeline,
 		Config:   config,
+		Assemble: client.NewAssemble(config),
 	}
 
 	var err error
@@ -92,46 +94,19 @@ func NewKubernetes(config *config.Config, pipeline *pipeline.Pipeline) (*Kuberne
 //
 // If no error is detected, the state will be one of "ready"|"busy"
 func (kube *Kubernetes) getStateFromDb(podname string, image string) string {
-	var slice []string = strings.Split(image, "/")
 	var (
-		state   string = "Running"
-		name    string = slice[len(slice)-1] + ":" + podname
-		address        = kube.Config.AssembleServer() + "/api/v1/bucket/pods/" + kube.Pipeline.BucketName + "/" + name
+		slice []string = strings.Split(image, "/")
+		name  string   = slice[len(slice)-1] + ":" + podname
 	)
 
-	request, err := http.NewRequest("GET", address, nil)
-	if err != nil {
-		log.Error(err)
-		return state
-	}
-	request.Header.Set("Content-Type", "application/json; charset=utf-8")
-	request.Header.Set("Accept", "application/json")
-	request.Header.Set("Connection", "close")
-	request.Close = true
-	client := &http.Client{
-		Timeout: config.TIMEOUT,
-	}
-	response, err := client.Do(request)
+	state, err := kube.Assemble.Get(context.Background(), "pods", kube.Pipeline.BucketName, name)
 	if err != nil {
-		log.Error(err)
-		return state
-	}
-	defer response.Body.Close()
-	if response.StatusCode == 200 {
-		body, err := ioutil.ReadAll(response.Body)
-		if err != nil {
-			log.Error(err)
-			return state
-		}
-		contents := serverApi.Result{}
-		err = json.Unmarshal(body, &contents)
-		if err != nil {
+		// no state is reported until the pod first registers
+		if _, ok := err.(*client.Error); !ok {
 			log.Error(err)
-			return state
 		}
-		state = string(contents.Message.(string))
+		return "Running"
 	}
-
 	return state
 }
 
@@ -0,0 +1,75 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/notapipeline/tiyo/pkg/config"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// The secret holding the token syphon presents to flow
+	TOKEN_SECRET string = "tiyo-syphon-token"
+
+	// The key of the token within TOKEN_SECRET
+	TOKEN_SECRET_KEY string = "token"
+)
+
+// CreateTokenSecret : Create or update the secret holding the syphon token
+//
+// The token is handed to containers through TOKEN_SECRET when they are
+// deployed rather than built into their images, where anyone able to pull
+// the image could read it.
+func (kube *Kubernetes) CreateTokenSecret() error {
+	token, ok := kube.Config.Tokens["syphon"]
+	if !ok || token.Token == "" {
+		return fmt.Errorf("No token for syphon - set tokens.syphon.token in the config")
+	}
+
+	client := kube.ClientSet.CoreV1().Secrets(kube.Config.Kubernetes.Namespace)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TOKEN_SECRET,
+			Namespace: kube.Config.Kubernetes.Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		StringData: map[string]string{
+			TOKEN_SECRET_KEY: token.Token,
+		},
+	}
+
+	_, err := client.Create(context.TODO(), secret, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		_, err = client.Update(context.TODO(), secret, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to store the syphon token in secret %s - %s", TOKEN_SECRET, err)
+	}
+	log.Info("Stored the syphon token in secret ", TOKEN_SECRET)
+	return nil
+}
+
+// GetTokenEnv : Get the environment passing the syphon token to a container
+func (kube *Kubernetes) GetTokenEnv() []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name: config.TOKEN_ENV,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: TOKEN_SECRET},
+					Key:                  TOKEN_SECRET_KEY,
+				},
+			},
+		},
+	}
+}
@@ -50,6 +50,7 @@ func (kube *Kubernetes) GetStatefulSetContainers(instances []*pipeline.Command)
 			Image:           instance.Tag,
 			ImagePullPolicy: corev1.PullAlways,
 			Ports:           kube.GetContainerPorts(instance),
+			Env:             kube.GetTokenEnv(),
 			VolumeMounts:    kube.GetVolumeMountForNamespace(kube.Config.Kubernetes.Namespace),
 			Resources: corev1.ResourceRequirements{
 				Requests: corev1.ResourceList{
@@ -0,0 +1,97 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package flow
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Metrics exported by flow
+var (
+	podStates = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "tiyo",
+		Subsystem: "flow",
+		Name:      "pods",
+		Help:      "The number of syphon pods by the state they last registered",
+	}, []string{"pipeline", "state"})
+
+	registrations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tiyo",
+		Subsystem: "flow",
+		Name:      "registrations_total",
+		Help:      "Registration requests received from syphon",
+	}, []string{"pipeline", "state"})
+
+	dispatchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "tiyo",
+		Subsystem: "flow",
+		Name:      "dispatch_duration_seconds",
+		Help:      "Time taken to retrieve a queue item from assemble for a syphon pod",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"pipeline", "result"})
+
+	buildDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "tiyo",
+		Subsystem: "flow",
+		Name:      "docker_build_duration_seconds",
+		Help:      "Time taken to build and push container images",
+		Buckets:   []float64{10, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+	}, []string{"pipeline", "result"})
+
+	pods = podTracker{
+		states: make(map[string]map[string]string),
+	}
+)
+
+// podTracker : The state each pod last registered, used for the pods gauge
+type podTracker struct {
+	sync.Mutex
+
+	// pipeline -> pod -> state
+	states map[string]map[string]string
+}
+
+// set : Record the state of a pod and update the gauge for its pipeline
+func (tracker *podTracker) set(pipelineName string, pod string, state string) {
+	tracker.Lock()
+	defer tracker.Unlock()
+
+	if _, ok := tracker.states[pipelineName]; !ok {
+		tracker.states[pipelineName] = make(map[string]string)
+	}
+	tracker.states[pipelineName][pod] = strings.ToLower(state)
+	tracker.update(pipelineName)
+}
+
+// forget : Remove all pods of a pipeline, for example when it is destroyed
+func (tracker *podTracker) forget(pipelineName string) {
+	tracker.Lock()
+	defer tracker.Unlock()
+
+	delete(tracker.states, pipelineName)
+	tracker.update(pipelineName)
+}
+
+// update : Recount the pods in each state of a pipeline
+//
+// Must be called with the lock held
+func (tracker *podTracker) update(pipelineName string) {
+	counts := map[string]int{
+		"ready": 0,
+		"busy":  0,
+	}
+	for _, state := range tracker.states[pipelineName] {
+		counts[state]++
+	}
+	for state, count := range counts {
+		podStates.WithLabelValues(pipelineName, state).Set(float64(count))
+	}
+}
@@ -7,15 +7,15 @@
 package flow
 
 import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io/ioutil"
+	"context"
 	"net/http"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/notapipeline/tiyo/pkg/client"
 	"github.com/notapipeline/tiyo/pkg/config"
+	"github.com/notapipeline/tiyo/pkg/logging"
 	"github.com/notapipeline/tiyo/pkg/pipeline"
 	"github.com/notapipeline/tiyo/pkg/server/api"
 
@@ -42,6 +42,9 @@ type Queue struct {
 	// A bucket to store non-filesystem events in
 	EventsBucket string
 
+	// A bucket tracking queue items currently being executed
+	RunningBucket string
+
 	// The pipeline bucket to assign against
 	PipelineBucket string
 
@@ -51,8 +54,11 @@ type Queue struct {
 	// The pipeline used by the queue
 	Pipeline *pipeline.Pipeline
 
-	// HTTP client
-	Client *http.Client
+	// Client for the assemble API
+	Client *client.Assemble
+
+	// Message broker handling NATS sources and sinks
+	Broker *Broker
 
 	// Is the queue stopped or not
 	Stopped bool
@@ -66,14 +72,14 @@ func NewQueue(config *config.Config, pipeline *pipeline.Pipeline, bucket string)
 		FilesBucket:    "files",
 		PodBucket:      "pods",
 		EventsBucket:   "events",
+		RunningBucket:  api.RUNNING_BUCKET,
 		PipelineBucket: bucket,
 		Config:         config,
 		Pipeline:       pipeline,
-		Client: &http.Client{
-			Timeout: config.TIMEOUT,
-		},
-		Stopped: true,
+		Client:         client.NewAssemble(config),
+		Stopped:        true,
 	}
+	queue.Broker = NewBroker(&queue)
 	queue.createBuckets()
 	return &queue
 }
@@ -82,21 +88,31 @@ func NewQueue(config *config.Config, pipeline *pipeline.Pipeline, bucket string)
 // queue management is here.
 
 // Register : Registers a container into the queue executors
-func (queue *Queue) Register(request map[string]interface{}) *api.Result {
+func (queue *Queue) Register(ctx context.Context, request map[string]interface{}) *api.Result {
 	var key string = request["container"].(string) + ":" + request["pod"].(string)
-	log.Infof("Recieved registration request from %s", key)
-	log.Debugf("Registration request body: %+v", request)
+	queue.logger(ctx).Infof("Recieved registration request from %s", key)
+	queue.logger(ctx).Debugf("Registration request body: %+v", request)
+
+	var status string = request["status"].(string)
+	registrations.WithLabelValues(queue.Pipeline.Name, strings.ToLower(status)).Inc()
+	pods.set(queue.Pipeline.Name, request["pod"].(string), status)
 
-	data := queue.jsonBody(queue.PodBucket, key, request["status"].(string))
-	result := queue.put(data)
-	if request["status"] == "Ready" {
+	result := queue.put(ctx, queue.PodBucket, key, status)
+	if status == "Ready" {
 		var (
 			code    int
 			message *api.QueueItem = nil
 		)
 		if !queue.Stopped {
-			code, message = queue.GetQueueItem(request["container"].(string), request["pod"].(string))
+			start := time.Now()
+			code, message = queue.GetQueueItem(ctx, request["container"].(string), request["pod"].(string))
 			result.Code = code
+
+			var dispatched string = "empty"
+			if message != nil && message.ID != "" {
+				dispatched = "dispatched"
+			}
+			dispatchDuration.WithLabelValues(queue.Pipeline.Name, dispatched).Observe(time.Since(start).Seconds())
 		}
 		if message != nil {
 			result.Message = *message
@@ -110,31 +126,27 @@ func (queue *Queue) Register(request map[string]interface{}) *api.Result {
 // Stop : stops the current queue
 func (queue *Queue) Stop() {
 	queue.Stopped = true
+	queue.Broker.Close()
 }
 
 // Start : starts the current queue as a background process
 func (queue *Queue) Start() {
 	go queue.perpetual()
+	go queue.Broker.Subscribe()
 }
 
 // GetQueueItem : Get a command to execute
-func (queue *Queue) GetQueueItem(container string, pod string) (int, *api.QueueItem) {
-	serverAddress := queue.Config.AssembleServer()
-
+func (queue *Queue) GetQueueItem(ctx context.Context, container string, pod string) (int, *api.QueueItem) {
 	var key string = container + ":" + pod
-	log.Infof("Retrieving queue item for %s", key)
-	req, err := http.NewRequest(http.MethodGet,
-		serverAddress+"/api/v1/popqueue/"+queue.Pipeline.Name+"/"+key, nil)
-	if err != nil {
-		panic(err)
-	}
-	req.Header.Set("Accept", "application/json")
-	code, body := queue.makeRequest(req)
+	queue.logger(ctx).Infof("Retrieving queue item for %s", key)
 
 	item := api.QueueItem{}
-	err = json.Unmarshal(body, &item)
+	code, err := queue.Client.PopQueue(ctx, queue.Pipeline.Name, key, &item)
 	if err != nil {
-		log.Error(err)
+		queue.logger(ctx).Error(err)
+		return code, nil
+	}
+	if code != http.StatusOK {
 		return code, nil
 	}
 
@@ -150,91 +162,99 @@ func (queue *Queue) GetQueueItem(container string, pod string) (int, *api.QueueI
 	return code, &item
 }
 
-// put : Put data into the bolt store
-func (queue *Queue) put(request []byte) *api.Result {
-	result := api.NewResult()
-	result.Code = 204
-	result.Result = "No content"
-	result.Message = ""
+// Heartbeat : Check if a queue item held by a syphon executor should continue
+//
+// Returns true if the item has been cancelled in assemble
+func (queue *Queue) Heartbeat(ctx context.Context, id string) bool {
+	item := queue.getRunning(ctx, id)
+	return item != nil && item.Status == api.STATE_CANCELLING
+}
 
-	serverAddress := queue.Config.AssembleServer()
-	req, err := http.NewRequest(
-		http.MethodPut,
-		fmt.Sprintf("%s/api/v1/bucket", serverAddress),
-		bytes.NewBuffer(request))
+// Complete : Record the final status of a queue item in assemble
+//
+// Once recorded, the status is published to any message broker sinks
+// attached to the command.
+func (queue *Queue) Complete(ctx context.Context, request *api.CompleteRequest) int {
+	item := queue.getRunning(ctx, request.ID)
+
+	request.Pipeline = queue.Pipeline.Name
+	code, err := queue.Client.Complete(ctx, request)
 	if err != nil {
-		log.Fatal(err)
+		queue.logger(ctx).Error(err)
+	}
+	if item != nil {
+		go queue.Broker.Publish(item, request.Status)
 	}
-	req.Header.Set("Content-Type", "application/json; charset=utf-8")
-	req.Header.Set("Connection", "close")
-	req.Close = true
+	return statusCode(code, err)
+}
 
-	code, _ := queue.makeRequest(req)
-	result.Code = code
-	return result
+// Logs : Relay a batch of command output to assemble
+func (queue *Queue) Logs(ctx context.Context, id string, final bool, chunks []api.LogChunk) int {
+	code, err := queue.Client.PostLogs(ctx, api.LogRequest{
+		Pipeline: queue.Pipeline.Name,
+		ID:       id,
+		Final:    final,
+		Chunks:   chunks,
+	})
+	if err != nil {
+		queue.logger(ctx).Error(err)
+	}
+	return statusCode(code, err)
 }
 
-// makeRequest : Retries a HTTP request up to 5 times
-func (queue *Queue) makeRequest(request *http.Request) (int, []byte) {
-	var (
-		maxRetries int = 5
-		retries    int = maxRetries
-		err        error
-		response   *http.Response
-		body       []byte
-	)
-	for retries > 0 {
-		response, err = queue.Client.Do(request)
-		if err == nil {
-			break
+// getRunning : Get a running queue item from assemble
+//
+// returns nil if the item is not running
+func (queue *Queue) getRunning(ctx context.Context, id string) *api.RunningItem {
+	item := api.RunningItem{}
+	if err := queue.Client.GetRunning(ctx, queue.Pipeline.Name, id, &item); err != nil {
+		if failure, ok := err.(*client.Error); !ok || failure.Code != http.StatusNotFound {
+			queue.logger(ctx).Error(err)
 		}
-		retries--
-	}
-	if err != nil {
-		log.Error(err)
-		return 500, nil
+		return nil
 	}
+	return &item
+}
+
+// put : Put a value into a bucket of this pipeline
+func (queue *Queue) put(ctx context.Context, bucket string, key string, value string) *api.Result {
+	result := api.NewResult()
+	result.Code = 204
+	result.Result = "No content"
+	result.Message = ""
 
-	defer response.Body.Close()
-	body, err = ioutil.ReadAll(response.Body)
+	err := queue.Client.Put(ctx, filepath.Base(bucket), queue.PipelineBucket, key, value)
 	if err != nil {
-		log.Error(err)
-		return response.StatusCode, nil
+		queue.logger(ctx).Error(err)
 	}
-	return response.StatusCode, body
+	result.Code = statusCode(http.StatusNoContent, err)
+	return result
 }
 
-// jsonBody : construct the jsonBody for writing information into an event bucket
-func (queue *Queue) jsonBody(bucket string, key string, value string) []byte {
-	bucket = filepath.Base(bucket)
-	values := map[string]string{
-		"bucket": bucket,
-		"child":  queue.PipelineBucket,
-		"key":    key,
-		"value":  value,
-	}
+// logger : Get a log entry carrying the pipeline and the identifiers held in ctx
+func (queue *Queue) logger(ctx context.Context) *log.Entry {
+	return logging.Entry(ctx).WithField(logging.FIELD_PIPELINE, queue.Pipeline.Name)
+}
 
-	jsonValue, _ := json.Marshal(values)
-	return jsonValue
+// statusCode : Get the status code to relay for a request made by the client
+//
+// Requests which failed to reach assemble are reported as 500
+func statusCode(code int, err error) int {
+	if failure, ok := err.(*client.Error); ok {
+		return failure.Code
+	} else if err != nil && code == 0 {
+		return http.StatusInternalServerError
+	}
+	return code
 }
 
 // createBuckets : Create any missing queue buckets for this pipeline
 func (queue *Queue) createBuckets() {
-	buckets := []string{queue.PodBucket, queue.EventsBucket, queue.FilesBucket, queue.QueueBucket}
+	buckets := []string{queue.PodBucket, queue.EventsBucket, queue.FilesBucket, queue.QueueBucket, queue.RunningBucket}
 	for _, bucket := range buckets {
-		content := make(map[string]string)
-		content["bucket"] = bucket
-		content["child"] = queue.PipelineBucket
-		body, err := json.Marshal(content)
-		if err != nil {
+		if err := queue.Client.CreateBucket(context.Background(), bucket, queue.PipelineBucket); err != nil {
 			log.Error("Failed to create bucket ", bucket, "/", queue.PipelineBucket, " : ", err)
 		}
-		serverAddress := queue.Config.AssembleServer()
-		request, _ := http.NewRequest(http.MethodPost, serverAddress+"/api/v1/bucket", bytes.NewBuffer(body))
-		request.Header.Set("Content-Type", "application/json; charset=utf-8")
-		request.Header.Set("Connection", "close")
-		request.Close = true
-		queue.makeRequest(request)
 	}
 }
 
@@ -255,36 +275,9 @@ func (queue *Queue) perpetual() {
 
 		first = false
 		log.Info("Updating queue for ", queue.Pipeline.Name)
-		content := make(map[string]interface{})
-		content["pipeline"] = queue.Pipeline.Name
-		content["maxitems"] = MAXQUEUE
-		data, _ := json.Marshal(content)
-
-		serverAddress := queue.Config.AssembleServer()
-		request, err := http.NewRequest(
-			http.MethodPost,
-			serverAddress+"/api/v1/perpetualqueue",
-			bytes.NewBuffer(data))
-
-		if err != nil {
-			log.Error(err)
-			continue
-		}
-		request.Header.Set("Content-Type", "application/json; charset=utf-8")
-		request.Header.Set("Connection", "close")
-		request.Close = true
-
-		response, err := queue.Client.Do(request)
-		if err != nil {
-			log.Error(err)
-			continue
-		}
-
-		if response.StatusCode != http.StatusAccepted {
-			log.Error("Error during processing queue ", response)
-			continue
+		if err := queue.Client.PerpetualQueue(context.Background(), queue.Pipeline.Name, MAXQUEUE); err != nil {
+			log.Error("Error during processing queue ", err)
 		}
-		response.Body.Close()
 	}
 	log.Info("Queue terminated ", queue.Pipeline.Name)
 	queue.Stopped = true
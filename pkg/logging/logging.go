@@ -0,0 +1,217 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package logging : Log format, rotation and correlation shared by the tiyo components
+//
+// The log format is controlled by the environment variable TIYO_LOG_FORMAT.
+// If set to `json`, every subcommand writes one JSON object per line,
+// otherwise logs are written as plain text.
+//
+// Log files written to /var/log/tiyo are rotated once they reach
+// TIYO_LOG_MAXSIZE megabytes (default 100) and rotated files are removed
+// after TIYO_LOG_MAXAGE days (default 28), keeping at most
+// TIYO_LOG_MAXBACKUPS files (default 10).
+//
+// Log lines written through Entry carry the request ID, the run, and any
+// pipeline, command and queue item held in the context. Request IDs are
+// sent on to the next component in the X-Request-ID header and runs are
+// carried as trace baggage, so a file can be followed through the logs of
+// every component it passes through.
+package logging
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/baggage"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// LOG_DIR : The directory server logs are written to
+const LOG_DIR = "/var/log/tiyo"
+
+// REQUEST_ID_HEADER : The header carrying the request ID between components
+const REQUEST_ID_HEADER = "X-Request-ID"
+
+// RUN_BAGGAGE : The baggage member carrying the run ID between components
+const RUN_BAGGAGE = "tiyo.run"
+
+// Fields added to log lines
+const (
+	FIELD_REQUEST  = "request"
+	FIELD_RUN      = "run"
+	FIELD_PIPELINE = "pipeline"
+	FIELD_COMMAND  = "command"
+	FIELD_ITEM     = "item"
+)
+
+// Rotation defaults
+const (
+	MAXSIZE    = 100
+	MAXAGE     = 28
+	MAXBACKUPS = 10
+)
+
+type contextKey int
+
+const (
+	requestKey contextKey = iota
+	fieldsKey
+)
+
+// Setup : Configure the log format of the standard logger
+func Setup() {
+	log.SetFormatter(Formatter())
+}
+
+// Formatter : Get the formatter selected by TIYO_LOG_FORMAT
+func Formatter() log.Formatter {
+	if os.Getenv("TIYO_LOG_FORMAT") == "json" {
+		return &log.JSONFormatter{
+			TimestampFormat: "2006-01-02T15:04:05.000Z07:00",
+		}
+	}
+	return &log.TextFormatter{
+		DisableColors:   true,
+		FullTimestamp:   true,
+		TimestampFormat: "2006-01-02 15:04:05",
+	}
+}
+
+// Rotate : Get a writer for the named log file inside LOG_DIR which rotates by size and age
+func Rotate(name string) io.Writer {
+	return &lumberjack.Logger{
+		Filename:   filepath.Join(LOG_DIR, name+".log"),
+		MaxSize:    fromEnv("TIYO_LOG_MAXSIZE", MAXSIZE),
+		MaxAge:     fromEnv("TIYO_LOG_MAXAGE", MAXAGE),
+		MaxBackups: fromEnv("TIYO_LOG_MAXBACKUPS", MAXBACKUPS),
+		Compress:   true,
+	}
+}
+
+// fromEnv : Read a positive integer from the environment, falling back to value
+func fromEnv(name string, value int) int {
+	if setting, err := strconv.Atoi(os.Getenv(name)); err == nil && setting > 0 {
+		return setting
+	}
+	return value
+}
+
+// WithRequestID : Add a request ID to ctx
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestKey, id)
+}
+
+// RequestID : Get the request ID held in ctx, empty if there is none
+func RequestID(ctx context.Context) string {
+	if id, ok := ctx.Value(requestKey).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// StartRun : Begin a new run unless ctx already belongs to one
+//
+// A run covers a single file or event from the moment it enters the
+// pipeline until every command triggered by it has completed.
+func StartRun(ctx context.Context) context.Context {
+	if Run(ctx) != "" {
+		return ctx
+	}
+	member, err := baggage.NewMember(RUN_BAGGAGE, uuid.New().String())
+	if err != nil {
+		return ctx
+	}
+	bag, err := baggage.FromContext(ctx).SetMember(member)
+	if err != nil {
+		return ctx
+	}
+	return baggage.ContextWithBaggage(ctx, bag)
+}
+
+// Run : Get the run ctx belongs to, empty if there is none
+func Run(ctx context.Context) string {
+	return baggage.FromContext(ctx).Member(RUN_BAGGAGE).Value()
+}
+
+// With : Add fields to every line logged through Entry with the returned context
+func With(ctx context.Context, fields log.Fields) context.Context {
+	merged := make(log.Fields)
+	if current, ok := ctx.Value(fieldsKey).(log.Fields); ok {
+		for k, v := range current {
+			merged[k] = v
+		}
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, fieldsKey, merged)
+}
+
+// Entry : Get a log entry carrying the identifiers held in ctx
+func Entry(ctx context.Context) *log.Entry {
+	fields := make(log.Fields)
+	if current, ok := ctx.Value(fieldsKey).(log.Fields); ok {
+		for k, v := range current {
+			fields[k] = v
+		}
+	}
+	if id := RequestID(ctx); id != "" {
+		fields[FIELD_REQUEST] = id
+	}
+	if run := Run(ctx); run != "" {
+		fields[FIELD_RUN] = run
+	}
+	return log.WithFields(fields)
+}
+
+// Middleware : Assign each request an ID, keeping any ID sent by the caller
+//
+// The ID is returned to the caller in the X-Request-ID header.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var id string = c.GetHeader(REQUEST_ID_HEADER)
+		if id == "" || len(id) > 128 {
+			id = uuid.New().String()
+		}
+		c.Header(REQUEST_ID_HEADER, id)
+		c.Request = c.Request.WithContext(WithRequestID(c.Request.Context(), id))
+		c.Next()
+	}
+}
+
+// transport : An http.RoundTripper which sends the request ID on to the next component
+type transport struct {
+	base http.RoundTripper
+}
+
+// Transport : Wrap base so requests carry the request ID held in their context
+//
+// If base is nil, http.DefaultTransport is used.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	return &transport{base: base}
+}
+
+// RoundTrip : Send the request with its request ID
+func (transport *transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	var base http.RoundTripper = transport.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if id := RequestID(request.Context()); id != "" && request.Header.Get(REQUEST_ID_HEADER) == "" {
+		request = request.Clone(request.Context())
+		request.Header.Set(REQUEST_ID_HEADER, id)
+	}
+	return base.RoundTrip(request)
+}
@@ -0,0 +1,165 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package machine acts as a sub-command, enrolling a flow host with
+// assemble and managing the machine token it signs requests with.
+//
+// Enrolling requires a token granted the admin scope, normally a personal
+// API token passed in TIYO_TOKEN. Once enrolled, the token is written to
+// `machine.token` in the config directory where the tiyo clients on the
+// host pick it up. Rotating and checking the token are done with requests
+// signed by the token itself.
+package machine
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/notapipeline/tiyo/pkg/client"
+	"github.com/notapipeline/tiyo/pkg/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Actions accepted by the machine command
+const (
+	ENROL  string = "enrol"
+	ROTATE string = "rotate"
+	STATUS string = "status"
+)
+
+// Machine : Primary structure of the machine command
+type Machine struct {
+
+	// Configuration of the machine command
+	Config *config.Config
+
+	// The action to take. One of enrol, rotate or status
+	Action string
+
+	// The address to enrol. Defaults to the address assemble sees the host at
+	Address string
+
+	// The file the machine token is written to
+	Output string
+
+	// Command flags
+	Flags *flag.FlagSet
+}
+
+// NewMachine : Create a new machine command
+func NewMachine() *Machine {
+	machine := Machine{}
+	var err error
+	machine.Config, err = config.NewConfig()
+	if err != nil {
+		log.Panic(err)
+	}
+	return &machine
+}
+
+// Init the command according to the flags provided
+func (machine *Machine) Init() {
+	machine.Flags = flag.NewFlagSet("machine", flag.ExitOnError)
+	machine.Flags.StringVar(&machine.Address, "a", "", "The address to enrol. Defaults to the address of this host as seen by assemble")
+	machine.Flags.StringVar(&machine.Output, "o", machine.Config.MachineTokenFile(), "The file to write the machine token to")
+	machine.Flags.Usage = func() {
+		fmt.Fprintf(machine.Flags.Output(), "USAGE: %s machine [FLAGS] [%s|%s|%s]:\n", filepath.Base(os.Args[0]), ENROL, ROTATE, STATUS)
+		machine.Flags.PrintDefaults()
+	}
+	machine.Flags.Parse(os.Args[2:])
+
+	machine.Action = machine.Flags.Arg(0)
+	if machine.Action == "" {
+		machine.Action = ENROL
+	}
+	if machine.Action != ENROL && machine.Action != ROTATE && machine.Action != STATUS {
+		machine.Flags.Usage()
+		os.Exit(1)
+	}
+}
+
+// Run the requested action against assemble
+func (machine *Machine) Run() int {
+	var (
+		ctx   context.Context = context.Background()
+		token *client.MachineToken
+		err   error
+	)
+
+	switch machine.Action {
+	case ENROL:
+		if machine.Config.ClientToken() == "" {
+			log.Error("Enrolling requires a token granted the admin scope in ", config.TOKEN_ENV)
+			return 1
+		}
+		token, err = client.NewAssemble(machine.Config).EnrolMachine(ctx, machine.Address)
+	case ROTATE:
+		token, err = machine.signed().RotateMachine(ctx)
+	case STATUS:
+		var status map[string]interface{}
+		if err = machine.signed().Machine(ctx, &status); err != nil {
+			log.Error("Failed to get machine status: ", err)
+			return 1
+		}
+		data, _ := json.Marshal(status)
+		log.Info("Machine status ", string(data))
+		return 0
+	}
+	if err != nil {
+		log.Errorf("Failed to %s machine: %s", machine.Action, err)
+		return 1
+	}
+
+	if err := machine.write(token.Token); err != nil {
+		log.Error("Failed to write machine token: ", err)
+		return 1
+	}
+	log.Infof("Machine token for %s written to %s, expires %s", token.Address, machine.Output, token.Expires)
+	return 0
+}
+
+// signed : Get a client signing requests with the machine token of this host
+func (machine *Machine) signed() *client.Assemble {
+	var token string = machine.Config.MachineToken()
+	if machine.Output != machine.Config.MachineTokenFile() {
+		if data, err := ioutil.ReadFile(machine.Output); err == nil {
+			token = strings.TrimSpace(string(data))
+		}
+	}
+	if token == "" {
+		log.Fatal("This host has not been enrolled. Run `", filepath.Base(os.Args[0]), " machine enrol` first")
+	}
+	return client.NewAssemble(machine.Config, client.WithToken(""), client.WithMachineToken(token))
+}
+
+// write : Write the machine token to the output file, readable only by its owner
+//
+// The token is written to a temporary file which replaces the output, so
+// components reading the token for each request never see it part written.
+func (machine *Machine) write(token string) error {
+	if err := os.MkdirAll(filepath.Dir(machine.Output), 0755); err != nil {
+		return err
+	}
+	file, err := ioutil.TempFile(filepath.Dir(machine.Output), filepath.Base(machine.Output)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.WriteString(token + "\n"); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), machine.Output)
+}
@@ -11,12 +11,14 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math/rand"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"syscall"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -25,6 +27,9 @@ import (
 // TIMEOUT : The maximum number of minutes a command can execute for if not forever
 const TIMEOUT = 15
 
+// GRACEPERIOD : The number of seconds a cancelled command has to exit after SIGTERM before being killed
+const GRACEPERIOD = 30
+
 // Command : Define the structure of a command taken from JointJS and executed by Syphon
 type Command struct {
 
@@ -103,6 +108,12 @@ type Command struct {
 	// Standard error buffer
 	Stderr bytes.Buffer
 
+	// OutputWriter receives standard output as it is written, including forever runs
+	OutputWriter io.Writer `json:"-"`
+
+	// ErrorWriter receives standard error as it is written, including forever runs
+	ErrorWriter io.Writer `json:"-"`
+
 	// The commands process ID whilst executing
 	ProcessID int
 
@@ -111,6 +122,18 @@ type Command struct {
 
 	// A computed file separator
 	FileSeperator string
+
+	// Cancel is signalled when the command should be terminated early
+	Cancel chan bool `json:"-"`
+
+	// Was the command cancelled whilst executing
+	Cancelled bool `json:"cancelled"`
+
+	// The resources consumed by the last execution
+	Usage *Usage `json:"usage,omitempty"`
+
+	// Samples the process tree whilst executing
+	sampler *usageSampler
 }
 
 var regex *regexp.Regexp
@@ -365,6 +388,19 @@ func (command *Command) writeScript() (string, error) {
 	return name, nil
 }
 
+// writeEvent : Write an event payload to a temporary file for the command to read
+func (command *Command) writeEvent(event string) (string, error) {
+	file, err := ioutil.TempFile("", "tiyo-event-*.json")
+	if err != nil {
+		return "", fmt.Errorf("Failed to create event file for %s. %s", command.Name, err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(event); err != nil {
+		return "", fmt.Errorf("Failed to write event file for %s. Error was: %s", command.Name, err)
+	}
+	return file.Name(), nil
+}
+
 // Execute : Execute the current command inside the container
 //
 // This is the main workhorse function for the application, taking all
@@ -442,7 +478,7 @@ func (command *Command) Execute(directory string, subdir string, filename string
 	}
 
 	info, _ := os.Stat(directory)
-	if info != nil {
+	if info != nil && filename != "" {
 		var filedir string = command.collectFiles(directory, filename)
 		directory = filepath.Join(directory, subdir, filedir)
 		if _, err := os.Stat(directory); err != nil {
@@ -454,21 +490,57 @@ func (command *Command) Execute(directory string, subdir string, filename string
 		os.Chdir(directory)
 	}
 
+	// Event payloads are made available as a file referenced by
+	// TIYO_EVENT_FILE and on stdin. They are not put in the environment
+	// as large payloads would exceed the limit on its size.
+	var eventFile string
+	if event != "" {
+		if eventFile, err = command.writeEvent(event); err != nil {
+			log.Error(err)
+			return 1
+		}
+		defer os.Remove(eventFile)
+		environment = append(environment, "TIYO_EVENT_FILE="+eventFile)
+	}
+
 	log.Info("Triggering command ", command.Command, " ", command.ProcessArgs)
 	cmd := exec.Command(command.Command, command.ProcessArgs...)
 	cmd.Env = environment
 
-	// dont collect logs on forever run
+	// Run the command in its own process group so children started by
+	// the command are signalled with it and release its output pipes.
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if eventFile != "" {
+		stdin, err := os.Open(eventFile)
+		if err != nil {
+			log.Error("Failed to open event file ", eventFile, " ", err)
+			return 1
+		}
+		defer stdin.Close()
+		cmd.Stdin = stdin
+	}
+
+	// dont buffer logs on forever run
+	var (
+		stdout []io.Writer = []io.Writer{os.Stdout}
+		stderr []io.Writer = []io.Writer{os.Stderr}
+	)
 	if command.Timeout != -1 {
-		cmd.Stdout = io.MultiWriter(os.Stdout, &command.Stdout)
-		cmd.Stderr = io.MultiWriter(os.Stderr, &command.Stderr)
-	} else {
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
+		stdout = append(stdout, &command.Stdout)
+		stderr = append(stderr, &command.Stderr)
 	}
+	if command.OutputWriter != nil {
+		stdout = append(stdout, command.OutputWriter)
+	}
+	if command.ErrorWriter != nil {
+		stderr = append(stderr, command.ErrorWriter)
+	}
+	cmd.Stdout = io.MultiWriter(stdout...)
+	cmd.Stderr = io.MultiWriter(stderr...)
 	command.StartTime = time.Now().UnixNano()
 
-	done := make(chan error)
+	// buffered so the waiting goroutine exits even if nothing receives
+	done := make(chan error, 1)
 	if err := cmd.Start(); err != nil {
 		command.EndTime = time.Now().UnixNano()
 		log.Error("Failed to start command `", command.Command, " ", command.Args, "`")
@@ -476,11 +548,15 @@ func (command *Command) Execute(directory string, subdir string, filename string
 		return 1
 	}
 
+	command.sampler = newUsageSampler(cmd.Process.Pid)
+	var exitCode int
 	if command.Timeout == -1 {
-		return command.ExecuteForever(cmd, done)
+		exitCode = command.ExecuteForever(cmd, done)
+	} else {
+		exitCode = command.ExecuteWithTimeout(cmd, done)
 	}
-
-	return command.ExecuteWithTimeout(cmd, done)
+	command.Usage = command.sampler.finish()
+	return exitCode
 }
 
 // Globs the input directory and appends each file as an argument to the command
@@ -534,21 +610,27 @@ func (command *Command) collectFiles(directory string, filename string) string {
 // ExecuteForever : Executes the given command in a "forever" loop
 //
 // Note:
-// This command does not copy any logs - presuming they will grow exponentially
-// large over time. Check STDERR/STDOUT on the pod for the logs garnered by it.
+// This command does not buffer any logs - presuming they will grow exponentially
+// large over time. Output is only available from STDERR/STDOUT on the pod and
+// from OutputWriter/ErrorWriter if set.
 func (command *Command) ExecuteForever(cmd *exec.Cmd, done chan error) int {
 	go func() {
-		done <- cmd.Wait()
+		err := cmd.Wait()
+		command.sampler.exited(cmd.ProcessState)
+		done <- err
 	}()
+
+	var exitCode int = 0
 	select {
 	case err := <-done:
 		if exitError, ok := err.(*exec.ExitError); ok {
-			command.EndTime = time.Now().UnixNano()
-			return exitError.ExitCode()
+			exitCode = exitError.ExitCode()
 		}
+	case <-command.Cancel:
+		exitCode = command.terminate(cmd, done)
 	}
-
-	return 0
+	command.EndTime = time.Now().UnixNano()
+	return exitCode
 }
 
 // ExecuteWithTimeout : Runs the given command with a timeout
@@ -557,28 +639,65 @@ func (command *Command) ExecuteForever(cmd *exec.Cmd, done chan error) int {
 // Longer timeouts can be set or set to -1 to run forever.
 func (command *Command) ExecuteWithTimeout(cmd *exec.Cmd, done chan error) int {
 	go func() {
-		done <- cmd.Wait()
+		err := cmd.Wait()
+		command.sampler.exited(cmd.ProcessState)
+		done <- err
 	}()
 
-	var exitCode int = 1
+	var exitCode int = 0
 	select {
 	case err := <-done:
+		command.EndTime = time.Now().UnixNano()
 		if exitError, ok := err.(*exec.ExitError); ok {
-			command.EndTime = time.Now().UnixNano()
 			exitCode = exitError.ExitCode()
 		}
-		break
+	case <-command.Cancel:
+		exitCode = command.terminate(cmd, done)
+		command.EndTime = time.Now().UnixNano()
 	case <-time.After(time.Duration(command.Timeout) * time.Second):
-		if err := cmd.Process.Kill(); err != nil {
-			log.Errorf("Failed to kill command %s - you might have zombies. %s", command.Name, err.Error())
-		}
+		exitCode = 1
 		log.Error("Command ", command.Name, " exited due to timeout - ", command.Timeout, " seconds exceeded")
-		break
+		command.kill(cmd)
+		<-done
+		command.EndTime = time.Now().UnixNano()
 	}
 	command.recreateWorkspace()
 	return exitCode
 }
 
+// terminate : Stop a cancelled command
+//
+// The process is sent SIGTERM and given GRACEPERIOD seconds to exit cleanly
+// before being sent SIGKILL.
+func (command *Command) terminate(cmd *exec.Cmd, done chan error) int {
+	log.Warn("Command ", command.Name, " cancelled - sending SIGTERM to process ", cmd.Process.Pid)
+	command.Cancelled = true
+	if err := syscall.Kill(-cmd.Process.Pid, syscall.SIGTERM); err != nil {
+		log.Error("Failed to send SIGTERM to ", command.Name, " ", err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(GRACEPERIOD * time.Second):
+		log.Warn("Command ", command.Name, " did not exit within ", GRACEPERIOD, " seconds - killing")
+		command.kill(cmd)
+		<-done
+	}
+	return -1
+}
+
+// kill : Send SIGKILL to the process group of a command
+//
+// Falls back to killing the process alone if the group cannot be signalled.
+func (command *Command) kill(cmd *exec.Cmd) {
+	if err := syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL); err == nil {
+		return
+	}
+	if err := cmd.Process.Kill(); err != nil {
+		log.Errorf("Failed to kill command %s - you might have zombies. %s", command.Name, err.Error())
+	}
+}
+
 // recreateWorkspace : Deletes and recreates the workspace directory inside the container
 func (command *Command) recreateWorkspace() {
 	os.Chdir("/tiyo")
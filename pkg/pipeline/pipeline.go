@@ -11,15 +11,13 @@
 package pipeline
 
 import (
-	"crypto/tls"
+	"context"
 	"encoding/base64"
 	"encoding/json"
-	"fmt"
-	"io/ioutil"
-	"net/http"
 	"regexp"
 	"strings"
 
+	"github.com/notapipeline/tiyo/pkg/client"
 	"github.com/notapipeline/tiyo/pkg/config"
 	log "github.com/sirupsen/logrus"
 )
@@ -59,6 +57,49 @@ type Pipeline struct {
 
 	// Global pipeline credentials (encrypted)
 	Credentials map[string]string
+
+	// A regular expression used to group files into samples by filename.
+	// If the expression contains a capture group, the first group names the sample.
+	SamplePattern string
+
+	// The compiled sample pattern
+	sampleRegex *regexp.Regexp
+}
+
+// SAMPLE_PATTERN : The default sample pattern - everything before the first underscore or dot
+const SAMPLE_PATTERN = `^([^_.]+)`
+
+// GetSamplePattern : Get the sample pattern in use for the pipeline
+func (pipeline *Pipeline) GetSamplePattern() string {
+	if pipeline.SamplePattern == "" {
+		return SAMPLE_PATTERN
+	}
+	return pipeline.SamplePattern
+}
+
+// SampleName : Get the name of the sample a file belongs to
+//
+// Files which do not match the sample pattern are their own sample,
+// named after the file.
+func (pipeline *Pipeline) SampleName(filename string) string {
+	if pipeline.sampleRegex == nil {
+		var pattern string = pipeline.GetSamplePattern()
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			log.Error("Invalid sample pattern ", pattern, " for ", pipeline.Name, " ", err)
+			return filename
+		}
+		pipeline.sampleRegex = regex
+	}
+
+	matches := pipeline.sampleRegex.FindStringSubmatch(filename)
+	switch {
+	case len(matches) > 1 && matches[1] != "":
+		return matches[1]
+	case len(matches) == 1 && matches[0] != "":
+		return matches[0]
+	}
+	return filename
 }
 
 // GetParent : Gets the parent (if any) of the current command element
@@ -115,6 +156,47 @@ func (pipeline *Pipeline) GetLinksFrom(command *Command) []*LinkInterface {
 	return links
 }
 
+// GetSource : Get a source element by its ID or name
+// returns nil if not found
+func (pipeline *Pipeline) GetSource(name string) *Source {
+	if source, ok := pipeline.Sources[name]; ok {
+		return source
+	}
+	for _, source := range pipeline.Sources {
+		if source.Name == name {
+			return source
+		}
+	}
+	return nil
+}
+
+// GetSourceTargets : Get all commands fed by a given source element
+// returns a slice of type *Command
+func (pipeline *Pipeline) GetSourceTargets(source *Source) []*Command {
+	commands := make([]*Command, 0)
+	for _, link := range pipeline.Links {
+		if (*link).GetLink().Source != source.ID {
+			continue
+		}
+		if command := pipeline.GetCommand((*link).GetLink().Target); command != nil {
+			commands = append(commands, command)
+		}
+	}
+	return commands
+}
+
+// GetSinks : Get all sources of a given type which a command feeds into
+// returns a slice of type *Source
+func (pipeline *Pipeline) GetSinks(command *Command, sourceType string) []*Source {
+	sinks := make([]*Source, 0)
+	for _, link := range pipeline.GetLinksFrom(command) {
+		if source, ok := pipeline.Sources[(*link).GetLink().Target]; ok && source.Type == sourceType {
+			sinks = append(sinks, source)
+		}
+	}
+	return sinks
+}
+
 // GetStartIds : Gets a list of all IDs which have no inputs from other Command elements
 // return slice of type string
 func (pipeline *Pipeline) GetStartIds() []string {
@@ -421,30 +503,13 @@ func GetPipeline(config *config.Config, name string) (*Pipeline, error) {
 	pipeline.Environment = make([]string, 0)
 	pipeline.Credentials = make(map[string]string)
 
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: config.UseInsecureTLS}
 	// Do not use pipeline.Name here - that has been Sanitized and will not match
-	response, err := http.Get(fmt.Sprintf("%s/api/v1/bucket/pipeline/%s", config.AssembleServer(), name))
+	message, err := client.NewAssemble(config).Get(context.Background(), "pipeline", "", name)
 	if err != nil {
 		return nil, err
 	}
 
-	defer response.Body.Close()
-	message := struct {
-		Code    int    `json:"code"`
-		Result  string `json:"result"`
-		Message string `json:"message"`
-	}{}
-
-	body, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
-	err = json.Unmarshal(body, &message)
-	if err != nil {
-		return nil, err
-	}
-
-	pipelineJSON, err := base64.StdEncoding.DecodeString(string(message.Message))
+	pipelineJSON, err := base64.StdEncoding.DecodeString(message)
 	if err != nil {
 		return nil, err
 	}
@@ -469,6 +534,10 @@ func GetPipeline(config *config.Config, name string) (*Pipeline, error) {
 		}
 	}
 
+	if pattern, ok := content["samplepattern"].(string); ok {
+		pipeline.SamplePattern = pattern
+	}
+
 	// issue#18
 	// When parsing the pipeline, any errors / missing required variables
 	// should be sent back to the browser as a map of "id:[errors]"
@@ -11,6 +11,13 @@ package pipeline
 //   - File
 //   - Directory
 //   - Stream
+//   - NATS
+//
+// NATS sources subscribe to a subject when linked into a command and
+// publish completion events to the subject when a command links to them.
+
+// SOURCE_NATS : The source type of a NATS message broker subject
+const SOURCE_NATS = "nats"
 
 // Source : A source data structure
 type Source struct {
@@ -23,6 +30,12 @@ type Source struct {
 
 	// The source type of this element
 	Type string `json:"sourcetype"`
+
+	// For NATS sources, the subject to subscribe or publish to
+	Subject string `json:"subject"`
+
+	// For NATS sources, an optional server URL overriding the configured default
+	Server string `json:"server"`
 }
 
 // NewSource : create a new source object
@@ -40,5 +53,13 @@ func NewSource(cell map[string]interface{}) *Source {
 		source.Type = cell["sourcetype"].(string)
 	}
 
+	if cell["subject"] != nil {
+		source.Subject = cell["subject"].(string)
+	}
+
+	if cell["server"] != nil {
+		source.Server = cell["server"].(string)
+	}
+
 	return &source
 }
@@ -0,0 +1,282 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package pipeline
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// USAGE_INTERVAL : The initial number of seconds between samples of the process tree
+const USAGE_INTERVAL = 5
+
+// USAGE_MAX_SAMPLES : The maximum number of samples kept for a single execution
+//
+// When reached, every other sample is discarded and the interval doubled
+// so long running commands keep an even spread of samples.
+const USAGE_MAX_SAMPLES = 360
+
+// CLOCK_TICKS : Kernel clock ticks per second used in /proc/<pid>/stat
+//
+// This is sysconf(_SC_CLK_TCK), which cannot be read without cgo. Linux
+// reports /proc times in USER_HZ, which is 100 on every architecture tiyo
+// images are built for (amd64, arm64) regardless of the kernel HZ setting.
+const CLOCK_TICKS = 100
+
+// Usage : The resources consumed by a single command execution
+type Usage struct {
+
+	// The maximum resident set size in bytes as reported by the kernel
+	MaxRSS int64 `json:"maxrss"`
+
+	// CPU time spent in user mode in seconds
+	UserCPU float64 `json:"usercpu"`
+
+	// CPU time spent in kernel mode in seconds
+	SystemCPU float64 `json:"systemcpu"`
+
+	// Bytes read from block devices
+	ReadBytes int64 `json:"readbytes"`
+
+	// Bytes written to block devices
+	WriteBytes int64 `json:"writebytes"`
+
+	// The peak resident set size of the whole process tree in bytes
+	PeakRSS int64 `json:"peakrss"`
+
+	// The peak CPU usage of the process tree in cores
+	PeakCPU float64 `json:"peakcpu"`
+
+	// The largest number of processes seen in the tree
+	PeakProcesses int `json:"peakprocesses"`
+
+	// The wall clock duration of the execution in nanoseconds
+	Duration int64 `json:"duration"`
+
+	// Samples taken of the process tree whilst executing
+	Samples []UsageSample `json:"samples"`
+}
+
+// UsageSample : A point in time measurement of the process tree
+type UsageSample struct {
+
+	// The time of the sample in unix nano
+	Time int64 `json:"time"`
+
+	// Resident set size of the tree in bytes
+	RSS int64 `json:"rss"`
+
+	// CPU usage of the tree since the previous sample in cores
+	CPU float64 `json:"cpu"`
+
+	// The number of processes in the tree
+	Processes int `json:"processes"`
+}
+
+// usageSampler : Periodically samples the process tree of an executing command
+type usageSampler struct {
+	sync.Mutex
+
+	// The root process of the tree
+	pid int
+
+	// The usage collected so far
+	usage Usage
+
+	// The time between samples
+	interval time.Duration
+
+	// CPU ticks and time of the previous sample
+	lastTicks int64
+	lastTime  time.Time
+
+	// When the sampler was started
+	started time.Time
+
+	// Closed to stop sampling
+	stop chan bool
+}
+
+// newUsageSampler : Start sampling the process tree rooted at pid
+func newUsageSampler(pid int) *usageSampler {
+	sampler := usageSampler{
+		pid:      pid,
+		interval: USAGE_INTERVAL * time.Second,
+		started:  time.Now(),
+		lastTime: time.Now(),
+		stop:     make(chan bool),
+	}
+	sampler.usage.Samples = make([]UsageSample, 0)
+	go sampler.run()
+	return &sampler
+}
+
+// run : Sample the tree until stopped
+func (sampler *usageSampler) run() {
+	for {
+		sampler.Lock()
+		var interval time.Duration = sampler.interval
+		sampler.Unlock()
+
+		select {
+		case <-sampler.stop:
+			return
+		case <-time.After(interval):
+		}
+		sampler.sample()
+	}
+}
+
+// sample : Record the current usage of the process tree
+func (sampler *usageSampler) sample() {
+	rss, ticks, processes := processTree(sampler.pid)
+	if processes == 0 {
+		return
+	}
+
+	sampler.Lock()
+	defer sampler.Unlock()
+
+	var (
+		now     time.Time = time.Now()
+		elapsed float64   = now.Sub(sampler.lastTime).Seconds()
+		cpu     float64
+	)
+	if elapsed > 0 && ticks >= sampler.lastTicks {
+		cpu = float64(ticks-sampler.lastTicks) / CLOCK_TICKS / elapsed
+	}
+	sampler.lastTicks = ticks
+	sampler.lastTime = now
+
+	usage := &sampler.usage
+	usage.Samples = append(usage.Samples, UsageSample{
+		Time:      now.UnixNano(),
+		RSS:       rss,
+		CPU:       cpu,
+		Processes: processes,
+	})
+	if rss > usage.PeakRSS {
+		usage.PeakRSS = rss
+	}
+	if cpu > usage.PeakCPU {
+		usage.PeakCPU = cpu
+	}
+	if processes > usage.PeakProcesses {
+		usage.PeakProcesses = processes
+	}
+
+	if len(usage.Samples) >= USAGE_MAX_SAMPLES {
+		samples := make([]UsageSample, 0, USAGE_MAX_SAMPLES/2+1)
+		for index := 0; index < len(usage.Samples); index += 2 {
+			samples = append(samples, usage.Samples[index])
+		}
+		usage.Samples = samples
+		sampler.interval *= 2
+	}
+}
+
+// exited : Record the kernel resource usage of the exited process
+func (sampler *usageSampler) exited(state *os.ProcessState) {
+	if sampler == nil || state == nil {
+		return
+	}
+	sampler.Lock()
+	defer sampler.Unlock()
+
+	usage := &sampler.usage
+	usage.UserCPU = state.UserTime().Seconds()
+	usage.SystemCPU = state.SystemTime().Seconds()
+	if rusage, ok := state.SysUsage().(*syscall.Rusage); ok {
+		// Linux reports maxrss in kilobytes and block operations in 512 byte units
+		usage.MaxRSS = int64(rusage.Maxrss) * 1024
+		usage.ReadBytes = int64(rusage.Inblock) * 512
+		usage.WriteBytes = int64(rusage.Oublock) * 512
+	}
+	if usage.MaxRSS > usage.PeakRSS {
+		usage.PeakRSS = usage.MaxRSS
+	}
+}
+
+// finish : Stop sampling and return the usage collected
+func (sampler *usageSampler) finish() *Usage {
+	sampler.Lock()
+	defer sampler.Unlock()
+
+	select {
+	case <-sampler.stop:
+	default:
+		close(sampler.stop)
+	}
+
+	usage := sampler.usage
+	usage.Samples = append([]UsageSample{}, sampler.usage.Samples...)
+	usage.Duration = time.Since(sampler.started).Nanoseconds()
+	return &usage
+}
+
+// processTree : Sum the resident memory and CPU ticks of a process and all of its descendants
+//
+// Reads /proc and returns zero processes where this is unavailable
+func processTree(pid int) (int64, int64, int) {
+	var (
+		rss       int64
+		ticks     int64
+		processes int
+	)
+
+	stats, err := filepath.Glob("/proc/[0-9]*/stat")
+	if err != nil {
+		return 0, 0, 0
+	}
+
+	children := make(map[int][]int)
+	usage := make(map[int][2]int64)
+	for _, path := range stats {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		// the command name may contain spaces so fields are read after the closing bracket
+		var line string = string(content)
+		index := strings.LastIndex(line, ")")
+		if index == -1 {
+			continue
+		}
+		fields := strings.Fields(line[index+1:])
+		if len(fields) < 22 {
+			continue
+		}
+
+		id, _ := strconv.Atoi(filepath.Base(filepath.Dir(path)))
+		parent, _ := strconv.Atoi(fields[1])
+		utime, _ := strconv.ParseInt(fields[11], 10, 64)
+		stime, _ := strconv.ParseInt(fields[12], 10, 64)
+		pages, _ := strconv.ParseInt(fields[21], 10, 64)
+
+		children[parent] = append(children[parent], id)
+		usage[id] = [2]int64{pages * int64(os.Getpagesize()), utime + stime}
+	}
+
+	pending := []int{pid}
+	for len(pending) > 0 {
+		var current int = pending[0]
+		pending = pending[1:]
+		if values, ok := usage[current]; ok {
+			rss += values[0]
+			ticks += values[1]
+			processes++
+		}
+		pending = append(pending, children[current]...)
+	}
+	return rss, ticks, processes
+}
@@ -0,0 +1,621 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/server/api"
+	log "github.com/sirupsen/logrus"
+)
+
+// INVITE_VALIDITY : How long an invitation may be accepted for
+const INVITE_VALIDITY time.Duration = 7 * 24 * time.Hour
+
+// ADMIN_GROUP : The group whose members administer assemble
+const ADMIN_GROUP string = "admin"
+
+// invitation : An invitation waiting to be accepted
+type invitation struct {
+	User    string    `json:"user"`
+	Expires time.Time `json:"expires"`
+}
+
+// userRequest : The request sent to invite or change a user
+type userRequest struct {
+	Email    string   `json:"email"`
+	Password string   `json:"password"`
+	Groups   []string `json:"groups"`
+	Disabled bool     `json:"disabled"`
+}
+
+// groupRequest : The request sent to create a group or permission
+type groupRequest struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// InviteUser : Add a user without a password and get the secret of the invitation
+//
+// The user sets their password by accepting the invitation on /invite
+func (s *Server) InviteUser(user *User) (string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	user.Password = ""
+	if err := s.AddUser(user); err != nil {
+		return "", err
+	}
+
+	var secret string = base64.RawURLEncoding.EncodeToString(random)
+	data, _ := json.Marshal(invitation{
+		User:    user.ID,
+		Expires: time.Now().Add(INVITE_VALIDITY),
+	})
+	if err := s.replace(hashToken(secret), INVITES_T, string(data)); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// AcceptInvite : Set the password of an invited user
+func (s *Server) AcceptInvite(secret string, password string) error {
+	var key string = hashToken(secret)
+	invite := invitation{}
+	if err := json.Unmarshal([]byte(s.get(key, INVITES_T)), &invite); err != nil || invite.User == "" {
+		return fmt.Errorf("Invalid invitation")
+	}
+	if time.Now().After(invite.Expires) {
+		s.delete(key, INVITES_T)
+		return fmt.Errorf("Invitation has expired")
+	}
+
+	user, err := s.FindUserByID(invite.User)
+	if err != nil {
+		return err
+	}
+	if err := s.SetPassword(user, password); err != nil {
+		return err
+	}
+	return s.delete(key, INVITES_T)
+}
+
+// groups : Look up groups by name
+func (s *Server) groups(names []string) ([]Group, error) {
+	groups := make([]Group, 0)
+	for _, name := range names {
+		group, err := s.FindGroup(name)
+		if err != nil || group == nil {
+			return nil, fmt.Errorf("No such group %s", name)
+		}
+		groups = append(groups, *group)
+	}
+	return groups, nil
+}
+
+// isAdmin : Is the user a member of the admin group
+func isAdmin(user *User) bool {
+	for _, group := range user.Groups {
+		if group.Name == ADMIN_GROUP {
+			return true
+		}
+	}
+	return false
+}
+
+// lastAdmin : Is user the only enabled member of the admin group
+func (server *Server) lastAdmin(user *User) (bool, error) {
+	if !isAdmin(user) || server.IsDisabled(user.ID) {
+		return false, nil
+	}
+	accounts, err := server.ListUsers()
+	if err != nil {
+		return false, err
+	}
+	for _, account := range accounts {
+		if account.ID != user.ID && !account.Disabled && contains(account.Groups, ADMIN_GROUP) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// revokeAccess : End the sessions and revoke the API tokens of a user
+func (server *Server) revokeAccess(user *User) error {
+	if err := server.DeleteSessions(user.ID); err != nil {
+		return err
+	}
+	tokens, err := server.ListAPITokens(user.ID)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		if err := server.DeleteAPIToken(token.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reply : Send a result with code and message
+func reply(c *gin.Context, code int, message interface{}) {
+	result := api.NewResult()
+	result.Code = code
+	result.Result = "OK"
+	if code >= http.StatusBadRequest {
+		result.Result = "Error"
+	}
+	result.Message = message
+	c.JSON(result.Code, result)
+}
+
+// RequireAdmin : Refuse pages to users outside the admin group
+//
+// Must follow RequireAccount
+func (server *Server) RequireAdmin(c *gin.Context) {
+	if user, ok := sessions.Default(c).Get("User").(User); ok && isAdmin(&user) {
+		return
+	}
+	server.Error(c, http.StatusForbidden, fmt.Errorf("You do not have permission to view this page"))
+	c.Abort()
+}
+
+// Admin : Render the user administration page
+func (server *Server) Admin(c *gin.Context) {
+	c.HTML(http.StatusOK, "admin", gin.H{
+		"Title": "TIYO - Users and groups",
+	})
+}
+
+// Invitation : Accept an invitation by setting a password
+//
+// GET  /invite?token= - show the password form
+// POST /invite        - set the password then continue to /login
+func (server *Server) Invitation(c *gin.Context) {
+	if c.Request.Method == http.MethodPost {
+		var request struct {
+			Token    string `form:"token"`
+			Password string `form:"password"`
+			Confirm  string `form:"confirmPassword"`
+		}
+		if err := c.ShouldBind(&request); err != nil {
+			server.Error(c, http.StatusBadRequest, err)
+			return
+		}
+		if request.Password != request.Confirm || !validPassword.MatchString(request.Password) {
+			c.Redirect(http.StatusFound, "/invite?error=invalidpassword&token="+request.Token)
+			return
+		}
+		if err := server.AcceptInvite(request.Token, request.Password); err != nil {
+			server.Error(c, http.StatusBadRequest, err)
+			return
+		}
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+	c.HTML(http.StatusOK, "invite", gin.H{
+		"Title": "TIYO - Accept invitation",
+		"Token": c.Query("token"),
+	})
+}
+
+// Users : List every user
+//
+// GET /api/v1/users
+//
+// Response codes:
+// - 200 OK
+// - 500 Internal server error
+func (server *Server) Users(c *gin.Context) {
+	users, err := server.ListUsers()
+	if err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	reply(c, http.StatusOK, users)
+}
+
+// Invite : Invite a new user
+//
+// POST /api/v1/users
+//
+// Request parameters:
+// - email  - the email of the user
+// - groups - [optional] the names of the groups the user belongs to
+//
+// Response codes:
+// - 201 Created - message holds the user and the path of the invitation
+// - 400 Bad request
+// - 409 Conflict - a user with the email exists
+// - 500 Internal server error
+func (server *Server) Invite(c *gin.Context) {
+	request := userRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil || !validEmail.MatchString(request.Email) {
+		reply(c, http.StatusBadRequest, "A valid email is required")
+		return
+	}
+	if existing, _ := server.FindUser(request.Email); existing != nil {
+		reply(c, http.StatusConflict, "User already exists")
+		return
+	}
+	groups, err := server.groups(request.Groups)
+	if err != nil {
+		reply(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user := User{
+		Email:  request.Email,
+		Groups: groups,
+	}
+	secret, err := server.InviteUser(&user)
+	if err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("User ", user.ID, " invited by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusCreated, map[string]interface{}{
+		"user":   server.account(&user),
+		"invite": "/invite?token=" + secret,
+	})
+}
+
+// GetUser : Get a user
+//
+// GET /api/v1/users/:id
+//
+// Response codes:
+// - 200 OK
+// - 404 Not found
+func (server *Server) GetUser(c *gin.Context) {
+	user, err := server.FindUserByID(c.Params.ByName("id"))
+	if err != nil {
+		reply(c, http.StatusNotFound, "No such user")
+		return
+	}
+	reply(c, http.StatusOK, server.account(user))
+}
+
+// ChangePassword : Set the password of a user
+//
+// PUT /api/v1/users/:id/password
+//
+// Request parameters:
+// - password - the new password
+//
+// The sessions and API tokens of the user are revoked.
+//
+// Response codes:
+// - 204 No content
+// - 400 Bad request
+// - 404 Not found
+// - 500 Internal server error
+func (server *Server) ChangePassword(c *gin.Context) {
+	request := userRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil || !validPassword.MatchString(request.Password) {
+		reply(c, http.StatusBadRequest, "Password must be between 6 and 200 printable characters")
+		return
+	}
+	user, err := server.FindUserByID(c.Params.ByName("id"))
+	if err != nil {
+		reply(c, http.StatusNotFound, "No such user")
+		return
+	}
+	if err := server.SetPassword(user, request.Password); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := server.revokeAccess(user); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("Password of user ", user.ID, " changed by ", c.GetString(AUTH_PRINCIPAL))
+	c.Status(http.StatusNoContent)
+}
+
+// AssignGroups : Replace the groups a user belongs to
+//
+// PUT /api/v1/users/:id/groups
+//
+// Request parameters:
+// - groups - the names of the groups
+//
+// The sessions of the user are ended so they sign in again with their new
+// groups. API tokens follow the groups of their owner as they are used.
+// The last administrator cannot leave the admin group.
+//
+// Response codes:
+// - 200 OK
+// - 400 Bad request
+// - 404 Not found
+// - 500 Internal server error
+func (server *Server) AssignGroups(c *gin.Context) {
+	request := userRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		reply(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := server.FindUserByID(c.Params.ByName("id"))
+	if err != nil {
+		reply(c, http.StatusNotFound, "No such user")
+		return
+	}
+	groups, err := server.groups(request.Groups)
+	if err != nil {
+		reply(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !contains(request.Groups, ADMIN_GROUP) {
+		if last, err := server.lastAdmin(user); err != nil {
+			reply(c, http.StatusInternalServerError, err.Error())
+			return
+		} else if last {
+			reply(c, http.StatusBadRequest, "The last administrator cannot leave the admin group")
+			return
+		}
+	}
+	if err := server.SetGroups(user, groups); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := server.DeleteSessions(user.ID); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("Groups of user ", user.ID, " changed by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusOK, server.account(user))
+}
+
+// SetDisabled : Disable or enable a user
+//
+// PUT /api/v1/users/:id/disabled
+//
+// Request parameters:
+// - disabled - true to stop the user signing in
+//
+// Administrators cannot disable themselves or the last administrator.
+//
+// Response codes:
+// - 200 OK
+// - 400 Bad request
+// - 404 Not found
+// - 500 Internal server error
+func (server *Server) SetDisabled(c *gin.Context) {
+	request := userRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		reply(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := server.FindUserByID(c.Params.ByName("id"))
+	if err != nil {
+		reply(c, http.StatusNotFound, "No such user")
+		return
+	}
+	if user.ID == c.GetString(AUTH_USER) {
+		reply(c, http.StatusBadRequest, "You cannot disable yourself")
+		return
+	}
+	if request.Disabled {
+		if last, err := server.lastAdmin(user); err != nil {
+			reply(c, http.StatusInternalServerError, err.Error())
+			return
+		} else if last {
+			reply(c, http.StatusBadRequest, "The last administrator cannot be disabled")
+			return
+		}
+	}
+	if err := server.DisableUser(user, request.Disabled); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("User ", user.ID, " disabled=", request.Disabled, " by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusOK, server.account(user))
+}
+
+// RemoveUser : Delete a user and their tokens
+//
+// DELETE /api/v1/users/:id
+//
+// Administrators cannot delete themselves or the last administrator.
+//
+// Response codes:
+// - 202 Accepted
+// - 400 Bad request
+// - 404 Not found
+// - 500 Internal server error
+func (server *Server) RemoveUser(c *gin.Context) {
+	user, err := server.FindUserByID(c.Params.ByName("id"))
+	if err != nil {
+		reply(c, http.StatusNotFound, "No such user")
+		return
+	}
+	if user.ID == c.GetString(AUTH_USER) {
+		reply(c, http.StatusBadRequest, "You cannot delete yourself")
+		return
+	}
+	if last, err := server.lastAdmin(user); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	} else if last {
+		reply(c, http.StatusBadRequest, "The last administrator cannot be deleted")
+		return
+	}
+	if err := server.DeleteUser(user); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("User ", user.ID, " deleted by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusAccepted, "")
+}
+
+// Groups : List every group with its permissions
+//
+// GET /api/v1/groups
+//
+// Response codes:
+// - 200 OK
+// - 500 Internal server error
+func (server *Server) Groups(c *gin.Context) {
+	groups, err := server.ListGroups()
+	if err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	reply(c, http.StatusOK, groups)
+}
+
+// CreateGroup : Create or update a group
+//
+// POST /api/v1/groups
+//
+// Request parameters:
+// - name        - the name of the group
+// - permissions - [optional] the names of the permissions the group holds
+//
+// Response codes:
+// - 201 Created
+// - 400 Bad request
+// - 500 Internal server error
+func (server *Server) CreateGroup(c *gin.Context) {
+	request := groupRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil || !validString.MatchString(request.Name) {
+		reply(c, http.StatusBadRequest, "A valid name is required")
+		return
+	}
+
+	available, err := server.ListPermissions()
+	if err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	permissions := make([]Permission, 0)
+	for _, name := range request.Permissions {
+		var found bool
+		for _, permission := range available {
+			if permission.Permission == name {
+				permissions = append(permissions, permission)
+				found = true
+			}
+		}
+		if !found {
+			reply(c, http.StatusBadRequest, fmt.Sprintf("No such permission %s", name))
+			return
+		}
+	}
+
+	group := Group{
+		Name:        request.Name,
+		Permissions: permissions,
+	}
+	if existing, _ := server.FindGroup(request.Name); existing != nil {
+		group.ID = existing.ID
+	}
+	if err := server.AddGroup(&group); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("Group ", group.Name, " saved by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusCreated, group)
+}
+
+// RemoveGroup : Delete a group
+//
+// DELETE /api/v1/groups/:name
+//
+// The admin group cannot be deleted.
+//
+// Response codes:
+// - 202 Accepted
+// - 400 Bad request
+// - 500 Internal server error
+func (server *Server) RemoveGroup(c *gin.Context) {
+	var name string = c.Params.ByName("name")
+	if name == ADMIN_GROUP {
+		reply(c, http.StatusBadRequest, "The admin group cannot be deleted")
+		return
+	}
+	if err := server.DeleteGroup(name); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("Group ", name, " deleted by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusAccepted, "")
+}
+
+// Permissions : List every permission
+//
+// GET /api/v1/permissions
+//
+// Response codes:
+// - 200 OK
+// - 500 Internal server error
+func (server *Server) Permissions(c *gin.Context) {
+	permissions, err := server.ListPermissions()
+	if err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	reply(c, http.StatusOK, permissions)
+}
+
+// CreatePermission : Create a permission
+//
+// POST /api/v1/permissions
+//
+// Request parameters:
+// - name - the name of the permission
+//
+// Response codes:
+// - 201 Created
+// - 400 Bad request
+// - 409 Conflict - the permission exists
+func (server *Server) CreatePermission(c *gin.Context) {
+	request := groupRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil || !validString.MatchString(request.Name) {
+		reply(c, http.StatusBadRequest, "A valid name is required")
+		return
+	}
+	id, err := server.AddPermission(request.Name)
+	if err != nil {
+		reply(c, http.StatusConflict, err.Error())
+		return
+	}
+	log.Info("Permission ", request.Name, " created by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusCreated, Permission{ID: id, Permission: request.Name})
+}
+
+// RemovePermission : Delete a permission
+//
+// DELETE /api/v1/permissions/:name
+//
+// The admin permission cannot be deleted.
+//
+// Response codes:
+// - 202 Accepted
+// - 400 Bad request
+// - 500 Internal server error
+func (server *Server) RemovePermission(c *gin.Context) {
+	var name string = c.Params.ByName("name")
+	if name == ADMIN_GROUP {
+		reply(c, http.StatusBadRequest, "The admin permission cannot be deleted")
+		return
+	}
+	if err := server.DeletePermission(name); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("Permission ", name, " deleted by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusAccepted, "")
+}
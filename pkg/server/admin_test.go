@@ -0,0 +1,78 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestLastAdmin(t *testing.T) {
+	tests := []struct {
+		name string
+
+		// Whether a second administrator exists and is disabled
+		second   bool
+		disabled bool
+
+		// Who acts and what they do to the first administrator
+		self   bool
+		method string
+		path   string
+		body   string
+
+		code int
+	}{
+		{name: "demote with another admin", second: true, method: http.MethodPut, path: "/groups", body: `{"groups": []}`, code: http.StatusOK},
+		{name: "demote last admin", method: http.MethodPut, path: "/groups", body: `{"groups": []}`, code: http.StatusBadRequest},
+		{name: "demote with another admin disabled", second: true, disabled: true, method: http.MethodPut, path: "/groups", body: `{"groups": []}`, code: http.StatusBadRequest},
+		{name: "keep last admin in admin group", method: http.MethodPut, path: "/groups", body: `{"groups": ["admin"]}`, code: http.StatusOK},
+		{name: "disable with another admin", second: true, method: http.MethodPut, path: "/disabled", body: `{"disabled": true}`, code: http.StatusOK},
+		{name: "disable last admin", method: http.MethodPut, path: "/disabled", body: `{"disabled": true}`, code: http.StatusBadRequest},
+		{name: "disable self", second: true, self: true, method: http.MethodPut, path: "/disabled", body: `{"disabled": true}`, code: http.StatusBadRequest},
+		{name: "delete with another admin", second: true, method: http.MethodDelete, code: http.StatusAccepted},
+		{name: "delete last admin", method: http.MethodDelete, code: http.StatusBadRequest},
+		{name: "delete self", second: true, self: true, method: http.MethodDelete, code: http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t)
+			first := addTestUser(t, server, "first@example.com", true)
+			actor := addTestUser(t, server, "actor@example.com", false)
+			if test.second {
+				second := addTestUser(t, server, "second@example.com", true)
+				if err := server.DisableUser(second, test.disabled); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if test.self {
+				actor = first
+			}
+
+			gin.SetMode(gin.TestMode)
+			engine := gin.New()
+			group := engine.Group(API_PREFIX, func(c *gin.Context) {
+				c.Set(AUTH_USER, actor.ID)
+			})
+			group.PUT("/users/:id/groups", server.AssignGroups)
+			group.PUT("/users/:id/disabled", server.SetDisabled)
+			group.DELETE("/users/:id", server.RemoveUser)
+
+			request := httptest.NewRequest(test.method, API_PREFIX+"/users/"+first.ID+test.path, strings.NewReader(test.body))
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, request)
+			if recorder.Code != test.code {
+				t.Errorf("expected %d, got %d - %s", test.code, recorder.Code, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,433 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/pipeline"
+)
+
+// ANALYTICS_HOURS : The default number of hours statistics are calculated over
+const ANALYTICS_HOURS = 24
+
+// Percentiles : Distribution of a set of durations in nanoseconds
+type Percentiles struct {
+	P50  int64 `json:"p50"`
+	P90  int64 `json:"p90"`
+	P95  int64 `json:"p95"`
+	P99  int64 `json:"p99"`
+	Mean int64 `json:"mean"`
+	Max  int64 `json:"max"`
+}
+
+// CommandAnalytics : Runtime statistics for a single command
+type CommandAnalytics struct {
+
+	// The jointJS ID of the command
+	CommandID string `json:"command"`
+
+	// The name of the command
+	Name string `json:"name"`
+
+	// The number of recent executions the percentiles are taken from
+	Samples int `json:"samples"`
+
+	// Execution time of recent executions
+	Duration Percentiles `json:"duration"`
+
+	// Time recent executions spent waiting on the queue
+	Wait Percentiles `json:"wait"`
+
+	// Completed executions per hour over the requested window
+	Throughput float64 `json:"throughput"`
+
+	// Failed executions as a fraction of all finished executions over the requested window
+	FailureRate float64 `json:"failurerate"`
+
+	// Execution counts per hour over the requested window
+	Hourly []HourlyStats `json:"hourly"`
+
+	// The number of items currently waiting on the queue
+	Queued int `json:"queued"`
+
+	// The number of items currently executing
+	Running int `json:"running"`
+}
+
+// PathStage : A command on the critical path
+type PathStage struct {
+
+	// The jointJS ID of the command
+	CommandID string `json:"command"`
+
+	// The name of the command
+	Name string `json:"name"`
+
+	// The median execution time in nanoseconds
+	Duration int64 `json:"duration"`
+
+	// The median queue wait in nanoseconds
+	Wait int64 `json:"wait"`
+}
+
+// Analytics : Runtime statistics and bottleneck analysis for a pipeline
+type Analytics struct {
+
+	// The name of the pipeline
+	Pipeline string `json:"pipeline"`
+
+	// The number of hours throughput, failure rate and percentiles are calculated over
+	Hours int `json:"hours"`
+
+	// Statistics for each command in the pipeline
+	Commands []CommandAnalytics `json:"commands"`
+
+	// The slowest route through the pipeline from a start command to an end command
+	CriticalPath []PathStage `json:"criticalpath"`
+
+	// The median time for an item to travel the critical path in nanoseconds
+	CriticalPathDuration int64 `json:"criticalpathduration"`
+
+	// The jointJS ID of the stage on the critical path contributing the most time
+	Bottleneck string `json:"bottleneck"`
+
+	// What limits the bottleneck - one of queue|execution
+	BottleneckReason string `json:"bottleneckreason"`
+
+	// A human readable suggestion for relieving the bottleneck
+	Recommendation string `json:"recommendation"`
+}
+
+// Analytics : Get runtime statistics and the critical path of a pipeline
+//
+// GET /analytics/:pipeline
+//
+// Request parameters:
+// - pipeline : The name of the pipeline
+// - hours    : [optional, query] The window statistics are calculated over (default 24, max 168)
+//
+// Response codes:
+// - 200 OK Message will contain the Analytics
+// - 400 Bad request if hours is invalid
+// - 404 Not found if the pipeline does not exist
+// - 500 Internal server error
+func (api *API) Analytics(c *gin.Context) {
+	result := Result{
+		Code:   200,
+		Result: "OK",
+	}
+
+	var (
+		pipelineName string = c.Params.ByName("pipeline")
+		hours        int    = ANALYTICS_HOURS
+		err          error
+	)
+
+	if value := c.Query("hours"); value != "" {
+		if hours, err = strconv.Atoi(value); err != nil || hours < 1 || hours > STATS_HOURS {
+			result.Code = 400
+			result.Result = "Error"
+			result.Message = fmt.Sprintf("hours must be between 1 and %d", STATS_HOURS)
+			c.JSON(result.Code, result)
+			return
+		}
+	}
+
+	pipeline, err := pipeline.GetPipeline(api.Config, pipelineName)
+	if err != nil {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "Error opening pipeline " + pipelineName + " " + err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	analytics, err := api.analyse(pipeline, hours)
+	if err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+	result.Message = analytics
+	c.JSON(result.Code, result)
+}
+
+// analyse : Build the analytics for a pipeline from the command statistics
+func (api *API) analyse(pipeline *pipeline.Pipeline, hours int) (*Analytics, error) {
+	stats, err := api.commandStats(pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	queued, running, err := api.activeCounts(pipeline.BucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	analytics := Analytics{
+		Pipeline:     pipeline.Name,
+		Hours:        hours,
+		Commands:     make([]CommandAnalytics, 0),
+		CriticalPath: make([]PathStage, 0),
+	}
+
+	byCommand := make(map[string]*CommandAnalytics)
+	for _, item := range stats {
+		analysis := analyseCommand(&item, hours)
+		analysis.Queued = queued[item.CommandID]
+		analysis.Running = running[item.CommandID]
+		analytics.Commands = append(analytics.Commands, analysis)
+	}
+
+	// commands which have never run still appear so queue depth is visible
+	for id, command := range pipeline.Commands {
+		var found bool = false
+		for _, item := range analytics.Commands {
+			if item.CommandID == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			analytics.Commands = append(analytics.Commands, CommandAnalytics{
+				CommandID: id,
+				Name:      command.Name,
+				Hourly:    make([]HourlyStats, 0),
+				Queued:    queued[id],
+				Running:   running[id],
+			})
+		}
+	}
+
+	sort.Slice(analytics.Commands, func(i, j int) bool {
+		return analytics.Commands[i].Name < analytics.Commands[j].Name
+	})
+	for index := range analytics.Commands {
+		byCommand[analytics.Commands[index].CommandID] = &analytics.Commands[index]
+	}
+
+	analytics.criticalPath(pipeline, byCommand)
+	return &analytics, nil
+}
+
+// analyseCommand : Calculate the runtime statistics of a single command
+func analyseCommand(stats *CommandStats, hours int) CommandAnalytics {
+	analysis := CommandAnalytics{
+		CommandID: stats.CommandID,
+		Name:      stats.Name,
+		Hourly:    make([]HourlyStats, 0),
+	}
+
+	var (
+		since     int64   = time.Now().Truncate(time.Hour).Unix() - int64(hours-1)*3600
+		durations []int64 = make([]int64, 0)
+		waits     []int64 = make([]int64, 0)
+		completed int
+		failed    int
+	)
+
+	// samples are timed in unix nano, hours in unix seconds
+	for _, sample := range stats.Recent {
+		if sample.Time/int64(time.Second) < since {
+			continue
+		}
+		durations = append(durations, sample.Duration)
+		if sample.Wait > 0 {
+			waits = append(waits, sample.Wait)
+		}
+	}
+	analysis.Samples = len(durations)
+	analysis.Duration = percentiles(durations)
+	analysis.Wait = percentiles(waits)
+
+	for _, hour := range stats.Hourly {
+		if hour.Hour < since {
+			continue
+		}
+		analysis.Hourly = append(analysis.Hourly, hour)
+		completed += hour.Completed
+		failed += hour.Failed
+	}
+	analysis.Throughput = float64(completed) / float64(hours)
+	if completed+failed > 0 {
+		analysis.FailureRate = float64(failed) / float64(completed+failed)
+	}
+	return analysis
+}
+
+// criticalPath : Find the slowest route from a start command to an end command
+//
+// Each command is weighted by its median queue wait plus median execution
+// time. The stage contributing most to the path is reported as the
+// bottleneck.
+func (analytics *Analytics) criticalPath(pipeline *pipeline.Pipeline, commands map[string]*CommandAnalytics) {
+	var (
+		weight   map[string]int64  = make(map[string]int64)
+		longest  map[string]int64  = make(map[string]int64)
+		next     map[string]string = make(map[string]string)
+		visiting map[string]bool   = make(map[string]bool)
+		ends     map[string]bool   = make(map[string]bool)
+		walk     func(id string) int64
+	)
+
+	for id, analysis := range commands {
+		weight[id] = analysis.Duration.P50 + analysis.Wait.P50
+	}
+	for _, id := range pipeline.GetEndIds() {
+		ends[id] = true
+	}
+
+	walk = func(id string) int64 {
+		if value, ok := longest[id]; ok {
+			return value
+		}
+		// guard against cycles in badly formed pipelines
+		if visiting[id] {
+			return 0
+		}
+		visiting[id] = true
+		defer delete(visiting, id)
+
+		var best int64 = -1
+		if !ends[id] {
+			for _, target := range pipeline.GetNextID(pipeline.Commands[id]) {
+				if _, ok := pipeline.Commands[target]; !ok {
+					continue
+				}
+				if value := walk(target); value > best {
+					best = value
+					next[id] = target
+				}
+			}
+		}
+		if best < 0 {
+			best = 0
+		}
+		longest[id] = weight[id] + best
+		return longest[id]
+	}
+
+	var (
+		start string
+		total int64 = -1
+	)
+	for _, command := range pipeline.GetStart() {
+		if value := walk(command.ID); value > total {
+			total = value
+			start = command.ID
+		}
+	}
+	if start == "" {
+		return
+	}
+
+	var slowest int64 = -1
+	for id, count := start, 0; id != "" && count < len(pipeline.Commands); id, count = next[id], count+1 {
+		stage := PathStage{
+			CommandID: id,
+			Name:      pipeline.Commands[id].Name,
+		}
+		if analysis, ok := commands[id]; ok {
+			stage.Duration = analysis.Duration.P50
+			stage.Wait = analysis.Wait.P50
+		}
+		analytics.CriticalPath = append(analytics.CriticalPath, stage)
+
+		if stage.Duration+stage.Wait > slowest {
+			slowest = stage.Duration + stage.Wait
+			analytics.Bottleneck = id
+			analytics.BottleneckReason = "execution"
+			if stage.Wait > stage.Duration {
+				analytics.BottleneckReason = "queue"
+			}
+		}
+	}
+	analytics.CriticalPathDuration = total
+
+	if slowest <= 0 {
+		analytics.Bottleneck = ""
+		analytics.BottleneckReason = ""
+		return
+	}
+
+	var name string = pipeline.Commands[analytics.Bottleneck].Name
+	switch analytics.BottleneckReason {
+	case "queue":
+		analytics.Recommendation = fmt.Sprintf(
+			"Scale up %s - items wait longer on the queue than they take to execute", name)
+	default:
+		analytics.Recommendation = fmt.Sprintf(
+			"%s is the slowest stage - consider increasing its CPU or memory, or splitting its work", name)
+	}
+}
+
+// activeCounts : Count the items queued and running for each command of a pipeline
+func (api *API) activeCounts(pipelineName string) (map[string]int, map[string]int, error) {
+	queued := make(map[string]int)
+	running := make(map[string]int)
+	err := api.Db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket([]byte("queue")); b != nil {
+			if b = b.Bucket([]byte(pipelineName)); b != nil {
+				if err := b.ForEach(func(k, v []byte) error {
+					queued[string(v)]++
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+		}
+
+		if b := tx.Bucket([]byte(RUNNING_BUCKET)); b != nil {
+			if b = b.Bucket([]byte(pipelineName)); b != nil {
+				return b.ForEach(func(k, v []byte) error {
+					item := RunningItem{}
+					body, _ := base64.StdEncoding.DecodeString(string(v))
+					if err := json.Unmarshal(body, &item); err == nil {
+						running[item.CommandID]++
+					}
+					return nil
+				})
+			}
+		}
+		return nil
+	})
+	return queued, running, err
+}
+
+// percentiles : Calculate the distribution of a set of durations
+func percentiles(values []int64) Percentiles {
+	result := Percentiles{}
+	if len(values) == 0 {
+		return result
+	}
+
+	floats := make([]float64, len(values))
+	var total int64
+	for index, value := range values {
+		floats[index] = float64(value)
+		total += value
+		if value > result.Max {
+			result.Max = value
+		}
+	}
+	result.P50 = int64(percentile(floats, 0.50))
+	result.P90 = int64(percentile(floats, 0.90))
+	result.P95 = int64(percentile(floats, 0.95))
+	result.P99 = int64(percentile(floats, 0.99))
+	result.Mean = total / int64(len(values))
+	return result
+}
@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/client"
 	"github.com/notapipeline/tiyo/pkg/config"
 )
 
@@ -36,14 +38,21 @@ func NewResult() *Result {
 type API struct {
 
 	// The bolt database held by this installation
-	Db *bolt.DB
+	Db *Database
 
 	// Server Configuration
 	Config *config.Config
 
+	// Client for the flow API
+	Flow *client.Flow
+
 	// A map of queue sizes
 	QueueSize map[string]int
 
+	// If set, decides whether the caller may see a key or child bucket
+	// found at the top of bucket when scanning
+	Visible func(c *gin.Context, bucket string, key string) bool
+
 	// The lock table for the queues
 	queueLock *Lock
 }
@@ -52,13 +61,15 @@ type API struct {
 func NewAPI(dbName string, c *config.Config) (*API, error) {
 	api := API{
 		Config: c,
+		Flow:   client.NewFlow(c),
 	}
 
-	var err error
-	api.Db, err = bolt.Open(dbName, 0600, &bolt.Options{Timeout: 2 * time.Second})
+	db, err := bolt.Open(dbName, 0600, &bolt.Options{Timeout: 2 * time.Second})
 	if err != nil {
 		return nil, err
 	}
+	api.Db = &Database{DB: db}
+	api.registerCollectors()
 	api.QueueSize = make(map[string]int)
 	lock := Lock{
 		locks: make([]string, 0),
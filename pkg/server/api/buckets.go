@@ -11,10 +11,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/notapipeline/tiyo/pkg/logging"
 	"net/http"
 
 	"github.com/boltdb/bolt"
 	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/tracing"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -176,12 +178,18 @@ func (api *API) DeleteKey(c *gin.Context) {
 		if err := b.Delete([]byte(request.Key)); err != nil {
 			return fmt.Errorf("Error deleting key %s - %s", request.Key, err)
 		}
+
+		if request.Bucket == "files" && request.Child != "" {
+			return deleteTrace(tx, request.Child, TRACE_FILE+request.Key)
+		}
 		return nil
 	}); err != nil {
 		log.Println(err)
 		result.Code = 500
 		result.Result = "Error"
 		result.Message = err
+	} else if request.Bucket == "files" {
+		fileEvents.WithLabelValues(request.Child, "delete").Inc()
 	}
 	if result.Code == 202 {
 		c.JSON(result.Code, nil)
@@ -232,11 +240,19 @@ func (api *API) Put(c *gin.Context) {
 			return fmt.Errorf("create kv: %s", err)
 		}
 
+		// files carry the trace fill started so it follows them onto the queue
+		if request.Bucket == "files" && request.Child != "" {
+			// files uploaded directly rather than through fill begin their run here
+			ctx := logging.StartRun(c.Request.Context())
+			return api.putTrace(tx, request.Child, TRACE_FILE+request.Key, tracing.Inject(ctx))
+		}
 		return nil
 	}); err != nil {
 		result.Code = 500
 		result.Result = "Error"
 		result.Message = err
+	} else if request.Bucket == "files" {
+		fileEvents.WithLabelValues(request.Child, "write").Inc()
 	}
 	if result.Code == 204 {
 		c.JSON(result.Code, nil)
@@ -299,6 +315,9 @@ func (api *API) Get(c *gin.Context) {
 // - child  [optional] The child bucket to scan instead
 // - key    [optional] If key is not specified, all contents will be returned
 //
+// Entries at the top of the bucket the caller may not see, as decided by
+// API.Visible, are left out.
+//
 // Response codes
 // - 200 OK Messsage will be a map of matching key/value pairs
 // - 400 Bad Request if bucket name is empty
@@ -321,6 +340,11 @@ func (api *API) PrefixScan(c *gin.Context) {
 	scanResults.Keys = make(map[string]string)
 	scanResults.KeyLen = 0
 
+	// only entries at the top of a bucket are filtered
+	visible := func(key []byte) bool {
+		return request.Child != "" || api.Visible == nil || api.Visible(c, request.Bucket, string(key))
+	}
+
 	if err := api.Db.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket([]byte(request.Bucket))
 
@@ -335,6 +359,9 @@ func (api *API) PrefixScan(c *gin.Context) {
 		if request.Key != "" {
 			prefix := []byte(request.Key)
 			for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+				if !visible(k) {
+					continue
+				}
 				if v == nil {
 					scanResults.Buckets = append(scanResults.Buckets, string(k))
 					scanResults.BucketsLength++
@@ -345,6 +372,9 @@ func (api *API) PrefixScan(c *gin.Context) {
 			}
 		} else {
 			for k, v := c.First(); k != nil; k, v = c.Next() {
+				if !visible(k) {
+					continue
+				}
 				if v == nil {
 					scanResults.Buckets = append(scanResults.Buckets, string(k))
 					scanResults.BucketsLength++
@@ -0,0 +1,279 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/notapipeline/tiyo/pkg/logging"
+	"github.com/notapipeline/tiyo/pkg/pipeline"
+	"github.com/notapipeline/tiyo/pkg/tracing"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// EVENTS_BUCKET : The bucket holding non-filesystem events
+const EVENTS_BUCKET = "events"
+
+// EVENT_PREFIX : The subfolder used in queue keys for event items
+//
+// The slash keeps it apart from the directory names fill uses as the
+// subfolder of file items.
+const EVENT_PREFIX = "/event"
+
+// EVENT_MAX_SIZE : The largest event payload accepted in bytes
+const EVENT_MAX_SIZE = 1 << 20
+
+// Event : A non-filesystem event posted to a pipeline
+type Event struct {
+
+	// The unique ID of the event
+	ID string `json:"id"`
+
+	// The source element the event was posted for, if any
+	Source string `json:"source"`
+
+	// The raw JSON payload of the event
+	Payload json.RawMessage `json:"payload"`
+
+	// The time the event was received in unix nano
+	Received int64 `json:"received"`
+
+	// The state of the event against each container tag
+	State map[string]string `json:"state"`
+}
+
+// PostEvent : Accept an arbitrary JSON payload and queue it against the pipeline
+//
+// If source is given, the event is routed to the commands linked from that
+// source element, otherwise it is routed to the start of the pipeline.
+//
+// POST /events/:pipeline
+//
+// Request parameters:
+// - pipeline : The name of the pipeline to trigger
+// - source   : [optional, query] The ID or name of the source element
+//
+// Response codes:
+// - 201 Created Message will contain the event ID
+// - 400 Bad request if the body is not valid JSON
+// - 404 Not found if the pipeline or source do not exist
+// - 413 Request entity too large if the body is over EVENT_MAX_SIZE
+// - 500 Internal server error
+func (api *API) PostEvent(c *gin.Context) {
+	result := Result{
+		Code:   201,
+		Result: "OK",
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, EVENT_MAX_SIZE))
+	if err != nil && len(body) >= EVENT_MAX_SIZE {
+		result.Code = http.StatusRequestEntityTooLarge
+		result.Result = "Error"
+		result.Message = fmt.Sprintf("Event payload must be at most %d bytes", EVENT_MAX_SIZE)
+		c.JSON(result.Code, result)
+		return
+	}
+	if err != nil || !json.Valid(body) {
+		result.Code = 400
+		result.Result = "Error"
+		result.Message = "Event payload must be valid JSON"
+		c.JSON(result.Code, result)
+		return
+	}
+
+	event, code, err := api.queueEvent(c.Request.Context(), c.Params.ByName("pipeline"), c.Query("source"), body)
+	if err != nil {
+		result.Code = code
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+	result.Message = event.ID
+	c.JSON(result.Code, result)
+}
+
+// queueEvent : Store an event and queue it against the commands it routes to
+//
+// Returns the event, or a HTTP status code and error on failure
+func (api *API) queueEvent(ctx context.Context, pipelineName string, sourceName string, body []byte) (*Event, int, error) {
+	ctx = logging.With(logging.StartRun(ctx), log.Fields{logging.FIELD_PIPELINE: pipelineName})
+	var commands []*pipeline.Command
+	pipeline, err := pipeline.GetPipeline(api.Config, pipelineName)
+	if err != nil {
+		return nil, 404, fmt.Errorf("Error opening pipeline %s %s", pipelineName, err)
+	}
+
+	if sourceName != "" {
+		source := pipeline.GetSource(sourceName)
+		if source == nil {
+			return nil, 404, fmt.Errorf("No source %s in pipeline %s", sourceName, pipelineName)
+		}
+		commands = pipeline.GetSourceTargets(source)
+	} else {
+		commands = pipeline.GetStart()
+	}
+
+	if err := api.ensureBuckets(pipeline.BucketName, EVENTS_BUCKET, "queue"); err != nil {
+		return nil, 500, err
+	}
+
+	event := Event{
+		ID:       uuid.New().String(),
+		Source:   sourceName,
+		Payload:  json.RawMessage(body),
+		Received: time.Now().UnixNano(),
+		State:    make(map[string]string),
+	}
+
+	if err := api.Db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte("queue")).Bucket([]byte(pipeline.BucketName))
+		queued, err := api.childBucket(tx, QUEUED_BUCKET, pipeline.BucketName)
+		if err != nil {
+			return err
+		}
+		for _, command := range commands {
+			parent := pipeline.GetParent(command)
+			if parent == nil {
+				logging.Entry(ctx).WithField(logging.FIELD_COMMAND, command.Name).Warn("Not routing event - command is not in a container")
+				continue
+			}
+			tag := command.GetContainer(true)
+			key := tag + ":" + parent.Name + ":" + EVENT_PREFIX + ":" + event.ID
+			if err := b.Put([]byte(key), []byte(command.ID)); err != nil {
+				return fmt.Errorf("create kv: %s", err)
+			}
+			if err := queued.Put([]byte(key), []byte(strconv.FormatInt(event.Received, 10))); err != nil {
+				return fmt.Errorf("create kv: %s", err)
+			}
+
+			queueCtx, span := tracing.Start(ctx, "enqueue "+command.Name, trace.WithAttributes(
+				attribute.String("tiyo.pipeline", pipeline.Name),
+				attribute.String("tiyo.event", event.ID)))
+			span.End()
+			if err := api.putTrace(tx, pipeline.BucketName, TRACE_QUEUE+key, tracing.Inject(queueCtx)); err != nil {
+				return err
+			}
+			event.State[tag] = "queued"
+		}
+
+		data, _ := json.Marshal(event)
+		b = tx.Bucket([]byte(EVENTS_BUCKET)).Bucket([]byte(pipeline.BucketName))
+		return b.Put([]byte(event.ID), []byte(base64.StdEncoding.EncodeToString(data)))
+	}); err != nil {
+		return nil, 500, err
+	}
+
+	var source string = sourceName
+	if source == "" {
+		source = "webhook"
+	}
+	sourceEvents.WithLabelValues(pipeline.Name, source).Inc()
+	logging.Entry(ctx).Info("Queued event ", event.ID, " for ", len(event.State), " commands")
+	return &event, 201, nil
+}
+
+// GetEvent : Get an event and its current state
+//
+// GET /events/:pipeline/:id
+//
+// Request parameters:
+// - pipeline : The name of the pipeline the event was posted to
+// - id       : The event ID
+//
+// Response codes:
+// - 200 OK Message will contain the event
+// - 404 Not found
+// - 500 Internal server error
+func (api *API) GetEvent(c *gin.Context) {
+	result := Result{
+		Code:   200,
+		Result: "OK",
+	}
+
+	var pipelineName string = pipeline.Sanitize(c.Params.ByName("pipeline"), "_")
+	event, err := api.getEvent(pipelineName, c.Params.ByName("id"))
+	if err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+	} else if event == nil {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "No such event " + c.Params.ByName("id")
+	} else {
+		result.Message = event
+	}
+	c.JSON(result.Code, result)
+}
+
+// getEvent : Load an event from the events bucket
+//
+// Returns nil if the event does not exist
+func (api *API) getEvent(pipelineName string, id string) (*Event, error) {
+	var event *Event
+	err := api.Db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(EVENTS_BUCKET))
+		if b == nil {
+			return nil
+		}
+		if b = b.Bucket([]byte(pipelineName)); b == nil {
+			return nil
+		}
+		value := b.Get([]byte(id))
+		if value == nil {
+			return nil
+		}
+		body, err := base64.StdEncoding.DecodeString(string(value))
+		if err != nil {
+			return err
+		}
+		event = &Event{}
+		return json.Unmarshal(body, event)
+	})
+	return event, err
+}
+
+// setEventState : Update the state of a container against an event
+func (api *API) setEventState(pipelineName string, id string, tag string, state string) error {
+	return api.Db.Update(func(tx *bolt.Tx) error {
+		var b *bolt.Bucket
+		if events := tx.Bucket([]byte(EVENTS_BUCKET)); events != nil {
+			b = events.Bucket([]byte(pipelineName))
+		}
+		if b == nil {
+			return fmt.Errorf("No such event %s", id)
+		}
+		value := b.Get([]byte(id))
+		if value == nil {
+			return fmt.Errorf("No such event %s", id)
+		}
+		body, _ := base64.StdEncoding.DecodeString(string(value))
+		event := Event{}
+		if err := json.Unmarshal(body, &event); err != nil {
+			return err
+		}
+		if event.State == nil {
+			event.State = make(map[string]string)
+		}
+		event.State[tag] = state
+		body, _ = json.Marshal(event)
+		return b.Put([]byte(id), []byte(base64.StdEncoding.EncodeToString(body)))
+	})
+}
@@ -7,15 +7,10 @@
 package api
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 
 	"github.com/boltdb/bolt"
 	"github.com/gin-gonic/gin"
-	"github.com/notapipeline/tiyo/pkg/config"
 	"github.com/notapipeline/tiyo/pkg/pipeline"
 	log "github.com/sirupsen/logrus"
 )
@@ -87,11 +82,12 @@ func (api *API) DestroyFlow(c *gin.Context) {
 	}
 
 	var pipelineName string = pipeline.Sanitize(content["pipeline"], "_")
-	for _, name := range []string{"events", "files", "pods", "queue"} {
+	for _, name := range []string{"events", "files", "pods", "queue", RUNNING_BUCKET, SUBMISSIONS_BUCKET, LOGS_BUCKET, ATTEMPTS_BUCKET, QUEUED_BUCKET, STAGES_BUCKET, TRACES_BUCKET, SCHEDULES_BUCKET, STATS_BUCKET} {
 		if err := api.Db.Update(func(tx *bolt.Tx) error {
+			// schedules and stats are only created once used
 			b := tx.Bucket([]byte(name))
 			if b == nil {
-				return fmt.Errorf("No such bucket")
+				return nil
 			}
 
 			if val := b.Get([]byte(pipelineName)); val == nil {
@@ -134,30 +130,9 @@ func (api *API) forwardPost(c *gin.Context, endpoint string) (Result, map[string
 		}
 		return result, content, err
 	}
-	data, _ := json.Marshal(content)
 
-	serverAddress := api.Config.FlowServer()
-	request, err := http.NewRequest(
-		http.MethodPost,
-		serverAddress+"/api/v1/"+endpoint,
-		bytes.NewBuffer(data))
-	if err != nil {
-		log.Error("Failed to build the request ", err)
-		result := Result{
-			Code:    500,
-			Result:  "Error",
-			Message: err.Error(),
-		}
-		return result, content, err
-	}
-	request.Header.Set("Content-Type", "application/json; charset=utf-8")
-	request.Header.Set("Connection", "close")
-	request.Close = true
-	client := &http.Client{
-		Timeout: config.TIMEOUT,
-	}
-	response, err := client.Do(request)
-	if err != nil {
+	response, err := api.Flow.Forward(c.Request.Context(), endpoint, content)
+	if err != nil && response == nil {
 		log.Error("Client request failed ", err)
 		result := Result{
 			Code:    500,
@@ -166,30 +141,7 @@ func (api *API) forwardPost(c *gin.Context, endpoint string) (Result, map[string
 		}
 		return result, content, err
 	}
-	defer response.Body.Close()
-	body, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		log.Error("Failed to read body ", err)
-		result := Result{
-			Code:    500,
-			Result:  "Error",
-			Message: err.Error(),
-		}
-		return result, content, err
-	}
-
-	ncontent := Result{}
-	err = json.Unmarshal(body, &ncontent)
-	if err != nil {
-		log.Error("Failed to unmarshal JSON object ", err)
-		result := Result{
-			Code:    500,
-			Result:  "Error",
-			Message: err.Error(),
-		}
-		return result, content, err
-	}
-	return ncontent, content, nil
+	return Result(*response), content, nil
 }
 
 // FlowStatus : Get the status of all items in the current pipeline
@@ -201,47 +153,8 @@ func (api *API) forwardPost(c *gin.Context, endpoint string) (Result, map[string
 // - 200 OK - Statuses will be the message field in the response
 // - 500 if status cannot be retrieved
 func (api *API) FlowStatus(c *gin.Context) {
-	res := make(map[string]string)
-	res["pipeline"] = c.Params.ByName("pipeline")
-	data, _ := json.Marshal(res)
-
-	serverAddress := api.Config.FlowServer()
-	request, err := http.NewRequest(
-		http.MethodPost,
-		serverAddress+"/api/v1/status",
-		bytes.NewBuffer(data))
-	if err != nil {
-		log.Error(err)
-		result := Result{
-			Code:    500,
-			Result:  "Error",
-			Message: err.Error(),
-		}
-		c.JSON(result.Code, result)
-		return
-	}
-	request.Header.Set("Content-Type", "application/json; charset=utf-8")
-	request.Header.Set("Connection", "close")
-	request.Close = true
-	client := &http.Client{
-		Timeout: config.TIMEOUT,
-	}
-	response, err := client.Do(request)
-	if err != nil {
-		log.Error(err)
-		result := Result{
-			Code:    500,
-			Result:  "Error",
-			Message: err.Error(),
-		}
-		c.JSON(result.Code, result)
-		return
-	}
-	defer response.Body.Close()
-	body, err := ioutil.ReadAll(response.Body)
-	content := Result{}
-	err = json.Unmarshal(body, &content)
-	if err != nil {
+	response, err := api.Flow.Status(c.Request.Context(), c.Params.ByName("pipeline"))
+	if err != nil && response == nil {
 		log.Error(err)
 		result := Result{
 			Code:    500,
@@ -251,5 +164,5 @@ func (api *API) FlowStatus(c *gin.Context) {
 		c.JSON(result.Code, result)
 		return
 	}
-	c.JSON(content.Code, content)
+	c.JSON(response.Code, Result(*response))
 }
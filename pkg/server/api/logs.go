@@ -0,0 +1,689 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/pipeline"
+	log "github.com/sirupsen/logrus"
+)
+
+// LOGS_BUCKET : The bucket holding command output shipped by syphon
+const LOGS_BUCKET = "logs"
+
+// ATTEMPTS_BUCKET : The bucket counting how many times each key has been executed per container
+const ATTEMPTS_BUCKET = "attempts"
+
+// LOG_MAX_ITEM_SIZE : The maximum number of bytes stored for a single queue item
+const LOG_MAX_ITEM_SIZE = 4 * 1024 * 1024
+
+// LOG_MAX_PIPELINE_SIZE : The maximum number of bytes stored for all items in a pipeline
+//
+// When exceeded, the logs of the oldest finished items are removed
+const LOG_MAX_PIPELINE_SIZE = 256 * 1024 * 1024
+
+// LOG_POLL : The number of seconds between checks for new output when streaming
+const LOG_POLL = 1
+
+// errNotRunning : Returned when logs are sent for an item which is not running
+var errNotRunning = errors.New("not running")
+
+// Keys used inside the log buckets
+const (
+	logMetaKey = "meta"
+	logSizeKey = "_size"
+)
+
+// LogChunk : A block of output from a single stream of an executing command
+type LogChunk struct {
+
+	// The queue item ID the output belongs to
+	ID string `json:"id"`
+
+	// The attempt number of the queue item
+	Attempt int `json:"attempt"`
+
+	// An incrementing sequence number for the chunk within the item
+	Sequence int `json:"sequence"`
+
+	// The stream the output was written to - one of stdout|stderr|tiyo
+	Stream string `json:"stream"`
+
+	// The time the first byte of the chunk was written in unix nano
+	Start int64 `json:"start"`
+
+	// The time the last byte of the chunk was written in unix nano
+	End int64 `json:"end"`
+
+	// The output itself
+	Data string `json:"data"`
+}
+
+// LogRequest : A batch of chunks sent from flow on behalf of syphon
+type LogRequest struct {
+
+	// The pipeline the item belongs to
+	Pipeline string `json:"pipeline"`
+
+	// The queue item ID
+	ID string `json:"id"`
+
+	// Is this the last batch for the item
+	Final bool `json:"final"`
+
+	// The chunks to store
+	Chunks []LogChunk `json:"chunks"`
+}
+
+// ItemLog : Details of the output stored for a queue item
+type ItemLog struct {
+
+	// The queue item ID
+	ID string `json:"id"`
+
+	// The key of the item in the files bucket or event:<id>
+	Key string `json:"key"`
+
+	// The container tag the item executed against
+	Tag string `json:"tag"`
+
+	// The jointJS ID of the command
+	CommandID string `json:"command"`
+
+	// The pod the item executed on
+	Pod string `json:"pod"`
+
+	// The attempt number of the item
+	Attempt int `json:"attempt"`
+
+	// The time the item was handed out in unix nano
+	Started int64 `json:"started"`
+
+	// The time output was last received in unix nano
+	Updated int64 `json:"updated"`
+
+	// The number of bytes stored
+	Size int64 `json:"size"`
+
+	// The number of chunks stored
+	Chunks int `json:"chunks"`
+
+	// Was output dropped because the item exceeded LOG_MAX_ITEM_SIZE
+	Truncated bool `json:"truncated"`
+
+	// Has the item finished writing output
+	Finished bool `json:"finished"`
+
+	// The final status of the item once complete
+	Status string `json:"status,omitempty"`
+
+	// The exit code of the command once complete
+	ExitCode int `json:"exitcode"`
+
+	// The resources consumed by the command once complete
+	Usage *pipeline.Usage `json:"usage,omitempty"`
+}
+
+// PostLogs : Store a batch of command output
+//
+// INTERNAL used for comms between flow and assemble
+//
+// POST /logs
+//
+// Request parameters:
+// - pipeline : The name of the pipeline the item belongs to
+// - id       : The queue item ID
+// - final    : Is this the last batch for the item
+// - chunks   : A list of LogChunk
+//
+// Response codes:
+// - 204 No content
+// - 400 Bad request
+// - 404 Not found if the item is not running
+// - 500 Internal server error
+func (api *API) PostLogs(c *gin.Context) {
+	result := Result{
+		Code:    204,
+		Result:  "OK",
+		Message: "",
+	}
+
+	request := LogRequest{}
+	if err := c.ShouldBind(&request); err != nil || request.Pipeline == "" || request.ID == "" {
+		result.Code = 400
+		result.Result = "Error"
+		result.Message = "pipeline and id are required"
+		c.JSON(result.Code, result)
+		return
+	}
+
+	if err := api.storeLogs(pipeline.Sanitize(request.Pipeline, "_"), &request); err == errNotRunning {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "No running item " + request.ID
+	} else if err != nil {
+		log.Error("Failed to store logs for ", request.ID, " ", err)
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+	}
+	c.JSON(result.Code, result)
+}
+
+// Logs : List the queue items which have output stored for a pipeline
+//
+// GET /logs/:pipeline
+//
+// Request parameters:
+// - pipeline : The name of the pipeline
+// - key      : [optional, query] Only list items for this files bucket key
+//
+// Response codes:
+// - 200 OK Message will contain a list of ItemLog, newest first
+// - 500 Internal server error
+func (api *API) Logs(c *gin.Context) {
+	result := Result{
+		Code:   200,
+		Result: "OK",
+	}
+
+	var (
+		pipelineName string = pipeline.Sanitize(c.Params.ByName("pipeline"), "_")
+		key          string = c.Query("key")
+		items        []ItemLog
+	)
+
+	if err := api.Db.View(func(tx *bolt.Tx) error {
+		var err error
+		b := tx.Bucket([]byte(LOGS_BUCKET))
+		if b != nil {
+			b = b.Bucket([]byte(pipelineName))
+		}
+		items, err = itemLogs(b)
+		return err
+	}); err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	filtered := make([]ItemLog, 0)
+	for _, item := range items {
+		if key == "" || item.Key == key {
+			filtered = append(filtered, item)
+		}
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].Started > filtered[j].Started
+	})
+	result.Message = filtered
+	c.JSON(result.Code, result)
+}
+
+// GetLog : Fetch the output of a queue item
+//
+// GET /logs/:pipeline/:id
+//
+// Request parameters:
+// - pipeline : The name of the pipeline
+// - id       : The queue item ID
+// - after    : [optional, query] Only return chunks with a sequence greater than this
+// - tail     : [optional, query] Only return the last N lines
+// - stream   : [optional, query] Only return output from stdout or stderr
+// - format   : [optional, query] If "text", the output is returned as plain text
+//
+// Response codes:
+// - 200 OK Message will contain the ItemLog as `log` and a list of LogChunk as `chunks`
+// - 400 Bad request if after or tail are not numeric
+// - 404 Not found
+// - 500 Internal server error
+func (api *API) GetLog(c *gin.Context) {
+	result := Result{
+		Code:   200,
+		Result: "OK",
+	}
+
+	var (
+		pipelineName string = pipeline.Sanitize(c.Params.ByName("pipeline"), "_")
+		id           string = c.Params.ByName("id")
+		after        int    = -1
+		tail         int    = 0
+		err          error
+	)
+
+	if value := c.Query("after"); value != "" {
+		if after, err = strconv.Atoi(value); err != nil {
+			result.Code = 400
+			result.Result = "Error"
+			result.Message = "after must be numeric"
+			c.JSON(result.Code, result)
+			return
+		}
+	}
+
+	if value := c.Query("tail"); value != "" {
+		if tail, err = strconv.Atoi(value); err != nil || tail < 0 {
+			result.Code = 400
+			result.Result = "Error"
+			result.Message = "tail must be a positive number"
+			c.JSON(result.Code, result)
+			return
+		}
+	}
+
+	item, chunks, err := api.readLog(pipelineName, id, after, c.Query("stream"))
+	if err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	if item == nil {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "No logs for " + id
+		c.JSON(result.Code, result)
+		return
+	}
+
+	if tail > 0 {
+		chunks = tailChunks(chunks, tail)
+	}
+
+	if c.Query("format") == "text" {
+		var builder strings.Builder
+		for _, chunk := range chunks {
+			builder.WriteString(chunk.Data)
+		}
+		c.String(result.Code, builder.String())
+		return
+	}
+
+	result.Message = map[string]interface{}{
+		"log":    item,
+		"chunks": chunks,
+	}
+	c.JSON(result.Code, result)
+}
+
+// StreamLog : Follow the output of a queue item as server sent events
+//
+// Each chunk is sent as a `log` event. When the item has finished and all
+// output has been sent, a final `end` event containing the ItemLog is sent
+// and the stream is closed.
+//
+// GET /logs/:pipeline/:id/stream
+//
+// Request parameters:
+// - pipeline : The name of the pipeline
+// - id       : The queue item ID
+// - after    : [optional, query] Only send chunks with a sequence greater than this
+// - stream   : [optional, query] Only send output from stdout or stderr
+//
+// Response codes:
+// - 200 OK
+// - 404 Not found
+func (api *API) StreamLog(c *gin.Context) {
+	var (
+		pipelineName string = pipeline.Sanitize(c.Params.ByName("pipeline"), "_")
+		id           string = c.Params.ByName("id")
+		stream       string = c.Query("stream")
+		after        int    = -1
+	)
+
+	if value, err := strconv.Atoi(c.Query("after")); err == nil {
+		after = value
+	}
+
+	// The log is created when the item is popped from the queue so a
+	// missing log means the item has never run.
+	if item, _, err := api.readLog(pipelineName, id, after, stream); err != nil || item == nil {
+		result := Result{
+			Code:    404,
+			Result:  "Error",
+			Message: "No logs for " + id,
+		}
+		c.JSON(result.Code, result)
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Stream(func(w io.Writer) bool {
+		item, chunks, err := api.readLog(pipelineName, id, after, stream)
+		if err != nil || item == nil {
+			c.SSEvent("end", nil)
+			return false
+		}
+
+		for _, chunk := range chunks {
+			c.SSEvent("log", chunk)
+			after = chunk.Sequence
+		}
+
+		if item.Finished {
+			c.SSEvent("end", item)
+			return false
+		}
+
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case <-time.After(LOG_POLL * time.Second):
+		}
+		return true
+	})
+}
+
+// createLog : Create the log record for an item as it is handed out
+//
+// Returns the attempt number of the item
+func (api *API) createLog(pipelineName string, running *RunningItem) int {
+	var attempt int = 1
+	if err := api.Db.Update(func(tx *bolt.Tx) error {
+		b, err := api.childBucket(tx, ATTEMPTS_BUCKET, pipelineName)
+		if err != nil {
+			return err
+		}
+		var key string = running.Tag + ":" + running.Key
+		if value := b.Get([]byte(key)); value != nil {
+			if count, err := strconv.Atoi(string(value)); err == nil {
+				attempt = count + 1
+			}
+		}
+		if err := b.Put([]byte(key), []byte(strconv.Itoa(attempt))); err != nil {
+			return err
+		}
+
+		if b, err = api.childBucket(tx, LOGS_BUCKET, pipelineName); err != nil {
+			return err
+		}
+		if b, err = b.CreateBucketIfNotExists([]byte(running.ID)); err != nil {
+			return err
+		}
+		return putItemLog(b, &ItemLog{
+			ID:        running.ID,
+			Key:       running.Key,
+			Tag:       running.Tag,
+			CommandID: running.CommandID,
+			Pod:       running.Pod,
+			Attempt:   attempt,
+			Started:   running.Started,
+			Updated:   running.Started,
+		})
+	}); err != nil {
+		log.Error("Failed to create log for ", running.ID, " ", err)
+	}
+	return attempt
+}
+
+// storeLogs : Append a batch of chunks to the log of an item
+//
+// Logs are only accepted for items in the running bucket, otherwise
+// errNotRunning is returned. Chunks belonging to another item are ignored.
+// Output beyond LOG_MAX_ITEM_SIZE is dropped and replaced with a single
+// marker chunk. If the pipeline exceeds LOG_MAX_PIPELINE_SIZE, the oldest
+// finished logs are removed.
+func (api *API) storeLogs(pipelineName string, request *LogRequest) error {
+	return api.Db.Update(func(tx *bolt.Tx) error {
+		running := tx.Bucket([]byte(RUNNING_BUCKET))
+		if running != nil {
+			running = running.Bucket([]byte(pipelineName))
+		}
+		if running == nil || running.Get([]byte(request.ID)) == nil {
+			return errNotRunning
+		}
+
+		root, err := api.childBucket(tx, LOGS_BUCKET, pipelineName)
+		if err != nil {
+			return err
+		}
+		b, err := root.CreateBucketIfNotExists([]byte(request.ID))
+		if err != nil {
+			return err
+		}
+
+		item := getItemLog(b)
+		if item == nil {
+			item = &ItemLog{
+				ID:      request.ID,
+				Started: time.Now().UnixNano(),
+			}
+		}
+
+		var (
+			total int64 = pipelineLogSize(root)
+			now   int64 = time.Now().UnixNano()
+		)
+		for _, chunk := range request.Chunks {
+			if chunk.ID != request.ID {
+				continue
+			}
+			if item.Attempt == 0 {
+				item.Attempt = chunk.Attempt
+			}
+			if item.Truncated {
+				continue
+			}
+
+			if item.Size+int64(len(chunk.Data)) > LOG_MAX_ITEM_SIZE {
+				item.Truncated = true
+				chunk = LogChunk{
+					ID:       request.ID,
+					Attempt:  chunk.Attempt,
+					Sequence: chunk.Sequence,
+					Stream:   "tiyo",
+					Start:    now,
+					End:      now,
+					Data:     fmt.Sprintf("\n[tiyo] output truncated after %d bytes\n", item.Size),
+				}
+			}
+
+			data, _ := json.Marshal(chunk)
+			if err := b.Put([]byte(logChunkKey(chunk.Sequence)), []byte(base64.StdEncoding.EncodeToString(data))); err != nil {
+				return fmt.Errorf("create kv: %s", err)
+			}
+			item.Size += int64(len(chunk.Data))
+			item.Chunks++
+			total += int64(len(chunk.Data))
+		}
+
+		item.Updated = now
+		item.Finished = item.Finished || request.Final
+		if err := putItemLog(b, item); err != nil {
+			return err
+		}
+
+		if total > LOG_MAX_PIPELINE_SIZE {
+			if total, err = api.evictLogs(root, total, request.ID); err != nil {
+				return err
+			}
+		}
+		return root.Put([]byte(logSizeKey), []byte(strconv.FormatInt(total, 10)))
+	})
+}
+
+// evictLogs : Remove the oldest finished logs until the pipeline is back under its limit
+//
+// Returns the new total size of the pipeline logs
+func (api *API) evictLogs(root *bolt.Bucket, total int64, keep string) (int64, error) {
+	items, err := itemLogs(root)
+	if err != nil {
+		return total, err
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Started < items[j].Started
+	})
+
+	for _, item := range items {
+		if total <= LOG_MAX_PIPELINE_SIZE {
+			break
+		}
+		if item.ID == keep || !item.Finished {
+			continue
+		}
+		log.Info("Removing logs for ", item.ID, " - pipeline log size exceeds ", LOG_MAX_PIPELINE_SIZE)
+		if err := root.DeleteBucket([]byte(item.ID)); err != nil {
+			return total, err
+		}
+		total -= item.Size
+	}
+	return total, nil
+}
+
+// readLog : Read the log of an item
+//
+// Returns nil if the item has no log
+func (api *API) readLog(pipelineName string, id string, after int, stream string) (*ItemLog, []LogChunk, error) {
+	var (
+		item   *ItemLog
+		chunks []LogChunk = make([]LogChunk, 0)
+	)
+	err := api.Db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(LOGS_BUCKET))
+		if b == nil {
+			return nil
+		}
+		if b = b.Bucket([]byte(pipelineName)); b == nil {
+			return nil
+		}
+		if b = b.Bucket([]byte(id)); b == nil {
+			return nil
+		}
+		item = getItemLog(b)
+
+		c := b.Cursor()
+		for k, v := c.Seek([]byte(logChunkKey(after + 1))); k != nil; k, v = c.Next() {
+			if string(k) == logMetaKey {
+				continue
+			}
+			chunk := LogChunk{}
+			body, _ := base64.StdEncoding.DecodeString(string(v))
+			if err := json.Unmarshal(body, &chunk); err != nil {
+				log.Error("Invalid log chunk ", string(k), " for ", id, " ", err)
+				continue
+			}
+			if stream != "" && chunk.Stream != stream {
+				continue
+			}
+			chunks = append(chunks, chunk)
+		}
+		return nil
+	})
+	return item, chunks, err
+}
+
+// itemLogs : List the log records held in a pipeline log bucket
+func itemLogs(b *bolt.Bucket) ([]ItemLog, error) {
+	items := make([]ItemLog, 0)
+	if b == nil {
+		return items, nil
+	}
+
+	err := b.ForEach(func(k, v []byte) error {
+		// only child buckets hold item logs
+		if v != nil {
+			return nil
+		}
+		if item := getItemLog(b.Bucket(k)); item != nil {
+			items = append(items, *item)
+		}
+		return nil
+	})
+	return items, err
+}
+
+// childBucket : Get or create the pipeline child of a top level bucket within a transaction
+func (api *API) childBucket(tx *bolt.Tx, name string, pipelineName string) (*bolt.Bucket, error) {
+	b, err := tx.CreateBucketIfNotExists([]byte(name))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating bucket %s - %s", name, err)
+	}
+	if b, err = b.CreateBucketIfNotExists([]byte(pipelineName)); err != nil {
+		return nil, fmt.Errorf("Error creating inner bucket %s/%s - %s", name, pipelineName, err)
+	}
+	return b, nil
+}
+
+// getItemLog : Read the log record from an item log bucket
+func getItemLog(b *bolt.Bucket) *ItemLog {
+	if b == nil {
+		return nil
+	}
+	value := b.Get([]byte(logMetaKey))
+	if value == nil {
+		return nil
+	}
+	body, _ := base64.StdEncoding.DecodeString(string(value))
+	item := ItemLog{}
+	if err := json.Unmarshal(body, &item); err != nil {
+		return nil
+	}
+	return &item
+}
+
+// putItemLog : Write the log record into an item log bucket
+func putItemLog(b *bolt.Bucket, item *ItemLog) error {
+	body, _ := json.Marshal(item)
+	return b.Put([]byte(logMetaKey), []byte(base64.StdEncoding.EncodeToString(body)))
+}
+
+// pipelineLogSize : The total number of bytes stored in a pipeline log bucket
+func pipelineLogSize(b *bolt.Bucket) int64 {
+	size, _ := strconv.ParseInt(string(b.Get([]byte(logSizeKey))), 10, 64)
+	return size
+}
+
+// logChunkKey : Zero pad a chunk sequence so chunks sort in order
+func logChunkKey(sequence int) string {
+	if sequence < 0 {
+		sequence = 0
+	}
+	return fmt.Sprintf("%010d", sequence)
+}
+
+// tailChunks : Reduce a list of chunks to those containing the last n lines
+func tailChunks(chunks []LogChunk, n int) []LogChunk {
+	var lines int = 0
+	for i := len(chunks) - 1; i >= 0; i-- {
+		var data string = chunks[i].Data
+		// a trailing newline terminates the last line rather than starting a new one
+		if i == len(chunks)-1 {
+			data = strings.TrimSuffix(data, "\n")
+		}
+		for j := len(data) - 1; j >= 0; j-- {
+			if data[j] != '\n' {
+				continue
+			}
+			lines++
+			if lines == n {
+				if j == len(chunks[i].Data)-1 {
+					return chunks[i+1:]
+				}
+				chunk := chunks[i]
+				chunk.Data = chunk.Data[j+1:]
+				return append([]LogChunk{chunk}, chunks[i+1:]...)
+			}
+		}
+	}
+	return chunks
+}
@@ -0,0 +1,172 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+// Metrics exported by assemble
+var (
+	boltDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "tiyo",
+		Subsystem: "bolt",
+		Name:      "transaction_duration_seconds",
+		Help:      "Time taken by bolt transactions including time spent waiting for the write lock",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"type"})
+
+	fileEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tiyo",
+		Name:      "file_events_total",
+		Help:      "File events received from the filesystem watcher",
+	}, []string{"pipeline", "event"})
+
+	sourceEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tiyo",
+		Name:      "source_events_total",
+		Help:      "Events received from webhooks and message broker sources",
+	}, []string{"pipeline", "source"})
+
+	queueDepthDesc = prometheus.NewDesc(
+		"tiyo_queue_depth",
+		"The number of items waiting on the queue",
+		[]string{"pipeline", "command"}, nil)
+
+	queueRunningDesc = prometheus.NewDesc(
+		"tiyo_queue_running",
+		"The number of queue items currently executing",
+		[]string{"pipeline", "command"}, nil)
+
+	queueOldestDesc = prometheus.NewDesc(
+		"tiyo_queue_oldest_seconds",
+		"How long the oldest item on the queue has been waiting",
+		[]string{"pipeline"}, nil)
+)
+
+// Database : A bolt database which records the duration of each transaction
+type Database struct {
+	*bolt.DB
+}
+
+// View : Execute a read only transaction
+func (db *Database) View(fn func(*bolt.Tx) error) error {
+	start := time.Now()
+	defer func() {
+		boltDuration.WithLabelValues("view").Observe(time.Since(start).Seconds())
+	}()
+	return db.DB.View(fn)
+}
+
+// Update : Execute a read-write transaction
+func (db *Database) Update(fn func(*bolt.Tx) error) error {
+	start := time.Now()
+	defer func() {
+		boltDuration.WithLabelValues("update").Observe(time.Since(start).Seconds())
+	}()
+	return db.DB.Update(fn)
+}
+
+// queueCollector : Reads queue depth from the database each time metrics are scraped
+type queueCollector struct {
+	api *API
+}
+
+// registerCollectors : Register the metrics which are read from the database
+func (api *API) registerCollectors() {
+	if err := prometheus.Register(&queueCollector{api: api}); err != nil {
+		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+			log.Error("Failed to register queue metrics ", err)
+		}
+	}
+}
+
+// Describe : Send the descriptions of the queue metrics
+func (collector *queueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- queueDepthDesc
+	ch <- queueRunningDesc
+	ch <- queueOldestDesc
+}
+
+// Collect : Count queued and running items per pipeline and command
+func (collector *queueCollector) Collect(ch chan<- prometheus.Metric) {
+	var now int64 = time.Now().UnixNano()
+	if err := collector.api.Db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket([]byte("queue")); b != nil {
+			b.ForEach(func(name, v []byte) error {
+				if v != nil {
+					return nil
+				}
+				counts := make(map[string]int)
+				b.Bucket(name).ForEach(func(k, v []byte) error {
+					counts[string(v)]++
+					return nil
+				})
+				for command, count := range counts {
+					ch <- prometheus.MustNewConstMetric(
+						queueDepthDesc, prometheus.GaugeValue, float64(count), string(name), command)
+				}
+				return nil
+			})
+		}
+
+		if b := tx.Bucket([]byte(RUNNING_BUCKET)); b != nil {
+			b.ForEach(func(name, v []byte) error {
+				if v != nil {
+					return nil
+				}
+				counts := make(map[string]int)
+				b.Bucket(name).ForEach(func(k, v []byte) error {
+					item := RunningItem{}
+					body, _ := base64.StdEncoding.DecodeString(string(v))
+					if err := json.Unmarshal(body, &item); err == nil {
+						counts[item.CommandID]++
+					}
+					return nil
+				})
+				for command, count := range counts {
+					ch <- prometheus.MustNewConstMetric(
+						queueRunningDesc, prometheus.GaugeValue, float64(count), string(name), command)
+				}
+				return nil
+			})
+		}
+
+		// a queue which stops draining shows as a growing oldest age
+		if b := tx.Bucket([]byte(QUEUED_BUCKET)); b != nil {
+			b.ForEach(func(name, v []byte) error {
+				if v != nil {
+					return nil
+				}
+				var oldest int64
+				b.Bucket(name).ForEach(func(k, v []byte) error {
+					if queued, err := strconv.ParseInt(string(v), 10, 64); err == nil && (oldest == 0 || queued < oldest) {
+						oldest = queued
+					}
+					return nil
+				})
+				var age float64
+				if oldest != 0 {
+					age = time.Duration(now - oldest).Seconds()
+				}
+				ch <- prometheus.MustNewConstMetric(queueOldestDesc, prometheus.GaugeValue, age, string(name))
+				return nil
+			})
+		}
+		return nil
+	}); err != nil {
+		log.Error("Failed to collect queue metrics ", err)
+	}
+}
@@ -8,20 +8,34 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/boltdb/bolt"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/notapipeline/tiyo/pkg/logging"
 	"github.com/notapipeline/tiyo/pkg/pipeline"
+	"github.com/notapipeline/tiyo/pkg/tracing"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // QueueItem : Type information about an item on the queue
 type QueueItem struct {
 
+	// A unique ID assigned to the item when it is taken off the queue
+	ID string `json:"id"`
+
+	// The number of times this key has been handed out for the container
+	Attempt int `json:"attempt"`
+
 	// The pipeline folder this queue is destined for
 	PipelineFolder string `json:"pipelineFolder"`
 
@@ -37,6 +51,9 @@ type QueueItem struct {
 
 	// The command to execute
 	Command pipeline.Command `json:"command"`
+
+	// The trace context the item is executed under
+	Trace tracing.Carrier `json:"trace,omitempty"`
 }
 
 // PopQueue : Take an item off the queue
@@ -54,6 +71,7 @@ func (api *API) PopQueue(c *gin.Context) {
 		pipelineName string = c.Params.ByName("pipeline")
 		key          string = c.Params.ByName("key")
 	)
+	logger := logging.Entry(logging.With(c.Request.Context(), log.Fields{logging.FIELD_PIPELINE: pipelineName}))
 	pipeline, err := pipeline.GetPipeline(api.Config, pipelineName)
 	if err != nil {
 		result.Code = 500
@@ -62,7 +80,7 @@ func (api *API) PopQueue(c *gin.Context) {
 		c.JSON(result.Code, result)
 		return
 	}
-	log.Info("PopQueue Using pipeline ", pipeline)
+	logger.Info("PopQueue Using pipeline ", pipeline)
 
 	var (
 		// key = 'container:version:hostname'
@@ -95,14 +113,14 @@ func (api *API) PopQueue(c *gin.Context) {
 	}
 
 	if group == "" {
-		log.Error("Invalid group name for key ", key)
+		logger.Error("Invalid group name for key ", key)
 	}
 
 	// rebuild the key
 	key = fmt.Sprintf("%s:%s:%s", container, version, group)
 	if err := api.Db.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket([]byte("queue")).Bucket([]byte(pipeline.BucketName))
-		log.Debug("PopQueue Scanning for key ", key)
+		logger.Debug("PopQueue Scanning for key ", key)
 		c := b.Cursor()
 		for k, v := c.Seek([]byte(key)); k != nil && bytes.HasPrefix(k, []byte(key)); k, v = c.Next() {
 			queue[string(k)] = string(v)
@@ -124,9 +142,9 @@ func (api *API) PopQueue(c *gin.Context) {
 
 		return nil
 	}); err != nil {
-		log.Error(err)
+		logger.Error(err)
 	}
-	log.Debug(queue)
+	logger.Debug(queue)
 
 	// take the first element off the queue and then delete it from the database
 
@@ -155,38 +173,39 @@ func (api *API) PopQueue(c *gin.Context) {
 		return
 	}
 
+	var (
+		queuedAt int64
+		carrier  tracing.Carrier
+	)
 	if err := api.Db.Update(func(tx *bolt.Tx) error {
 		b := tx.Bucket([]byte("queue")).Bucket([]byte(pipeline.BucketName))
 		if err := b.Delete([]byte(activeKey)); err != nil {
 			return fmt.Errorf("Error deleting key %s - %s", activeKey, err)
 		}
+
+		carrier = getTrace(tx, pipeline.BucketName, TRACE_QUEUE+activeKey)
+		if err := deleteTrace(tx, pipeline.BucketName, TRACE_QUEUE+activeKey); err != nil {
+			return err
+		}
+
+		// record how long the item waited on the queue
+		if b = tx.Bucket([]byte(QUEUED_BUCKET)); b != nil {
+			if b = b.Bucket([]byte(pipeline.BucketName)); b != nil {
+				queuedAt, _ = strconv.ParseInt(string(b.Get([]byte(activeKey))), 10, 64)
+				return b.Delete([]byte(activeKey))
+			}
+		}
 		return nil
 	}); err != nil {
-		log.Error(err)
+		logger.Error(err)
 	}
 
 	// update files bucket to store state
 	slice := strings.Split(activeKey, ":")
 	keystr := slice[len(slice)-2] + ":" + slice[len(slice)-1]
-	log.Warn(keystr)
-	if err := api.Db.Update(func(tx *bolt.Tx) error {
-		b := tx.Bucket([]byte("files")).Bucket([]byte(pipeline.BucketName))
-		var tag string = container + ":" + version
-		log.Debug("Updating state in files/", pipeline.BucketName, " for container ", tag)
-		value := b.Get([]byte(keystr))
-		body, _ := base64.StdEncoding.DecodeString(string(value))
-		content := make(map[string]string)
-		json.Unmarshal(body, &content)
-		content[tag] = "in_progress"
-		body, _ = json.Marshal(content)
-		err = b.Put([]byte(keystr), []byte(base64.StdEncoding.EncodeToString([]byte(body))))
-		if err != nil {
-			return fmt.Errorf("create kv: %s", err)
-		}
-
-		return nil
-	}); err != nil {
-		log.Error(err)
+	var tag string = container + ":" + version
+	if err := api.setState(pipeline.BucketName, keystr, tag, STATE_RUNNING); err != nil {
+		logger.Error(err)
 	}
 
 	// now remove it from the lock
@@ -213,14 +232,73 @@ func (api *API) PopQueue(c *gin.Context) {
 		return
 	}
 
+	// record the item as running so it can be tracked and cancelled
+	running := RunningItem{
+		ID:        uuid.New().String(),
+		Pod:       keyparts[2],
+		Tag:       tag,
+		Key:       keystr,
+		CommandID: id,
+		Status:    STATE_RUNNING,
+		Queued:    queuedAt,
+		Started:   time.Now().UnixNano(),
+	}
+	running.Attempt = api.createLog(pipeline.BucketName, &running)
+	if err := api.putRunning(pipeline.BucketName, &running); err != nil {
+		logger.Error(err)
+	}
+	api.updateStages(pipeline.BucketName, []string{keystr}, id, func(stage *SampleStage) {
+		stage.Status = STATE_RUNNING
+		stage.ItemID = running.ID
+		stage.Attempts = running.Attempt
+		stage.Started = running.Started
+		stage.Finished = 0
+		stage.Reason = ""
+		if running.Queued != 0 {
+			stage.Queued = running.Queued
+		}
+	})
+
 	// Now build the response
 	message := QueueItem{
+		ID:             running.ID,
+		Attempt:        running.Attempt,
 		PipelineFolder: pipeline.BucketName,
 		// get subfolder or "" if subfolder is root
 		SubFolder: strings.TrimPrefix(str[len(str)-2], "root"),
 		Filename:  str[len(str)-1],
 		Command:   *pipeline.Commands[id],
 	}
+
+	// the item continues the trace of its file, linked to the request from flow
+	ctx, span := tracing.Start(tracing.Extract(c.Request.Context(), carrier), "dispatch "+message.Command.Name,
+		trace.WithLinks(trace.LinkFromContext(c.Request.Context())),
+		trace.WithAttributes(
+			attribute.String("tiyo.pipeline", pipeline.Name),
+			attribute.String("tiyo.item", running.ID),
+			attribute.String("tiyo.key", keystr),
+			attribute.String("tiyo.pod", running.Pod)))
+	span.End()
+	message.Trace = tracing.Inject(ctx)
+
+	logger = logging.Entry(logging.With(ctx, log.Fields{
+		logging.FIELD_PIPELINE: pipelineName,
+		logging.FIELD_COMMAND:  message.Command.Name,
+		logging.FIELD_ITEM:     running.ID,
+	}))
+	logger.Info("Dispatching ", keystr, " to ", running.Pod)
+
+	// events carry their payload rather than a file
+	if str[len(str)-2] == EVENT_PREFIX {
+		message.SubFolder = ""
+		message.Filename = ""
+		event, err := api.getEvent(pipeline.BucketName, str[len(str)-1])
+		if err != nil || event == nil {
+			logger.Error("Failed to load event ", str[len(str)-1], " ", err)
+		} else {
+			message.Event = string(event.Payload)
+		}
+	}
 	result.Message = message
 	c.JSON(result.Code, result.Message)
 }
@@ -344,47 +422,7 @@ func (api *API) walkFiles(pipeline *pipeline.Pipeline, command *pipeline.Command
 		}
 
 		// Add to queue
-		added := make([]string, 0)
-		if err := api.Db.Update(func(tx *bolt.Tx) error {
-			b := tx.Bucket([]byte("queue")).Bucket([]byte(pipeline.BucketName))
-
-			for k := range available {
-				// need command container name as second
-				key := tag + ":" + pipeline.GetParent(command).Name + ":" + k
-				err := b.Put([]byte(key), []byte(command.ID))
-				if err != nil {
-					return fmt.Errorf("create kv: %s", err)
-				}
-				added = append(added, k)
-				*count--
-				if *count == 0 {
-					break
-				}
-			}
-			return nil
-		}); err != nil {
-			log.Error(err)
-		}
-
-		// update files bucket to store state
-		if err := api.Db.Update(func(tx *bolt.Tx) error {
-			b := tx.Bucket([]byte("files")).Bucket([]byte(pipeline.BucketName))
-			for _, v := range added {
-				log.Debug("Adding ", command.ID, "to files/", pipeline.BucketName)
-				value := available[v]
-				value[tag] = "queued"
-				com, _ := json.Marshal(value)
-				body := base64.StdEncoding.EncodeToString([]byte(com))
-				err := b.Put([]byte(v), []byte(body))
-				if err != nil {
-					return fmt.Errorf("create kv: %s", err)
-				}
-			}
-
-			return nil
-		}); err != nil {
-			log.Error(err)
-		}
+		api.enqueue(pipeline, command, available, count)
 	}
 	if *count != 0 {
 		for _, com := range pipeline.GetNext(command) {
@@ -396,3 +434,83 @@ func (api *API) walkFiles(pipeline *pipeline.Pipeline, command *pipeline.Command
 		}
 	}
 }
+
+// enqueue : Add a set of files to the queue for the given command
+//
+// available is a map of files bucket keys to their current state. Once
+// queued, the state of each file is updated in the files bucket against
+// the command container tag. Returns the list of keys added to the queue.
+func (api *API) enqueue(pipeline *pipeline.Pipeline, command *pipeline.Command, available map[string]map[string]string, count *int) []string {
+	tag := command.GetContainer(true)
+	added := make([]string, 0)
+	if err := api.Db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte("queue")).Bucket([]byte(pipeline.BucketName))
+		queued, err := api.childBucket(tx, QUEUED_BUCKET, pipeline.BucketName)
+		if err != nil {
+			return err
+		}
+
+		var now []byte = []byte(strconv.FormatInt(time.Now().UnixNano(), 10))
+		for k := range available {
+			// need command container name as second
+			key := tag + ":" + pipeline.GetParent(command).Name + ":" + k
+			err := b.Put([]byte(key), []byte(command.ID))
+			if err != nil {
+				return fmt.Errorf("create kv: %s", err)
+			}
+			if err := queued.Put([]byte(key), now); err != nil {
+				return fmt.Errorf("create kv: %s", err)
+			}
+
+			// continue the trace of the file onto the queue item
+			ctx, span := tracing.Start(tracing.Extract(context.Background(), getTrace(tx, pipeline.BucketName, TRACE_FILE+k)),
+				"enqueue "+command.Name, trace.WithAttributes(
+					attribute.String("tiyo.pipeline", pipeline.Name),
+					attribute.String("tiyo.key", k)))
+			span.End()
+			if err := api.putTrace(tx, pipeline.BucketName, TRACE_QUEUE+key, tracing.Inject(ctx)); err != nil {
+				return err
+			}
+			logging.Entry(logging.With(ctx, log.Fields{
+				logging.FIELD_PIPELINE: pipeline.Name,
+				logging.FIELD_COMMAND:  command.Name,
+			})).Debug("Queued ", k)
+			added = append(added, k)
+			*count--
+			if *count == 0 {
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		log.Error(err)
+	}
+
+	// update files bucket to store state
+	if err := api.Db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte("files")).Bucket([]byte(pipeline.BucketName))
+		for _, v := range added {
+			log.Debug("Adding ", command.ID, "to files/", pipeline.BucketName)
+			value := available[v]
+			value[tag] = "queued"
+			com, _ := json.Marshal(value)
+			body := base64.StdEncoding.EncodeToString([]byte(com))
+			err := b.Put([]byte(v), []byte(body))
+			if err != nil {
+				return fmt.Errorf("create kv: %s", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		log.Error(err)
+	}
+
+	var now int64 = time.Now().UnixNano()
+	api.updateStages(pipeline.BucketName, added, command.ID, func(stage *SampleStage) {
+		stage.Status = STAGE_QUEUED
+		stage.Queued = now
+		stage.Reason = ""
+	})
+	return added
+}
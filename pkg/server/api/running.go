@@ -0,0 +1,389 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/logging"
+	"github.com/notapipeline/tiyo/pkg/pipeline"
+	log "github.com/sirupsen/logrus"
+)
+
+// RUNNING_BUCKET : The bucket holding queue items currently assigned to a syphon executor
+const RUNNING_BUCKET = "running"
+
+// QUEUED_BUCKET : The bucket holding the time each queue key was added in unix nano
+const QUEUED_BUCKET = "queued"
+
+// Running item states
+const (
+	STATE_RUNNING    = "in_progress"
+	STATE_CANCELLING = "cancelling"
+	STATE_CANCELLED  = "cancelled"
+	STATE_COMPLETE   = "complete"
+	STATE_FAILED     = "failed"
+)
+
+// RunningItem : A queue item which has been handed out to a syphon executor
+type RunningItem struct {
+
+	// The ID assigned to the queue item when it was popped from the queue
+	ID string `json:"id"`
+
+	// The pod the item was handed to
+	Pod string `json:"pod"`
+
+	// The container tag the item is executing against
+	Tag string `json:"tag"`
+
+	// The key of the item in the files bucket
+	Key string `json:"key"`
+
+	// The jointJS ID of the command being executed
+	CommandID string `json:"command"`
+
+	// The number of times the key has been handed out for the container
+	Attempt int `json:"attempt"`
+
+	// The current state of the item
+	Status string `json:"status"`
+
+	// The time the item was queued in unix nano, 0 if unknown
+	Queued int64 `json:"queued"`
+
+	// The time the item was handed out in unix nano
+	Started int64 `json:"started"`
+}
+
+// CompleteRequest : The final status of a queue item as reported by syphon
+type CompleteRequest struct {
+
+	// The pod which executed the item
+	Pod string `json:"pod"`
+
+	// The container which executed the item
+	Container string `json:"container"`
+
+	// The pipeline the item belongs to. Populated by flow.
+	Pipeline string `json:"pipeline"`
+
+	// The ID of the queue item
+	ID string `json:"id"`
+
+	// One of complete|failed|cancelled
+	Status string `json:"status"`
+
+	// The exit code of the command
+	ExitCode int `json:"exitcode"`
+
+	// The resources consumed by the command
+	Usage *pipeline.Usage `json:"usage,omitempty"`
+}
+
+// Running : List the queue items currently executing for a pipeline
+//
+// GET /running/:pipeline[/:id]
+//
+// Request parameters:
+// - pipeline : The name of the pipeline to list running items for
+// - id       : [optional] The ID of a single queue item
+//
+// Response codes:
+// - 200 OK Message will contain the running item(s)
+// - 404 Not found if the id is not running
+// - 500 Internal server error
+func (api *API) Running(c *gin.Context) {
+	result := Result{
+		Code:   200,
+		Result: "OK",
+	}
+
+	var (
+		pipelineName string = pipeline.Sanitize(c.Params.ByName("pipeline"), "_")
+		id           string = c.Params.ByName("id")
+	)
+
+	if id != "" {
+		item, err := api.getRunning(pipelineName, id)
+		if err != nil {
+			result.Code = 500
+			result.Result = "Error"
+			result.Message = err.Error()
+		} else if item == nil {
+			result.Code = 404
+			result.Result = "Error"
+			result.Message = "No such item " + id
+		} else {
+			result.Message = item
+		}
+		c.JSON(result.Code, result)
+		return
+	}
+
+	items := make([]RunningItem, 0)
+	if err := api.Db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(RUNNING_BUCKET))
+		if b == nil {
+			return nil
+		}
+		if b = b.Bucket([]byte(pipelineName)); b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			item := RunningItem{}
+			body, _ := base64.StdEncoding.DecodeString(string(v))
+			if err := json.Unmarshal(body, &item); err != nil {
+				log.Error("Invalid running item ", string(k), " ", err)
+				return nil
+			}
+			items = append(items, item)
+			return nil
+		})
+	}); err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+	result.Message = items
+	c.JSON(result.Code, result)
+}
+
+// Cancel : Cancel a running queue item
+//
+// The item is marked as cancelling and flow relays this to the syphon
+// executor holding the item on its next heartbeat.
+//
+// POST /cancel
+//
+// Request parameters:
+// - pipeline : The name of the pipeline the item belongs to
+// - id       : The ID of the queue item to cancel
+//
+// Response codes:
+// - 202 Accepted
+// - 400 Bad request if pipeline or id are missing
+// - 404 Not found if the item is not running
+// - 500 Internal server error
+func (api *API) Cancel(c *gin.Context) {
+	result := Result{
+		Code:    202,
+		Result:  "Accepted",
+		Message: "",
+	}
+
+	content := make(map[string]string)
+	if err := c.ShouldBind(&content); err != nil {
+		content["pipeline"] = c.PostForm("pipeline")
+		content["id"] = c.PostForm("id")
+	}
+
+	var (
+		pipelineName string = pipeline.Sanitize(content["pipeline"], "_")
+		id           string = content["id"]
+	)
+	if pipelineName == "" || id == "" {
+		result.Code = 400
+		result.Result = "Error"
+		result.Message = "pipeline and id are required"
+		c.JSON(result.Code, result)
+		return
+	}
+
+	item, err := api.getRunning(pipelineName, id)
+	if err != nil || item == nil {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "No such item " + id
+		c.JSON(result.Code, result)
+		return
+	}
+
+	log.Info("Cancelling ", id, " on ", item.Pod)
+	item.Status = STATE_CANCELLING
+	if err := api.putRunning(pipelineName, item); err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+	}
+	c.JSON(result.Code, result)
+}
+
+// Complete : Record the final state of a running queue item
+//
+// INTERNAL used for comms between flow and assemble
+//
+// POST /complete
+//
+// Request parameters:
+// - pipeline : The name of the pipeline the item belongs to
+// - id       : The ID of the queue item
+// - status   : One of complete|failed|cancelled
+// - exitcode : [optional] The exit code of the command
+// - usage    : [optional] The resources consumed by the command
+//
+// Response codes:
+// - 204 No content
+// - 400 Bad request
+// - 404 Not found if the item is not running
+// - 500 Internal server error
+func (api *API) Complete(c *gin.Context) {
+	result := Result{
+		Code:    204,
+		Result:  "OK",
+		Message: "",
+	}
+
+	request := CompleteRequest{}
+	if err := c.ShouldBind(&request); err != nil {
+		result.Code = 400
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	var (
+		pipelineName string = pipeline.Sanitize(request.Pipeline, "_")
+		id           string = request.ID
+		status       string = request.Status
+	)
+
+	switch status {
+	case STATE_COMPLETE, STATE_FAILED, STATE_CANCELLED:
+	default:
+		result.Code = 400
+		result.Result = "Error"
+		result.Message = "Invalid status " + status
+		c.JSON(result.Code, result)
+		return
+	}
+
+	item, err := api.getRunning(pipelineName, id)
+	if err != nil || item == nil {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "No such item " + id
+		c.JSON(result.Code, result)
+		return
+	}
+
+	logger := logging.Entry(logging.With(c.Request.Context(), log.Fields{
+		logging.FIELD_PIPELINE: pipelineName,
+		logging.FIELD_COMMAND:  item.CommandID,
+		logging.FIELD_ITEM:     id,
+	}))
+	logger.Info("Queue item finished with status ", status)
+	if err := api.setState(pipelineName, item.Key, item.Tag, status); err != nil {
+		logger.Error(err)
+	}
+
+	if err := api.recordUsage(pipelineName, item, &request); err != nil {
+		logger.Error("Failed to record usage ", err)
+	}
+
+	var reason string = api.failureReason(pipelineName, &request)
+	api.updateStages(pipelineName, []string{item.Key}, item.CommandID, func(stage *SampleStage) {
+		stage.Status = status
+		stage.Finished = time.Now().UnixNano()
+		stage.Reason = reason
+	})
+
+	if err := api.Db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(RUNNING_BUCKET)).Bucket([]byte(pipelineName))
+		return b.Delete([]byte(id))
+	}); err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+	}
+	c.JSON(result.Code, result)
+}
+
+// getRunning : Load a running item from the database
+//
+// Returns nil if the item does not exist
+func (api *API) getRunning(pipelineName string, id string) (*RunningItem, error) {
+	var item *RunningItem
+	err := api.Db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(RUNNING_BUCKET))
+		if b == nil {
+			return nil
+		}
+		if b = b.Bucket([]byte(pipelineName)); b == nil {
+			return nil
+		}
+		value := b.Get([]byte(id))
+		if value == nil {
+			return nil
+		}
+		body, err := base64.StdEncoding.DecodeString(string(value))
+		if err != nil {
+			return err
+		}
+		item = &RunningItem{}
+		return json.Unmarshal(body, item)
+	})
+	return item, err
+}
+
+// putRunning : Store a running item in the database
+func (api *API) putRunning(pipelineName string, item *RunningItem) error {
+	return api.Db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(RUNNING_BUCKET))
+		if err != nil {
+			return err
+		}
+		if b, err = b.CreateBucketIfNotExists([]byte(pipelineName)); err != nil {
+			return err
+		}
+		body, _ := json.Marshal(item)
+		return b.Put([]byte(item.ID), []byte(base64.StdEncoding.EncodeToString(body)))
+	})
+}
+
+// setState : Update the state of a container against a queued key
+//
+// Event keys are tracked in the events bucket, all others in the files bucket
+func (api *API) setState(pipelineName string, key string, tag string, state string) error {
+	if strings.HasPrefix(key, EVENT_PREFIX+":") {
+		return api.setEventState(pipelineName, strings.TrimPrefix(key, EVENT_PREFIX+":"), tag, state)
+	}
+	return api.setFileState(pipelineName, key, tag, state)
+}
+
+// setFileState : Update the state of a container against a key in the files bucket
+func (api *API) setFileState(pipelineName string, key string, tag string, state string) error {
+	return api.Db.Update(func(tx *bolt.Tx) error {
+		var b *bolt.Bucket
+		if files := tx.Bucket([]byte("files")); files != nil {
+			b = files.Bucket([]byte(pipelineName))
+		}
+		if b == nil {
+			return fmt.Errorf("No such bucket files/%s", pipelineName)
+		}
+		log.Debug("Updating state in files/", pipelineName, " for container ", tag, " to ", state)
+		value := b.Get([]byte(key))
+		body, _ := base64.StdEncoding.DecodeString(string(value))
+		content := make(map[string]string)
+		json.Unmarshal(body, &content)
+		content[tag] = state
+		body, _ = json.Marshal(content)
+		if err := b.Put([]byte(key), []byte(base64.StdEncoding.EncodeToString(body))); err != nil {
+			return fmt.Errorf("create kv: %s", err)
+		}
+		return nil
+	})
+}
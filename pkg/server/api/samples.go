@@ -0,0 +1,464 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/pipeline"
+	log "github.com/sirupsen/logrus"
+)
+
+// STAGES_BUCKET : The bucket holding the progress of each file through each command
+const STAGES_BUCKET = "stages"
+
+// Stage states not covered by the running item states
+const (
+	STAGE_PENDING = "pending"
+	STAGE_QUEUED  = "queued"
+)
+
+// SampleStage : The progress of a file, or a whole sample, through a single command
+type SampleStage struct {
+
+	// One of pending|queued|in_progress|complete|failed|cancelled
+	Status string `json:"status"`
+
+	// The ID of the most recent queue item
+	ItemID string `json:"id,omitempty"`
+
+	// The number of times the stage has been attempted
+	Attempts int `json:"attempts"`
+
+	// When the stage was last queued in unix nano
+	Queued int64 `json:"queued,omitempty"`
+
+	// When the stage last started executing in unix nano
+	Started int64 `json:"started,omitempty"`
+
+	// When the stage last finished in unix nano
+	Finished int64 `json:"finished,omitempty"`
+
+	// Why the stage failed or was cancelled
+	Reason string `json:"reason,omitempty"`
+
+	// For samples, the number of files which have reached this stage
+	Files int `json:"files,omitempty"`
+}
+
+// Sample : A group of files sharing a sample name and their progress through the pipeline
+type Sample struct {
+
+	// The name of the sample taken from the sample pattern
+	Name string `json:"name"`
+
+	// The files bucket keys belonging to the sample
+	Files []string `json:"files"`
+
+	// The overall status of the sample
+	Status string `json:"status"`
+
+	// The fraction of commands the sample has completed
+	Progress float64 `json:"progress"`
+
+	// The progress of the sample through each command, keyed by command ID
+	Stages map[string]*SampleStage `json:"stages"`
+
+	// The progress of each file through each command.
+	// Only populated when a single sample is requested.
+	FileStages map[string]map[string]*SampleStage `json:"filestages,omitempty"`
+}
+
+// SampleStageName : A command shown as a column of the samples matrix
+type SampleStageName struct {
+
+	// The jointJS ID of the command
+	ID string `json:"id"`
+
+	// The name of the command
+	Name string `json:"name"`
+}
+
+// Samples : List every sample in a pipeline and its progress across each command
+//
+// Files are grouped into samples by the pipeline sample pattern.
+// Commands are listed in pipeline order to form the columns of a
+// samples by stages matrix.
+//
+// GET /samples/:pipeline
+//
+// Request parameters:
+// - pipeline : The name of the pipeline
+// - status   : [optional, query] Only list samples with this overall status
+//
+// Response codes:
+// - 200 OK Message will contain `pattern`, `commands` and `samples`
+// - 404 Not found if the pipeline does not exist
+// - 500 Internal server error
+func (api *API) Samples(c *gin.Context) {
+	result := Result{
+		Code:   200,
+		Result: "OK",
+	}
+
+	pipeline, err := pipeline.GetPipeline(api.Config, c.Params.ByName("pipeline"))
+	if err != nil {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "Error opening pipeline " + c.Params.ByName("pipeline") + " " + err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	samples, err := api.samples(pipeline, "")
+	if err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	var status string = c.Query("status")
+	list := make([]*Sample, 0)
+	for _, sample := range samples {
+		if status == "" || sample.Status == status {
+			sample.FileStages = nil
+			list = append(list, sample)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	result.Message = map[string]interface{}{
+		"pattern":  pipeline.GetSamplePattern(),
+		"commands": sampleColumns(pipeline),
+		"samples":  list,
+	}
+	c.JSON(result.Code, result)
+}
+
+// GetSample : Get the progress of a single sample, including each of its files
+//
+// GET /samples/:pipeline/:sample
+//
+// Request parameters:
+// - pipeline : The name of the pipeline
+// - sample   : The name of the sample
+//
+// Response codes:
+// - 200 OK Message will contain the Sample
+// - 404 Not found
+// - 500 Internal server error
+func (api *API) GetSample(c *gin.Context) {
+	result := Result{
+		Code:   200,
+		Result: "OK",
+	}
+
+	pipeline, err := pipeline.GetPipeline(api.Config, c.Params.ByName("pipeline"))
+	if err != nil {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "Error opening pipeline " + c.Params.ByName("pipeline") + " " + err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	var name string = c.Params.ByName("sample")
+	samples, err := api.samples(pipeline, name)
+	if err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	sample, ok := samples[name]
+	if !ok {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "No such sample " + name
+		c.JSON(result.Code, result)
+		return
+	}
+	result.Message = sample
+	c.JSON(result.Code, result)
+}
+
+// samples : Group the files of a pipeline into samples and calculate their progress
+//
+// If only is given, only that sample is built.
+func (api *API) samples(pipeline *pipeline.Pipeline, only string) (map[string]*Sample, error) {
+	samples := make(map[string]*Sample)
+
+	tags := make(map[string]string)
+	for id, command := range pipeline.Commands {
+		tags[id] = command.GetContainer(true)
+	}
+
+	err := api.Db.View(func(tx *bolt.Tx) error {
+		files := tx.Bucket([]byte("files"))
+		if files == nil {
+			return nil
+		}
+		if files = files.Bucket([]byte(pipeline.BucketName)); files == nil {
+			return nil
+		}
+
+		var stages *bolt.Bucket
+		if stages = tx.Bucket([]byte(STAGES_BUCKET)); stages != nil {
+			stages = stages.Bucket([]byte(pipeline.BucketName))
+		}
+
+		return files.ForEach(func(k, v []byte) error {
+			// keys are directory:filename
+			var key string = string(k)
+			index := strings.Index(key, ":")
+			if index == -1 {
+				return nil
+			}
+
+			var name string = pipeline.SampleName(key[index+1:])
+			if only != "" && name != only {
+				return nil
+			}
+
+			sample, ok := samples[name]
+			if !ok {
+				sample = &Sample{
+					Name:       name,
+					Files:      make([]string, 0),
+					Stages:     make(map[string]*SampleStage),
+					FileStages: make(map[string]map[string]*SampleStage),
+				}
+				samples[name] = sample
+			}
+			sample.Files = append(sample.Files, key)
+
+			// stage records hold timings and reasons. Files queued before
+			// stages were recorded fall back to the state in the files bucket
+			recorded := make(map[string]*SampleStage)
+			if stages != nil {
+				if value := stages.Get(k); value != nil {
+					body, _ := base64.StdEncoding.DecodeString(string(value))
+					if err := json.Unmarshal(body, &recorded); err != nil {
+						log.Error("Invalid stage record for ", key, " ", err)
+					}
+				}
+			}
+
+			state := make(map[string]string)
+			body, _ := base64.StdEncoding.DecodeString(string(v))
+			_ = json.Unmarshal(body, &state)
+
+			fileStages := make(map[string]*SampleStage)
+			for id, tag := range tags {
+				if stage, ok := recorded[id]; ok {
+					fileStages[id] = stage
+				} else if status, ok := state[tag]; ok {
+					// files waiting on an upstream command are marked ready
+					if status == "ready" {
+						status = STAGE_PENDING
+					}
+					fileStages[id] = &SampleStage{Status: status}
+				}
+			}
+			sample.FileStages[key] = fileStages
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sample := range samples {
+		sample.summarise(pipeline)
+	}
+	return samples, nil
+}
+
+// summarise : Combine the stages of each file into the stages of the sample
+//
+// A stage takes the least advanced state of any file which reached it,
+// with failures taking precedence so they are never hidden.
+func (sample *Sample) summarise(pipeline *pipeline.Pipeline) {
+	rank := map[string]int{
+		STATE_FAILED:     0,
+		STATE_CANCELLED:  1,
+		STATE_CANCELLING: 2,
+		STATE_RUNNING:    3,
+		STAGE_PENDING:    4,
+		STAGE_QUEUED:     5,
+		STATE_COMPLETE:   6,
+	}
+	ranked := func(status string) int {
+		if value, ok := rank[status]; ok {
+			return value
+		}
+		return len(rank)
+	}
+
+	for _, fileStages := range sample.FileStages {
+		for id, stage := range fileStages {
+			summary, ok := sample.Stages[id]
+			if !ok {
+				copied := *stage
+				copied.Files = 1
+				sample.Stages[id] = &copied
+				continue
+			}
+
+			summary.Files++
+			summary.Attempts += stage.Attempts
+			if stage.Queued != 0 && (summary.Queued == 0 || stage.Queued < summary.Queued) {
+				summary.Queued = stage.Queued
+			}
+			if stage.Started != 0 && (summary.Started == 0 || stage.Started < summary.Started) {
+				summary.Started = stage.Started
+			}
+			if stage.Finished > summary.Finished {
+				summary.Finished = stage.Finished
+			}
+			if ranked(stage.Status) < ranked(summary.Status) {
+				summary.Status = stage.Status
+				summary.ItemID = stage.ItemID
+				summary.Reason = stage.Reason
+			}
+		}
+	}
+
+	var complete int
+	sample.Status = STATE_COMPLETE
+	for id := range pipeline.Commands {
+		stage, ok := sample.Stages[id]
+		if !ok {
+			sample.Stages[id] = &SampleStage{Status: STAGE_PENDING}
+			continue
+		}
+		switch stage.Status {
+		case STATE_COMPLETE:
+			complete++
+		case STATE_FAILED, STATE_CANCELLED:
+			sample.Status = STATE_FAILED
+		}
+	}
+
+	if len(pipeline.Commands) > 0 {
+		sample.Progress = float64(complete) / float64(len(pipeline.Commands))
+	}
+	if sample.Status != STATE_FAILED && complete < len(pipeline.Commands) {
+		sample.Status = STATE_RUNNING
+	}
+	sort.Strings(sample.Files)
+}
+
+// updateStages : Apply a change to the stage record of each key for a command
+//
+// Event keys are not files and are not tracked.
+func (api *API) updateStages(pipelineName string, keys []string, commandID string, update func(*SampleStage)) {
+	if err := api.Db.Update(func(tx *bolt.Tx) error {
+		b, err := api.childBucket(tx, STAGES_BUCKET, pipelineName)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if strings.HasPrefix(key, EVENT_PREFIX+":") {
+				continue
+			}
+
+			stages := make(map[string]*SampleStage)
+			if value := b.Get([]byte(key)); value != nil {
+				body, _ := base64.StdEncoding.DecodeString(string(value))
+				if err := json.Unmarshal(body, &stages); err != nil {
+					log.Error("Replacing invalid stage record for ", key, " ", err)
+					stages = make(map[string]*SampleStage)
+				}
+			}
+
+			stage, ok := stages[commandID]
+			if !ok {
+				stage = &SampleStage{}
+				stages[commandID] = stage
+			}
+			update(stage)
+
+			body, _ := json.Marshal(stages)
+			if err := b.Put([]byte(key), []byte(base64.StdEncoding.EncodeToString(body))); err != nil {
+				return fmt.Errorf("create kv: %s", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		log.Error("Failed to update stages for ", commandID, " ", err)
+	}
+}
+
+// failureReason : Describe why a queue item did not complete
+//
+// Failures include the exit code and the last line written to stderr
+func (api *API) failureReason(pipelineName string, request *CompleteRequest) string {
+	switch request.Status {
+	case STATE_CANCELLED:
+		return "cancelled"
+	case STATE_FAILED:
+	default:
+		return ""
+	}
+
+	var reason string = fmt.Sprintf("exit code %d", request.ExitCode)
+	if _, chunks, err := api.readLog(pipelineName, request.ID, -1, "stderr"); err == nil && len(chunks) > 0 {
+		chunks = tailChunks(chunks, 1)
+		if line := strings.TrimSpace(chunks[0].Data); line != "" {
+			reason = reason + ": " + line
+		}
+	}
+	return reason
+}
+
+// sampleColumns : List the commands of a pipeline in the order data flows through them
+func sampleColumns(pipeline *pipeline.Pipeline) []SampleStageName {
+	var (
+		columns []SampleStageName = make([]SampleStageName, 0)
+		seen    map[string]bool   = make(map[string]bool)
+		pending []string          = pipeline.GetStartIds()
+	)
+	sort.Strings(pending)
+
+	for len(pending) > 0 {
+		var id string = pending[0]
+		pending = pending[1:]
+		command, ok := pipeline.Commands[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		columns = append(columns, SampleStageName{ID: id, Name: command.Name})
+		pending = append(pending, pipeline.GetNextID(command)...)
+	}
+
+	// anything unreachable from the start is appended by name
+	remaining := make([]SampleStageName, 0)
+	for id, command := range pipeline.Commands {
+		if !seen[id] {
+			remaining = append(remaining, SampleStageName{ID: id, Name: command.Name})
+		}
+	}
+	sort.Slice(remaining, func(i, j int) bool {
+		return remaining[i].Name < remaining[j].Name
+	})
+	return append(columns, remaining...)
+}
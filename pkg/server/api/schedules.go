@@ -0,0 +1,576 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/notapipeline/tiyo/pkg/pipeline"
+	"github.com/notapipeline/tiyo/pkg/tracing"
+	"github.com/robfig/cron/v3"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SCHEDULES_BUCKET : The bucket holding schedule definitions for each pipeline
+const SCHEDULES_BUCKET = "schedules"
+
+// SCHEDULER_TICK : How often, in seconds, the scheduler checks for due schedules
+const SCHEDULER_TICK = 30
+
+// MAXCATCHUP : The maximum number of missed runs a schedule will catch up on
+const MAXCATCHUP = 100
+
+// Catch-up policies for runs missed whilst assemble was not running
+const (
+	// Run every missed occurrence (up to MAXCATCHUP)
+	CATCHUP_ALL = "all"
+
+	// Run once regardless of how many occurrences were missed
+	CATCHUP_ONCE = "once"
+
+	// Skip missed occurrences and wait for the next one
+	CATCHUP_NONE = "none"
+)
+
+// Schedule : A cron or interval trigger for a pipeline
+type Schedule struct {
+
+	// The unique ID of the schedule
+	ID string `json:"id"`
+
+	// A human readable name for the schedule
+	Name string `json:"name"`
+
+	// The pipeline the schedule triggers
+	Pipeline string `json:"pipeline"`
+
+	// A standard 5 field cron expression. Mutually exclusive with Interval
+	Cron string `json:"cron"`
+
+	// The time zone the cron expression is evaluated in (default UTC)
+	Timezone string `json:"timezone"`
+
+	// A duration between runs, for example "6h". Mutually exclusive with Cron
+	Interval string `json:"interval"`
+
+	// What to trigger - one of submit|event
+	Action string `json:"action"`
+
+	// For submit actions, the optional command to start at
+	Command string `json:"command"`
+
+	// For submit actions, the files or directories to submit
+	Files []string `json:"files"`
+
+	// For event actions, the optional source element to route the event by
+	Source string `json:"source"`
+
+	// For event actions, the payload to send
+	Payload json.RawMessage `json:"payload,omitempty"`
+
+	// How to handle runs missed whilst the scheduler was not running
+	CatchUp string `json:"catchup"`
+
+	// Is the schedule paused
+	Paused bool `json:"paused"`
+
+	// The time the schedule last ran in unix nano
+	LastRun int64 `json:"lastrun"`
+
+	// The time the schedule is next due in unix nano
+	NextRun int64 `json:"nextrun"`
+
+	// The number of runs skipped under the catch-up policy
+	Missed int `json:"missed"`
+
+	// The submission or event ID of the last run, or the error it produced
+	LastResult string `json:"lastresult"`
+}
+
+// next : Get the next time the schedule is due after a given time
+func (schedule *Schedule) next(after time.Time) (time.Time, error) {
+	if schedule.Interval != "" {
+		duration, err := time.ParseDuration(schedule.Interval)
+		if err != nil {
+			return after, err
+		}
+		if duration < time.Minute {
+			return after, fmt.Errorf("Interval must be at least one minute")
+		}
+		return after.Add(duration), nil
+	}
+
+	var timezone string = schedule.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return after, err
+	}
+	expression, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		return after, err
+	}
+	return expression.Next(after.In(location)), nil
+}
+
+// validate : Check a schedule definition is complete and can be evaluated
+func (schedule *Schedule) validate() error {
+	if (schedule.Cron == "") == (schedule.Interval == "") {
+		return fmt.Errorf("Exactly one of cron or interval must be set")
+	}
+
+	if _, err := schedule.next(time.Now()); err != nil {
+		return err
+	}
+
+	switch schedule.Action {
+	case "submit":
+		if len(schedule.Files) == 0 {
+			return fmt.Errorf("Submit schedules require a list of files")
+		}
+	case "event":
+		if len(schedule.Payload) == 0 {
+			schedule.Payload = json.RawMessage("{}")
+		}
+		if !json.Valid(schedule.Payload) {
+			return fmt.Errorf("Event payload must be valid JSON")
+		}
+	default:
+		return fmt.Errorf("Invalid action '%s' - must be one of submit|event", schedule.Action)
+	}
+
+	switch schedule.CatchUp {
+	case "":
+		schedule.CatchUp = CATCHUP_ONCE
+	case CATCHUP_ALL, CATCHUP_ONCE, CATCHUP_NONE:
+	default:
+		return fmt.Errorf("Invalid catchup '%s' - must be one of all|once|none", schedule.CatchUp)
+	}
+	return nil
+}
+
+// Schedules : List the schedules defined for a pipeline
+//
+// GET /schedules/:pipeline
+//
+// Request parameters:
+// - pipeline : The name of the pipeline
+//
+// Response codes:
+// - 200 OK Message will contain a list of schedules
+// - 500 Internal server error
+func (api *API) Schedules(c *gin.Context) {
+	result := Result{
+		Code:   200,
+		Result: "OK",
+	}
+
+	schedules, err := api.getSchedules(pipeline.Sanitize(c.Params.ByName("pipeline"), "_"))
+	if err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+	result.Message = schedules
+	c.JSON(result.Code, result)
+}
+
+// SaveSchedule : Create or update a schedule for a pipeline
+//
+// POST /schedules/:pipeline
+//
+// Request parameters:
+// - pipeline : The name of the pipeline
+// - body     : The Schedule definition. If id is set, the existing schedule is replaced
+//
+// Response codes:
+// - 201 Created Message will contain the schedule
+// - 400 Bad request if the schedule is invalid
+// - 404 Not found if the pipeline does not exist
+// - 500 Internal server error
+func (api *API) SaveSchedule(c *gin.Context) {
+	result := Result{
+		Code:   201,
+		Result: "OK",
+	}
+
+	schedule := Schedule{}
+	if err := c.ShouldBindJSON(&schedule); err != nil {
+		result.Code = 400
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	pipeline, err := pipeline.GetPipeline(api.Config, c.Params.ByName("pipeline"))
+	if err != nil {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "Error opening pipeline " + c.Params.ByName("pipeline") + " " + err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+	schedule.Pipeline = pipeline.Name
+
+	if err := schedule.validate(); err != nil {
+		result.Code = 400
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	if schedule.ID == "" {
+		schedule.ID = uuid.New().String()
+	} else if existing, _ := api.getSchedule(pipeline.BucketName, schedule.ID); existing != nil {
+		schedule.LastRun = existing.LastRun
+		schedule.Missed = existing.Missed
+		schedule.LastResult = existing.LastResult
+	}
+
+	next, _ := schedule.next(time.Now())
+	schedule.NextRun = next.UnixNano()
+	if err := api.putSchedule(pipeline.BucketName, &schedule); err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+	result.Message = schedule
+	c.JSON(result.Code, result)
+}
+
+// DeleteSchedule : Delete a schedule
+//
+// DELETE /schedules/:pipeline/:id
+//
+// Response codes:
+// - 202 Accepted
+// - 500 Internal server error
+func (api *API) DeleteSchedule(c *gin.Context) {
+	result := Result{
+		Code:    202,
+		Result:  "OK",
+		Message: "",
+	}
+
+	var pipelineName string = pipeline.Sanitize(c.Params.ByName("pipeline"), "_")
+	if err := api.Db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(SCHEDULES_BUCKET))
+		if b == nil {
+			return nil
+		}
+		if b = b.Bucket([]byte(pipelineName)); b == nil {
+			return nil
+		}
+		return b.Delete([]byte(c.Params.ByName("id")))
+	}); err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+	}
+	c.JSON(result.Code, result)
+}
+
+// PauseSchedule : Stop a schedule from triggering
+//
+// POST /schedules/:pipeline/:id/pause
+//
+// Response codes:
+// - 200 OK Message will contain the schedule
+// - 404 Not found
+// - 500 Internal server error
+func (api *API) PauseSchedule(c *gin.Context) {
+	api.updateSchedule(c, func(schedule *Schedule) {
+		schedule.Paused = true
+	})
+}
+
+// ResumeSchedule : Allow a paused schedule to trigger again
+//
+// Runs missed whilst the schedule was paused are not caught up on.
+//
+// POST /schedules/:pipeline/:id/resume
+//
+// Response codes:
+// - 200 OK Message will contain the schedule
+// - 404 Not found
+// - 500 Internal server error
+func (api *API) ResumeSchedule(c *gin.Context) {
+	api.updateSchedule(c, func(schedule *Schedule) {
+		schedule.Paused = false
+		next, _ := schedule.next(time.Now())
+		schedule.NextRun = next.UnixNano()
+	})
+}
+
+// TriggerSchedule : Run a schedule immediately
+//
+// The schedule runs even if paused and its next due time is unchanged.
+//
+// POST /schedules/:pipeline/:id/trigger
+//
+// Response codes:
+// - 200 OK Message will contain the schedule
+// - 404 Not found
+// - 500 Internal server error
+func (api *API) TriggerSchedule(c *gin.Context) {
+	api.updateSchedule(c, func(schedule *Schedule) {
+		api.runSchedule(schedule)
+	})
+}
+
+// updateSchedule : Load the schedule in the current context, apply a change and store it
+func (api *API) updateSchedule(c *gin.Context, change func(*Schedule)) {
+	result := Result{
+		Code:   200,
+		Result: "OK",
+	}
+
+	var (
+		pipelineName string = pipeline.Sanitize(c.Params.ByName("pipeline"), "_")
+		id           string = c.Params.ByName("id")
+	)
+	schedule, err := api.getSchedule(pipelineName, id)
+	if err != nil || schedule == nil {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "No such schedule " + id
+		c.JSON(result.Code, result)
+		return
+	}
+
+	change(schedule)
+	if err := api.putSchedule(pipelineName, schedule); err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+	result.Message = schedule
+	c.JSON(result.Code, result)
+}
+
+// Scheduler : Run the in-process scheduler
+//
+// Every SCHEDULER_TICK seconds, all schedules are checked and any which are
+// due are triggered. If occurrences were missed, for example whilst assemble
+// was stopped, the schedule's catch-up policy decides how many are run and
+// the remainder are recorded as missed.
+func (api *API) Scheduler() {
+	log.Info("Starting pipeline scheduler")
+	for {
+		api.runDueSchedules(time.Now())
+		time.Sleep(SCHEDULER_TICK * time.Second)
+	}
+}
+
+// runDueSchedules : Trigger every schedule due at or before now
+func (api *API) runDueSchedules(now time.Time) {
+	due := make(map[string][]Schedule)
+	if err := api.Db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(SCHEDULES_BUCKET))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(name, v []byte) error {
+			child := b.Bucket(name)
+			if v != nil || child == nil {
+				return nil
+			}
+			return child.ForEach(func(k, v []byte) error {
+				schedule := Schedule{}
+				body, _ := base64.StdEncoding.DecodeString(string(v))
+				if err := json.Unmarshal(body, &schedule); err != nil {
+					log.Error("Invalid schedule ", string(k), " ", err)
+					return nil
+				}
+				if !schedule.Paused && schedule.NextRun <= now.UnixNano() {
+					due[string(name)] = append(due[string(name)], schedule)
+				}
+				return nil
+			})
+		})
+	}); err != nil {
+		log.Error(err)
+		return
+	}
+
+	for pipelineName, schedules := range due {
+		for _, schedule := range schedules {
+			var (
+				occurrences int = 0
+				next        time.Time
+				err         error
+			)
+
+			// count the occurrences between the due time and now
+			next = time.Unix(0, schedule.NextRun)
+			for !next.After(now) && occurrences < MAXCATCHUP {
+				occurrences++
+				if next, err = schedule.next(next); err != nil {
+					break
+				}
+			}
+			if err != nil {
+				log.Error("Cannot evaluate schedule ", schedule.ID, " ", err)
+				continue
+			}
+			if next.Before(now) {
+				next, _ = schedule.next(now)
+			}
+
+			var runs int = 1
+			switch schedule.CatchUp {
+			case CATCHUP_ALL:
+				runs = occurrences
+			case CATCHUP_NONE:
+				// only run if the most recent occurrence is within this tick
+				if occurrences > 1 || now.Sub(time.Unix(0, schedule.NextRun)) > 2*SCHEDULER_TICK*time.Second {
+					runs = 0
+				}
+			}
+
+			if occurrences > runs {
+				log.Warn("Schedule ", schedule.ID, " for ", schedule.Pipeline, " missed ", occurrences-runs, " runs")
+			}
+			api.recordScheduleRuns(pipelineName, schedule.ID, runs, occurrences-runs, next)
+		}
+	}
+}
+
+// recordScheduleRuns : Run a schedule a number of times and store the outcome
+//
+// The schedule is reloaded so changes made whilst runs were being
+// calculated, such as pausing, are not overwritten.
+func (api *API) recordScheduleRuns(pipelineName string, id string, runs int, missed int, next time.Time) {
+	schedule, err := api.getSchedule(pipelineName, id)
+	if err != nil || schedule == nil {
+		return
+	}
+
+	for i := 0; i < runs && !schedule.Paused; i++ {
+		api.runSchedule(schedule)
+	}
+	schedule.Missed += missed
+	schedule.NextRun = next.UnixNano()
+	if err := api.putSchedule(pipelineName, schedule); err != nil {
+		log.Error("Failed to store schedule ", id, " ", err)
+	}
+}
+
+// runSchedule : Submit the run or event defined by a schedule
+func (api *API) runSchedule(schedule *Schedule) {
+	log.Info("Triggering schedule ", schedule.ID, " (", schedule.Name, ") for ", schedule.Pipeline)
+	schedule.LastRun = time.Now().UnixNano()
+
+	ctx, span := tracing.Start(context.Background(), "schedule "+schedule.Name,
+		trace.WithAttributes(attribute.String("tiyo.pipeline", schedule.Pipeline)))
+	defer span.End()
+	switch schedule.Action {
+	case "submit":
+		submission, _, err := api.submit(&SubmitRequest{
+			Pipeline: schedule.Pipeline,
+			Command:  schedule.Command,
+			Files:    schedule.Files,
+		})
+		if err != nil {
+			log.Error("Schedule ", schedule.ID, " failed ", err)
+			schedule.LastResult = err.Error()
+			return
+		}
+		schedule.LastResult = submission.ID
+	case "event":
+		event, _, err := api.queueEvent(ctx, schedule.Pipeline, schedule.Source, schedule.Payload)
+		if err != nil {
+			log.Error("Schedule ", schedule.ID, " failed ", err)
+			schedule.LastResult = err.Error()
+			return
+		}
+		schedule.LastResult = event.ID
+	}
+}
+
+// getSchedules : Load all schedules for a pipeline
+func (api *API) getSchedules(pipelineName string) ([]Schedule, error) {
+	schedules := make([]Schedule, 0)
+	err := api.Db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(SCHEDULES_BUCKET))
+		if b == nil {
+			return nil
+		}
+		if b = b.Bucket([]byte(pipelineName)); b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			schedule := Schedule{}
+			body, _ := base64.StdEncoding.DecodeString(string(v))
+			if err := json.Unmarshal(body, &schedule); err != nil {
+				log.Error("Invalid schedule ", string(k), " ", err)
+				return nil
+			}
+			schedules = append(schedules, schedule)
+			return nil
+		})
+	})
+	return schedules, err
+}
+
+// getSchedule : Load a single schedule
+//
+// Returns nil if the schedule does not exist
+func (api *API) getSchedule(pipelineName string, id string) (*Schedule, error) {
+	var schedule *Schedule
+	err := api.Db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(SCHEDULES_BUCKET))
+		if b == nil {
+			return nil
+		}
+		if b = b.Bucket([]byte(pipelineName)); b == nil {
+			return nil
+		}
+		value := b.Get([]byte(id))
+		if value == nil {
+			return nil
+		}
+		body, err := base64.StdEncoding.DecodeString(string(value))
+		if err != nil {
+			return err
+		}
+		schedule = &Schedule{}
+		return json.Unmarshal(body, schedule)
+	})
+	return schedule, err
+}
+
+// putSchedule : Store a schedule
+func (api *API) putSchedule(pipelineName string, schedule *Schedule) error {
+	if err := api.ensureBuckets(pipelineName, SCHEDULES_BUCKET); err != nil {
+		return err
+	}
+	return api.Db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(SCHEDULES_BUCKET)).Bucket([]byte(pipelineName))
+		body, _ := json.Marshal(schedule)
+		return b.Put([]byte(schedule.ID), []byte(base64.StdEncoding.EncodeToString(body)))
+	})
+}
@@ -0,0 +1,398 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/pipeline"
+	log "github.com/sirupsen/logrus"
+)
+
+// STATS_BUCKET : The bucket holding resource usage statistics per command
+const STATS_BUCKET = "stats"
+
+// STATS_WINDOW : The number of recent executions used to make recommendations
+const STATS_WINDOW = 100
+
+// STATS_HOURS : The number of hours of execution counts kept for each command
+const STATS_HOURS = 168
+
+// STATS_HEADROOM : The multiplier applied to observed usage when recommending requests
+const STATS_HEADROOM = 1.2
+
+// STATS_PERCENTILE : The percentile of recent executions used when recommending requests
+const STATS_PERCENTILE = 0.95
+
+// Minimum recommended requests
+const (
+	STATS_MIN_CPU    = 10
+	STATS_MIN_MEMORY = 32
+)
+
+// CommandStats : Resource usage statistics collected for a command
+type CommandStats struct {
+
+	// The jointJS ID of the command
+	CommandID string `json:"command"`
+
+	// The name of the command
+	Name string `json:"name"`
+
+	// The number of executions which completed
+	Runs int `json:"runs"`
+
+	// The number of executions which failed
+	Failures int `json:"failures"`
+
+	// The number of executions which were cancelled
+	Cancelled int `json:"cancelled"`
+
+	// Total wall clock time across all executions in nanoseconds
+	TotalDuration int64 `json:"totalduration"`
+
+	// The longest execution in nanoseconds
+	MaxDuration int64 `json:"maxduration"`
+
+	// Total user CPU time across all executions in seconds
+	TotalUserCPU float64 `json:"totalusercpu"`
+
+	// Total system CPU time across all executions in seconds
+	TotalSystemCPU float64 `json:"totalsystemcpu"`
+
+	// The highest memory usage seen in bytes
+	MaxRSS int64 `json:"maxrss"`
+
+	// The highest CPU usage seen in cores
+	PeakCPU float64 `json:"peakcpu"`
+
+	// Total bytes read from block devices
+	ReadBytes int64 `json:"readbytes"`
+
+	// Total bytes written to block devices
+	WriteBytes int64 `json:"writebytes"`
+
+	// The most recent STATS_WINDOW executions
+	Recent []StatsSample `json:"recent"`
+
+	// Execution counts for each of the last STATS_HOURS hours, oldest first
+	Hourly []HourlyStats `json:"hourly"`
+
+	// The CPU currently requested by the command
+	RequestedCPU string `json:"requestedcpu"`
+
+	// The memory currently requested by the command
+	RequestedMemory string `json:"requestedmemory"`
+
+	// The CPU request recommended from recent executions
+	RecommendedCPU string `json:"recommendedcpu"`
+
+	// The memory request recommended from recent executions
+	RecommendedMemory string `json:"recommendedmemory"`
+}
+
+// StatsSample : A summary of a single execution kept for recommendations
+type StatsSample struct {
+
+	// The queue item ID
+	ID string `json:"id"`
+
+	// When the execution finished in unix nano
+	Time int64 `json:"time"`
+
+	// The final status of the execution
+	Status string `json:"status"`
+
+	// The exit code of the command
+	ExitCode int `json:"exitcode"`
+
+	// Wall clock duration in nanoseconds
+	Duration int64 `json:"duration"`
+
+	// Time spent waiting on the queue in nanoseconds, 0 if unknown
+	Wait int64 `json:"wait"`
+
+	// Peak memory in bytes
+	RSS int64 `json:"rss"`
+
+	// Peak CPU in cores
+	CPU float64 `json:"cpu"`
+}
+
+// HourlyStats : Execution counts for a command within a single hour
+type HourlyStats struct {
+
+	// The start of the hour in unix seconds
+	Hour int64 `json:"hour"`
+
+	// The number of executions which completed
+	Completed int `json:"completed"`
+
+	// The number of executions which failed
+	Failed int `json:"failed"`
+
+	// The number of executions which were cancelled
+	Cancelled int `json:"cancelled"`
+}
+
+// Stats : Get resource usage statistics for the commands in a pipeline
+//
+// GET /stats/:pipeline[/:command]
+//
+// Request parameters:
+// - pipeline : The name of the pipeline
+// - command  : [optional] The ID or name of a single command
+//
+// Response codes:
+// - 200 OK Message will contain a list of CommandStats or a single CommandStats
+// - 404 Not found
+// - 500 Internal server error
+func (api *API) Stats(c *gin.Context) {
+	result := Result{
+		Code:   200,
+		Result: "OK",
+	}
+
+	var (
+		pipelineName string = c.Params.ByName("pipeline")
+		commandName  string = c.Params.ByName("command")
+		stats        []CommandStats
+	)
+
+	pipeline, err := pipeline.GetPipeline(api.Config, pipelineName)
+	if err != nil {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "Error opening pipeline " + pipelineName + " " + err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	if stats, err = api.commandStats(pipeline); err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	for index := range stats {
+		stats[index].recommend()
+	}
+
+	if commandName == "" {
+		sort.Slice(stats, func(i, j int) bool {
+			return stats[i].Name < stats[j].Name
+		})
+		result.Message = stats
+		c.JSON(result.Code, result)
+		return
+	}
+
+	for _, item := range stats {
+		if item.CommandID == commandName || item.Name == commandName {
+			result.Message = item
+			c.JSON(result.Code, result)
+			return
+		}
+	}
+	result.Code = 404
+	result.Result = "Error"
+	result.Message = "No statistics for " + commandName
+	c.JSON(result.Code, result)
+}
+
+// commandStats : Load the statistics of every command in a pipeline
+//
+// Names and requests are taken from the current pipeline definition
+func (api *API) commandStats(pipeline *pipeline.Pipeline) ([]CommandStats, error) {
+	stats := make([]CommandStats, 0)
+	err := api.Db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(STATS_BUCKET))
+		if b == nil {
+			return nil
+		}
+		if b = b.Bucket([]byte(pipeline.BucketName)); b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			item := CommandStats{}
+			body, _ := base64.StdEncoding.DecodeString(string(v))
+			if err := json.Unmarshal(body, &item); err != nil {
+				log.Error("Invalid stats for ", string(k), " ", err)
+				return nil
+			}
+			if command := pipeline.GetCommand(item.CommandID); command != nil {
+				item.Name = command.Name
+				item.RequestedCPU = command.CPU
+				item.RequestedMemory = command.Memory
+			}
+			stats = append(stats, item)
+			return nil
+		})
+	})
+	return stats, err
+}
+
+// recordUsage : Add the result of an execution to the command statistics and item log
+func (api *API) recordUsage(pipelineName string, item *RunningItem, request *CompleteRequest) error {
+	return api.Db.Update(func(tx *bolt.Tx) error {
+		var now int64 = time.Now().UnixNano()
+		if b := tx.Bucket([]byte(LOGS_BUCKET)); b != nil {
+			if b = b.Bucket([]byte(pipelineName)); b != nil {
+				if b = b.Bucket([]byte(item.ID)); b != nil {
+					if itemLog := getItemLog(b); itemLog != nil {
+						itemLog.Status = request.Status
+						itemLog.ExitCode = request.ExitCode
+						itemLog.Finished = true
+						itemLog.Usage = request.Usage
+						if err := putItemLog(b, itemLog); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+
+		b, err := api.childBucket(tx, STATS_BUCKET, pipelineName)
+		if err != nil {
+			return err
+		}
+
+		stats := CommandStats{
+			CommandID: item.CommandID,
+			Recent:    make([]StatsSample, 0),
+		}
+		if value := b.Get([]byte(item.CommandID)); value != nil {
+			body, _ := base64.StdEncoding.DecodeString(string(value))
+			if err := json.Unmarshal(body, &stats); err != nil {
+				return err
+			}
+		}
+
+		sample := StatsSample{
+			ID:       item.ID,
+			Time:     now,
+			Status:   request.Status,
+			ExitCode: request.ExitCode,
+			Duration: now - item.Started,
+		}
+		if item.Queued > 0 && item.Started > item.Queued {
+			sample.Wait = item.Started - item.Queued
+		}
+
+		var hour int64 = time.Unix(0, now).Truncate(time.Hour).Unix()
+		if len(stats.Hourly) == 0 || stats.Hourly[len(stats.Hourly)-1].Hour != hour {
+			stats.Hourly = append(stats.Hourly, HourlyStats{Hour: hour})
+		}
+		current := &stats.Hourly[len(stats.Hourly)-1]
+
+		switch request.Status {
+		case STATE_COMPLETE:
+			stats.Runs++
+			current.Completed++
+		case STATE_FAILED:
+			stats.Failures++
+			current.Failed++
+		case STATE_CANCELLED:
+			stats.Cancelled++
+			current.Cancelled++
+		}
+
+		for len(stats.Hourly) > 0 && stats.Hourly[0].Hour <= hour-STATS_HOURS*3600 {
+			stats.Hourly = stats.Hourly[1:]
+		}
+
+		if usage := request.Usage; usage != nil {
+			sample.Duration = usage.Duration
+			sample.RSS = usage.PeakRSS
+			sample.CPU = usage.PeakCPU
+			// commands too short to be sampled only have their average CPU
+			if sample.CPU == 0 && usage.Duration > 0 {
+				sample.CPU = (usage.UserCPU + usage.SystemCPU) / time.Duration(usage.Duration).Seconds()
+			}
+
+			stats.TotalUserCPU += usage.UserCPU
+			stats.TotalSystemCPU += usage.SystemCPU
+			stats.ReadBytes += usage.ReadBytes
+			stats.WriteBytes += usage.WriteBytes
+			if sample.RSS > stats.MaxRSS {
+				stats.MaxRSS = sample.RSS
+			}
+			if sample.CPU > stats.PeakCPU {
+				stats.PeakCPU = sample.CPU
+			}
+		}
+
+		stats.TotalDuration += sample.Duration
+		if sample.Duration > stats.MaxDuration {
+			stats.MaxDuration = sample.Duration
+		}
+
+		// cancelled runs say nothing about what the command needs
+		if request.Status != STATE_CANCELLED {
+			stats.Recent = append(stats.Recent, sample)
+			if len(stats.Recent) > STATS_WINDOW {
+				stats.Recent = stats.Recent[len(stats.Recent)-STATS_WINDOW:]
+			}
+		}
+
+		body, _ := json.Marshal(stats)
+		return b.Put([]byte(item.CommandID), []byte(base64.StdEncoding.EncodeToString(body)))
+	})
+}
+
+// recommend : Calculate CPU and memory requests from recent executions
+//
+// Uses the STATS_PERCENTILE of peak usage plus STATS_HEADROOM. Executions
+// which reported no usage are ignored.
+func (stats *CommandStats) recommend() {
+	memory := make([]float64, 0)
+	cpu := make([]float64, 0)
+	for _, sample := range stats.Recent {
+		if sample.RSS > 0 {
+			memory = append(memory, float64(sample.RSS))
+		}
+		if sample.CPU > 0 {
+			cpu = append(cpu, sample.CPU)
+		}
+	}
+
+	if len(memory) > 0 {
+		var mebibytes int = int(math.Ceil(percentile(memory, STATS_PERCENTILE) * STATS_HEADROOM / (1024 * 1024)))
+		if mebibytes < STATS_MIN_MEMORY {
+			mebibytes = STATS_MIN_MEMORY
+		}
+		stats.RecommendedMemory = fmt.Sprintf("%dMi", mebibytes)
+	}
+
+	if len(cpu) > 0 {
+		var millicores int = int(math.Ceil(percentile(cpu, STATS_PERCENTILE) * STATS_HEADROOM * 1000))
+		if millicores < STATS_MIN_CPU {
+			millicores = STATS_MIN_CPU
+		}
+		stats.RecommendedCPU = fmt.Sprintf("%dm", millicores)
+	}
+}
+
+// percentile : Get the nearest rank percentile of a list of values
+func percentile(values []float64, p float64) float64 {
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	var rank int = int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
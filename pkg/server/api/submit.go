@@ -0,0 +1,338 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/notapipeline/tiyo/pkg/pipeline"
+	log "github.com/sirupsen/logrus"
+)
+
+// SUBMISSIONS_BUCKET : The bucket holding ad-hoc submissions made against a pipeline
+const SUBMISSIONS_BUCKET = "submissions"
+
+// SubmitRequest : An ad-hoc request to push a set of inputs through a pipeline
+type SubmitRequest struct {
+
+	// The name of the pipeline to submit against
+	Pipeline string `json:"pipeline"`
+
+	// [optional] The ID or name of the command to start at.
+	// If empty, the start of the pipeline is used.
+	Command string `json:"command"`
+
+	// A list of files or directories relative to the pipeline directory
+	Files []string `json:"files"`
+}
+
+// Submission : A record of an ad-hoc submission
+type Submission struct {
+
+	// The unique ID of the submission
+	ID string `json:"id"`
+
+	// The pipeline the submission was made against
+	Pipeline string `json:"pipeline"`
+
+	// The IDs of the commands the inputs were queued for
+	Commands []string `json:"commands"`
+
+	// The files bucket keys registered by the submission
+	Keys []string `json:"keys"`
+
+	// The time the submission was made in unix nano
+	Submitted int64 `json:"submitted"`
+
+	// The state of each key against each container tag.
+	// Only populated when the submission is retrieved.
+	State map[string]map[string]string `json:"state,omitempty"`
+}
+
+// Submit : Push an explicit list of inputs through a pipeline
+//
+// Each file is registered in the files bucket as ready and immediately
+// queued against the start command(s).
+//
+// POST /submit
+//
+// Request parameters:
+// - pipeline : The name of the pipeline to submit against
+// - command  : [optional] The ID or name of the command to start at
+// - files    : A list of files or directories relative to the pipeline directory
+//
+// Response codes:
+// - 201 Created Message will contain the submission
+// - 400 Bad request
+// - 404 Not found if the pipeline or command do not exist
+// - 500 Internal server error
+func (api *API) Submit(c *gin.Context) {
+	result := Result{
+		Code:   201,
+		Result: "OK",
+	}
+
+	request := SubmitRequest{}
+	if err := c.ShouldBind(&request); err != nil {
+		result.Code = 400
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	submission, code, err := api.submit(&request)
+	if err != nil {
+		result.Code = code
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+	result.Message = submission
+	c.JSON(result.Code, result)
+}
+
+// submit : Register and queue the inputs of a submission request
+//
+// Returns the submission, or a HTTP status code and error on failure
+func (api *API) submit(request *SubmitRequest) (*Submission, int, error) {
+	if request.Pipeline == "" || len(request.Files) == 0 {
+		return nil, 400, fmt.Errorf("pipeline and files are required")
+	}
+
+	commands := make([]*pipeline.Command, 0)
+	pipeline, err := pipeline.GetPipeline(api.Config, request.Pipeline)
+	if err != nil {
+		return nil, 404, fmt.Errorf("Error opening pipeline %s %s", request.Pipeline, err)
+	}
+
+	if request.Command == "" {
+		commands = pipeline.GetStart()
+	} else {
+		for id, command := range pipeline.Commands {
+			if id == request.Command || command.Name == request.Command {
+				commands = append(commands, command)
+				break
+			}
+		}
+	}
+
+	if len(commands) == 0 {
+		return nil, 404, fmt.Errorf("No command %s in pipeline %s", request.Command, request.Pipeline)
+	}
+
+	keys, err := api.submissionKeys(pipeline, request.Files)
+	if err != nil {
+		return nil, 400, err
+	}
+
+	if err := api.ensureBuckets(pipeline.BucketName, "files", "queue", SUBMISSIONS_BUCKET); err != nil {
+		return nil, 500, err
+	}
+
+	submission := Submission{
+		ID:        uuid.New().String(),
+		Pipeline:  pipeline.Name,
+		Commands:  make([]string, 0),
+		Keys:      keys,
+		Submitted: time.Now().UnixNano(),
+	}
+
+	for _, command := range commands {
+		if pipeline.GetParent(command) == nil {
+			log.Warn("Not submitting to ", command.Name, " - command is not in a container")
+			continue
+		}
+
+		available := make(map[string]map[string]string)
+		for _, key := range keys {
+			available[key] = map[string]string{"status": "ready"}
+		}
+		if err := api.Db.View(func(tx *bolt.Tx) error {
+			b := tx.Bucket([]byte("files")).Bucket([]byte(pipeline.BucketName))
+			for _, key := range keys {
+				if value := b.Get([]byte(key)); value != nil {
+					content := make(map[string]string)
+					body, _ := base64.StdEncoding.DecodeString(string(value))
+					_ = json.Unmarshal(body, &content)
+					content["status"] = "ready"
+					available[key] = content
+				}
+			}
+			return nil
+		}); err != nil {
+			log.Error(err)
+		}
+
+		var count int = len(available)
+		api.enqueue(pipeline, command, available, &count)
+		submission.Commands = append(submission.Commands, command.ID)
+	}
+
+	if err := api.Db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(SUBMISSIONS_BUCKET)).Bucket([]byte(pipeline.BucketName))
+		body, _ := json.Marshal(submission)
+		return b.Put([]byte(submission.ID), []byte(base64.StdEncoding.EncodeToString(body)))
+	}); err != nil {
+		return nil, 500, err
+	}
+
+	log.Info("Submitted ", len(keys), " inputs to ", pipeline.Name, " as ", submission.ID)
+	return &submission, 201, nil
+}
+
+// GetSubmission : Get a submission and the current state of its inputs
+//
+// GET /submission/:pipeline/:id
+//
+// Request parameters:
+// - pipeline : The name of the pipeline the submission was made against
+// - id       : The submission ID
+//
+// Response codes:
+// - 200 OK Message will contain the submission
+// - 404 Not found
+// - 500 Internal server error
+func (api *API) GetSubmission(c *gin.Context) {
+	result := Result{
+		Code:   200,
+		Result: "OK",
+	}
+
+	var (
+		pipelineName string = pipeline.Sanitize(c.Params.ByName("pipeline"), "_")
+		id           string = c.Params.ByName("id")
+		submission   *Submission
+	)
+
+	if err := api.Db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(SUBMISSIONS_BUCKET))
+		if b == nil {
+			return nil
+		}
+		if b = b.Bucket([]byte(pipelineName)); b == nil {
+			return nil
+		}
+		value := b.Get([]byte(id))
+		if value == nil {
+			return nil
+		}
+		body, err := base64.StdEncoding.DecodeString(string(value))
+		if err != nil {
+			return err
+		}
+		submission = &Submission{}
+		if err := json.Unmarshal(body, submission); err != nil {
+			return err
+		}
+
+		submission.State = make(map[string]map[string]string)
+		files := tx.Bucket([]byte("files")).Bucket([]byte(pipelineName))
+		for _, key := range submission.Keys {
+			state := make(map[string]string)
+			if value := files.Get([]byte(key)); value != nil {
+				body, _ := base64.StdEncoding.DecodeString(string(value))
+				_ = json.Unmarshal(body, &state)
+			}
+			submission.State[key] = state
+		}
+		return nil
+	}); err != nil {
+		result.Code = 500
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+
+	if submission == nil {
+		result.Code = 404
+		result.Result = "Error"
+		result.Message = "No such submission " + id
+		c.JSON(result.Code, result)
+		return
+	}
+	result.Message = submission
+	c.JSON(result.Code, result)
+}
+
+// submissionKeys : Convert a list of submitted paths into files bucket keys
+//
+// Paths are relative to the pipeline directory and must exist. Directories
+// are expanded to every file beneath them. Keys follow the same format as
+// fill, namely `directory:filename` or `root:filename` for files at the
+// pipeline root.
+func (api *API) submissionKeys(pipeline *pipeline.Pipeline, paths []string) ([]string, error) {
+	var root string = filepath.Join(
+		api.Config.SequenceBaseDir, api.Config.Kubernetes.Volume, pipeline.BucketName)
+
+	keys := make([]string, 0)
+	for _, path := range paths {
+		path = filepath.Clean(strings.TrimPrefix(path, root))
+		if strings.HasPrefix(path, "..") {
+			return nil, fmt.Errorf("Path %s is outside the pipeline directory", path)
+		}
+		path = strings.TrimPrefix(path, "/")
+
+		var full string = filepath.Join(root, path)
+		info, err := os.Stat(full)
+		if err != nil {
+			return nil, fmt.Errorf("Path %s does not exist in the pipeline directory", path)
+		}
+		if !info.IsDir() {
+			keys = append(keys, submissionKey(path))
+			continue
+		}
+		if err := filepath.Walk(full, func(name string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				keys = append(keys, submissionKey(strings.TrimPrefix(name, root+"/")))
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// submissionKey : Create a files bucket key from a path relative to the pipeline directory
+func submissionKey(path string) string {
+	var dirname string = filepath.Base(filepath.Dir(path))
+	if dirname == "." || dirname == "/" {
+		dirname = "root"
+	}
+	return dirname + ":" + filepath.Base(path)
+}
+
+// ensureBuckets : Make sure the pipeline child bucket exists in each of the named buckets
+func (api *API) ensureBuckets(pipelineName string, buckets ...string) error {
+	return api.Db.Update(func(tx *bolt.Tx) error {
+		for _, name := range buckets {
+			b, err := tx.CreateBucketIfNotExists([]byte(name))
+			if err != nil {
+				return fmt.Errorf("Error creating bucket %s - %s", name, err)
+			}
+			if _, err = b.CreateBucketIfNotExists([]byte(pipelineName)); err != nil {
+				return fmt.Errorf("Error creating inner bucket %s/%s - %s", name, pipelineName, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/boltdb/bolt"
+	"github.com/notapipeline/tiyo/pkg/tracing"
+)
+
+// TRACES_BUCKET : The bucket holding the trace context of files and queue items
+//
+// Files are keyed `file:<dir>:<filename>` and queue items `queue:<queue key>`
+// so that the trace begun when fill reported a file continues through the
+// queue and on to the syphon executing it.
+const TRACES_BUCKET = "traces"
+
+// Trace key prefixes
+const (
+	TRACE_FILE  = "file:"
+	TRACE_QUEUE = "queue:"
+)
+
+// putTrace : Store a trace context inside an update transaction
+//
+// Empty carriers are not stored.
+func (api *API) putTrace(tx *bolt.Tx, pipelineName string, key string, carrier tracing.Carrier) error {
+	if len(carrier) == 0 {
+		return nil
+	}
+	b, err := api.childBucket(tx, TRACES_BUCKET, pipelineName)
+	if err != nil {
+		return err
+	}
+	body, _ := json.Marshal(carrier)
+	return b.Put([]byte(key), []byte(base64.StdEncoding.EncodeToString(body)))
+}
+
+// getTrace : Read a trace context inside a transaction
+//
+// Returns an empty carrier if none is stored.
+func getTrace(tx *bolt.Tx, pipelineName string, key string) tracing.Carrier {
+	carrier := make(tracing.Carrier)
+	b := tx.Bucket([]byte(TRACES_BUCKET))
+	if b == nil {
+		return carrier
+	}
+	if b = b.Bucket([]byte(pipelineName)); b == nil {
+		return carrier
+	}
+	if value := b.Get([]byte(key)); value != nil {
+		body, _ := base64.StdEncoding.DecodeString(string(value))
+		_ = json.Unmarshal(body, &carrier)
+	}
+	return carrier
+}
+
+// deleteTrace : Remove a trace context inside an update transaction
+func deleteTrace(tx *bolt.Tx, pipelineName string, key string) error {
+	b := tx.Bucket([]byte(TRACES_BUCKET))
+	if b == nil {
+		return nil
+	}
+	if b = b.Bucket([]byte(pipelineName)); b == nil {
+		return nil
+	}
+	return b.Delete([]byte(key))
+}
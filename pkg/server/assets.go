@@ -27,7 +27,9 @@
 // assets/files/img/pulse.svg
 // assets/files/img/source/directory.svg
 // assets/files/img/source/file.svg
+// assets/files/img/source/nats.svg
 // assets/files/img/source/stream.svg
+// assets/files/js/admin.js
 // assets/files/js/api.js
 // assets/files/js/collections/collection.js
 // assets/files/js/collections/container.js
@@ -38,11 +40,14 @@
 // assets/files/js/page.js
 // assets/files/js/pipeline.js
 // assets/files/js/router.js
+// assets/templates/admin.tpl
 // assets/templates/configure.tpl
+// assets/templates/enrol.tpl
 // assets/templates/error.tpl
 // assets/templates/footer.html
 // assets/templates/header.html
 // assets/templates/index.tpl
+// assets/templates/invite.tpl
 // assets/templates/login.tpl
 package server
 
@@ -661,7 +666,7 @@ func assetsFilesImgSourceStreamSvg() (*asset, error) {
 	return a, nil
 }
 
-var _assetsFilesJsApiJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\x5f\x6f\xdb\x38\x12\x7f\xf7\xa7\x98\xd3\x15\x90\x94\xaa\xb2\x93\xa7\x83\x03\xe1\x70\xcd\x25\xb8\xbb\xa6\x6d\x6e\x93\x97\x45\x10\x2c\x68\x69\x64\x33\xa6\x48\x81\xa4\x9c\x7a\xb7\xfe\xee\x0b\x92\xfa\x2f\x25\xc5\x62\x1f\xd7\x0f\xb6\x3c\x1c\xfe\xe6\xc7\xf9\xc7\xd1\xf2\x0c\xae\x44\x79\x94\x74\xbb\xd3\x70\xb1\xba\x38\x87\x87\x1d\xc2\x03\x3d\x0a\x20\x95\xde\x09\xa9\x16\x70\xb6\x80\x33\x78\xd8\x51\x05\xf7\xa2\x92\x29\xc2\x95\xc8\x10\x6e\x84\x2c\x80\x2a\x50\xd5\xe6\x19\x53\x0d\x5a\x80\xde\x21\x68\x94\x85\x02\x91\xdb\x3f\x9f\xc5\xaf\x94\x31\x02\x77\xd5\x86\xd1\xd4\xc0\xdc\xd2\x14\xb9\xc2\x08\x0e\x31\x5c\xc4\xab\x18\xfe\x9b\x03\x81\x54\x94\xc7\x76\xcf\xdd\x2d\xbc\x10\x05\x5c\x68\xc8\xa8\xd2\x92\x6e\x2a\x8d\x19\xbc\x50\xbd\x03\xbd\xa3\x86\x10\xe4\x94\x61\x04\x3f\x8b\x0a\x52\xc2\x41\x6c\x34\xa1\x1c\x04\x47\x20\x1a\x76\x5a\x97\x6a\xbd\x5c\x16\xce\x78\x2c\xe4\x76\xf9\xf9\xee\x76\x79\x11\xaf\x96\xf1\x02\xce\x96\x8b\xc5\xf3\xff\x2b\x94\xc7\x18\x49\xba\x0b\xe0\x11\xbc\x52\x28\xed\x45\xe0\x95\x95\xfd\xc9\x90\xa1\x46\x0f\x9e\x22\xc8\x2b\x9e\x6a\x2a\x78\x00\x34\x82\x02\xf5\x4e\x64\x10\xc2\x6f\x0b\x00\x00\x87\xf2\xd8\x88\x9f\x20\xe9\xa9\x57\x92\x45\x90\x11\x4d\x22\x48\x09\x63\x1b\x92\xee\x23\xd0\xc7\x12\xdb\xed\xe6\x43\x73\x08\x6a\x9c\x98\xaa\x9b\x76\xb7\xd9\x08\xe1\x40\xd5\x7c\xec\xfe\xc4\xfd\x7c\xff\xde\x02\x5f\x0e\x94\x1a\x29\x24\x16\x66\xb8\x68\x81\x13\xa8\x78\x86\x39\xe5\x98\x75\xab\xa7\x45\xfb\x78\x20\x12\x52\xc1\x35\x72\xfd\xd0\x59\xbc\x1c\xb0\x36\x12\x91\xdb\x9f\x10\x92\x24\x01\xbf\xc5\xf4\x5f\xa1\xed\x3f\x2b\xc1\xfd\x11\xd9\x81\x19\x9f\x94\x25\xa3\x29\x31\x4e\x58\x1a\xed\x4b\x48\x77\x44\x2a\xd4\x49\xa5\xf3\x0f\xff\xf0\xfb\x74\x9b\x27\x89\xba\x92\xbc\xf1\x22\x79\x26\xdf\x82\xa1\xf9\x4a\xb2\xb5\x8d\xc7\x84\xd4\xba\x8e\x5d\xf4\x1a\xa7\x75\xff\x4f\x34\xf1\xa4\x53\xd1\xb3\x6b\x6b\x17\xfd\x81\x5c\x55\x69\x8a\x4a\xad\xbb\x94\xe8\xce\x13\xba\xb3\x9d\x2e\x17\xe6\x71\xd1\x64\x12\xa4\x12\x89\xc6\x3b\x5a\x22\xa3\x1c\x03\x4e\x0a\x0c\x17\xee\x80\xa9\xe0\x4a\x30\x8c\x99\xd8\x3a\xf9\xe5\xe2\xb4\x58\x2c\xcf\x6c\xc1\x66\x58\x4a\x34\xae\xe4\x5b\x9b\xf4\x2d\x60\x26\xae\x33\xaa\x83\x3d\x1e\x1b\x9c\x0d\x24\xf0\x2e\xf0\xff\x5e\x6e\xaa\x74\x8f\xda\x0f\xe3\x03\x61\x41\x4d\x48\x8a\x4a\xa3\x8c\x39\x39\xd0\x2d\xd1\x18\xf8\xcb\x5c\xc8\xc2\xaf\x57\xb7\xa8\x83\x4d\x04\x06\xec\xd2\x25\x90\x01\x1a\xe0\x6c\x6a\x55\xb3\xb0\xc7\x63\x2d\x75\x3b\x4e\x8b\x3e\xaf\x3b\x89\x39\xfd\x76\x9f\x12\x1e\x38\x84\x86\xe0\x94\x5c\xbd\xde\x21\x97\x1d\xb4\xe7\xd5\xf2\x2b\x21\xf6\x14\x55\xac\x50\x07\x7e\xbd\x3d\x82\x76\xab\xd5\x51\xc6\xda\xab\x47\x35\xab\xfe\x88\xa7\x3d\xb2\xc5\x70\xe7\xae\x39\xf6\x8a\xa2\xb6\x00\x49\x02\x5e\x5b\x15\x5e\xbf\x2a\x9c\x86\x75\xbb\x57\x7b\xcb\x1b\x78\xbd\xae\xc6\x1e\xa8\x31\xf5\x06\xe2\x1e\x8f\x35\xdc\x1e\x8f\x63\x2c\xeb\xa4\xd8\x10\x6f\xf5\xbd\x25\x29\xe9\xf2\x70\xbe\x74\xd6\x97\x1e\xbc\x6f\x58\xbd\x07\xcf\xfe\xdd\xe3\xb1\xcb\xcf\xb6\xb3\x99\xac\x0e\x87\x05\x46\x73\x2b\x8d\x25\xaa\x8a\x69\x4b\xf2\xeb\x27\x6f\xdc\x05\x9a\x48\x1d\x08\xab\xb0\x0e\x95\xdd\x56\xa0\x52\x64\x8b\xe1\xb0\x33\x9c\x46\xa5\x3e\x8a\x82\x2b\x8b\x8f\x96\x71\x20\x31\xa3\x12\x53\x9d\x68\x59\x61\x3f\x20\x75\x44\x19\x51\xfa\x27\x54\x82\x1d\x30\x0b\xc2\xc7\xd5\x53\x5c\x49\x66\x79\xda\x08\x0f\xa8\x3a\xe0\xab\x1d\x65\x59\xd0\x24\xd0\xb6\x97\x40\x61\x34\x4d\xf1\xb0\xc7\xdd\xb5\xa3\x41\x14\xdf\xc5\xe6\x82\x09\x46\x2e\xf7\xa2\x49\x42\xac\x67\xa0\x63\x2d\x69\x11\x84\x0e\x2f\x1a\xc6\x21\x02\xa5\x89\xae\x54\x9f\xff\x7c\xd2\xbf\x49\xd9\x7a\xaa\x76\xe1\x38\x6a\x2f\x94\x67\xe2\x25\x66\xc2\x75\xe5\x98\x28\x45\xb7\xbc\x57\x1a\xc3\x28\x9d\xc2\x38\x27\x94\x05\x2d\x4d\x94\x52\xc8\x3e\xe8\x8e\xf0\x8c\xe1\xb5\x11\xd7\x8b\xb5\xaf\x66\x03\xec\xe2\x50\x12\x89\x5c\x7f\xac\x8b\x2e\x35\xb2\x2f\xa4\xc0\x08\x66\x03\xff\xaa\xbb\xff\x77\xff\xf5\x4b\x6c\x26\x0a\xbe\xa5\xf9\x31\x98\x7a\xbf\x6f\xa7\xf6\x7b\x57\x01\xd6\xec\xba\xb3\x3e\x50\x38\x85\x7f\xb9\xd0\xdc\x50\x86\xf7\x5a\xc8\xd1\x9d\xd4\x0b\x9b\x6f\xc6\x34\xe5\x47\xc0\xad\xbb\xc4\xad\x78\x41\x79\x45\x14\x06\x61\x2c\xb1\x64\x24\xc5\x7f\x31\x16\x78\x60\x86\xae\x5f\xbc\x26\xd5\x23\xc8\x09\x53\x38\xbe\x1e\xec\x50\x56\x17\x7c\x17\x6a\x27\x1e\x07\xdb\xf6\xaf\x59\xc7\x4e\x21\x3f\xe1\x31\x98\xef\xe3\x7f\xbe\xe5\xfe\x80\xdd\xe8\xc2\xed\x77\xde\xc9\xe5\xf8\x6f\x07\x35\x62\x9a\x0a\x9e\x53\x59\x04\x9e\x5b\xfe\xa7\x17\xf6\x19\x0e\x0f\xd8\xa7\x56\xe7\x8e\x42\xfd\x40\x0b\x14\x95\x0e\x4c\xd2\x44\x70\xbe\x5a\xad\x46\xa6\xcb\xca\x5e\xf1\x69\x04\xfb\x08\x0e\x0d\x7c\xff\xba\x7b\x73\x00\x6c\x86\x8b\xb9\xd9\x62\x7a\xcb\xa5\x13\x2c\x3b\xe8\x5a\x21\xaf\x18\x1b\x74\x6a\x48\xc0\xf3\x86\xb5\xb2\x89\x29\x4f\x59\x95\xa1\x0a\xfc\xa5\x1f\x8e\x4b\xc6\x4c\xb6\x25\x91\x5a\x41\x02\x9b\x58\x95\x8c\x6a\xab\x37\x50\x32\x8c\xad\xd2\xe3\xea\x69\x34\xad\xb6\x2b\xe7\x4f\x93\xf2\x9a\xde\xd8\x6f\xfa\x65\x5f\xfb\xa5\x9b\x5b\x06\x01\xea\xe1\x1c\xde\xc4\x39\xd4\x38\xfd\x6b\x35\x1c\x5f\x3f\xd5\xeb\x37\xbf\xd7\x75\xb8\x71\x7f\x6c\xfb\xe2\x26\x6a\xbb\x9f\x9d\x79\xd6\x36\x15\x8c\xc1\x35\x1c\xe0\x14\xce\x5d\xd0\x76\xb0\x6a\xd3\xc5\x66\x7b\xae\xfc\x30\xde\xe9\xa2\x37\xa2\x99\x80\x28\xf7\x56\xe9\x8e\x81\xdf\x4a\x26\x24\xea\x92\x35\xba\x3d\x4d\x8d\x45\xc9\x88\x36\xba\xff\xb1\xbd\x6a\x43\xa4\x8a\x53\x51\x94\x94\x61\xe0\x70\x9a\xc1\xce\xe8\xf7\xc6\xac\x57\xa6\x5b\x9b\x33\xb5\x96\xa9\xf4\xf9\x29\x6d\x76\x0e\xe8\x12\xe0\x07\xe3\xe9\x34\x33\x0e\x21\xfc\xed\x8d\x8a\x69\xcc\x8e\xe6\xb1\xc3\x1f\xb0\xd8\x78\xc0\xce\x39\x5d\xc8\x4d\x50\x7a\xa3\x5e\xe7\x83\xd1\x08\xed\xf8\x0d\xbd\xe1\xa0\xcc\x77\x43\x68\xbc\x69\x94\x75\xc6\x5b\xf6\x35\x78\x38\x3b\xf6\x73\x97\x48\x30\x31\x36\xcf\x49\x1b\xdd\xd1\xeb\x1b\xcd\xd6\xe0\xd5\x69\xf1\xc1\x7b\x3f\x13\xca\xe1\x4b\x96\x5b\x53\xee\xfd\xab\x19\x2e\xe3\x5a\x3a\x54\xdd\xe3\x71\xac\x67\x44\x73\x78\x0c\xf9\x2c\x22\x43\x3e\xc1\x9c\xea\x3a\xe1\xe4\x65\x6f\xa6\x32\xcc\x57\x6f\xf5\x85\x50\x7d\x23\xe4\x35\x0b\x7c\x9d\xc5\x98\x51\x4d\x36\x0c\xfd\x08\x9a\xc7\x6b\x86\x05\x72\xad\x06\x57\xf5\xef\x01\x00\x00\xff\xff\x02\xda\xb2\xe6\xd6\x11\x00\x00")
+var _assetsFilesJsApiJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\x5f\x6f\xe3\xb8\x11\x7f\xd7\xa7\x98\xaa\x01\x24\x65\xb5\xb4\x93\xa7\xc2\x81\x50\x74\xd3\x04\x6d\x37\xbb\x9b\x36\x79\x29\x82\xe0\x40\x4b\x23\x9b\x31\x4d\x0a\x24\xe5\xac\xef\xd6\xdf\xfd\x40\x52\xff\xed\xe4\x70\xb8\xc7\x83\x0c\x59\x1a\x0e\x7f\xf3\xe3\xfc\x21\x47\xb3\x73\xb8\x96\xd5\x5e\xb1\xd5\xda\xc0\xe5\xfc\xf2\x02\x1e\xd7\x08\x8f\x6c\x2f\x81\xd6\x66\x2d\x95\x0e\xe0\x3c\x80\x73\x78\x5c\x33\x0d\x0f\xb2\x56\x39\xc2\xb5\x2c\x10\x6e\xa5\xda\x02\xd3\xa0\xeb\xe5\x0b\xe6\x06\x8c\x04\xb3\x46\x30\xa8\xb6\x1a\x64\xe9\x5e\xbe\xc8\x9f\x19\xe7\x14\xee\xeb\x25\x67\xb9\x85\xb9\x63\x39\x0a\x8d\x29\xec\x08\x5c\x92\x39\x81\x7f\x97\x40\x21\x97\xd5\xbe\x9b\x73\x7f\x07\xaf\x54\x83\x90\x06\x0a\xa6\x8d\x62\xcb\xda\x60\x01\xaf\xcc\xac\xc1\xac\x99\x25\x04\x25\xe3\x98\xc2\xff\x65\x0d\x39\x15\x20\x97\x86\x32\x01\x52\x20\x50\x03\x6b\x63\x2a\xbd\x98\xcd\xb6\xde\x38\x91\x6a\x35\xfb\x72\x7f\x37\xbb\x24\xf3\x19\x09\xe0\x7c\x16\x04\x2f\xff\xad\x51\xed\x09\xd2\x7c\x1d\xc3\x13\x84\x95\xd4\x26\x4c\x21\xac\x6a\xf7\x57\x20\x47\x83\x21\x3c\xa7\x50\xd6\x22\x37\x4c\x8a\x18\x58\x0a\x5b\x34\x6b\x59\x40\x02\xbf\x04\x00\x00\x1e\xe5\xa9\x15\x3f\x43\x36\x50\xaf\x15\x4f\xa1\xa0\x86\xa6\x90\x53\xce\x97\x34\xdf\xa4\x60\xf6\x15\x76\xd3\xed\x8f\x95\x10\x37\x38\x84\xe9\xdb\x6e\xb6\x9d\x08\xc9\x48\xd5\xfe\xdc\xfc\xcc\xc3\xfc\xf8\xd1\x01\x5f\x8d\x94\x5a\x29\x64\xce\xfe\x78\xd0\x4a\x20\x83\x5a\x14\x58\x32\x81\x45\x3f\x7a\x08\xba\xc7\x1d\x55\x90\x4b\x61\x50\x98\xc7\xde\x62\xaf\x6a\x59\x5b\x89\x2c\xdd\x5f\x02\x59\x96\x41\xd4\x61\x46\x6f\xd0\x8e\x5e\xb4\x14\x51\x0f\x63\xaf\xb1\x99\x88\x56\x15\x67\x39\xb5\x1e\x9f\x59\xed\x2b\xc8\xd7\x54\x69\x34\x59\x6d\xca\x8f\x7f\x1b\x4c\x3e\x74\x4f\x0a\x4d\xad\x44\xeb\x45\xfa\x42\xbf\xc7\x63\xf3\xb5\xe2\x0b\x17\x8f\x91\xd4\x92\x5a\x34\xb1\x4b\xdf\xe2\xb4\x18\xbe\x8c\xb5\xac\x27\xad\x74\x01\xe6\xe4\xd8\xc2\xdd\xc7\x72\x5d\xe7\x39\x6a\xbd\xe8\x53\xa2\x1b\x3e\x24\x7e\x6d\x87\xab\xc0\x3e\x06\x6d\x26\x41\xae\x90\x1a\xbc\x67\x15\x72\x26\x30\x16\x74\x8b\x49\xe0\x17\x98\x4b\xa1\x25\x47\xc2\xe5\xca\xcb\xaf\x82\x43\x10\xcc\xce\x5d\xc1\x16\x58\x29\xb4\xae\x14\x2b\x97\xf4\x1d\x60\x21\x6f\x0a\x66\xe2\x0d\xee\x5b\x9c\x25\x64\x70\x16\x47\x7f\xad\x96\x75\xbe\x41\x13\x25\x64\x47\x79\xdc\x10\x52\xb2\x36\xa8\x88\xa0\x3b\xb6\xa2\x06\xe3\x68\x56\x4a\xb5\x8d\x9a\xd1\x15\x9a\x78\x99\x82\x05\xbb\xf2\x09\x64\x81\x46\x38\xcb\x46\xd5\x0e\x6c\x70\xdf\x48\xfd\x8c\xc3\x60\xa1\x85\xbc\x57\x58\xb2\xef\x0f\x39\x15\xb1\x47\x68\x09\x1e\x93\x6b\xc6\x7b\xe4\xaa\x87\x0e\xc3\xc6\xe2\xb5\x94\x1b\x86\x9a\x68\x34\x71\xd4\x4c\x4f\xa1\x9b\xea\x74\xb4\xb5\xf6\xe6\x52\xed\x68\x34\xe1\xe9\x96\xec\x30\x52\x18\x38\x71\x50\x14\x8d\x05\xc8\x32\x08\xbb\xaa\x08\x87\x55\xe1\x35\x9c\xdb\xc3\xc6\x5b\xe1\xc8\xeb\x87\x60\x0a\x6a\x4d\xbd\x83\xb8\xc1\x7d\x03\xb7\xc1\xfd\x14\xcb\xde\xcf\x88\x25\xde\xe9\x87\x33\x5a\xb1\xd9\xee\x62\xe6\xad\xcf\x42\xf8\xd0\xb2\xfa\x00\xa1\x7b\xdd\xe0\xbe\xcf\xcf\x76\xf9\xb1\xcd\xea\xa4\xb7\x6b\x2f\x56\x3a\x29\x51\xa8\x6b\x6e\x1c\xc9\x6f\x9f\x47\xec\xda\xcb\x46\x6a\x47\x79\x8d\x4d\xa8\xdc\xb4\x2d\x6a\x4d\x57\xd8\xb0\x3d\x2e\x70\xff\x34\x89\x82\x2f\x8b\x4f\x8e\x71\xac\xb0\x60\x0a\x73\x93\x19\x55\x77\xd5\x61\x03\xd2\x44\x94\x53\x6d\xfe\x87\x5a\xf2\x1d\x16\x71\xf2\x34\x7f\x26\xb5\xe2\x8e\xa7\x8b\xf0\x88\xaa\x07\xbe\x5e\x33\x5e\xc4\x6d\x02\xad\x06\x09\x94\xa4\xc7\x29\x9e\x0c\xb8\xfb\xed\x68\x14\xc5\x33\x62\x0f\x98\x78\xe2\xf2\x30\x1d\x58\xf5\xae\x5f\x9c\x80\x26\x46\xb1\x6d\x9c\x38\xcd\xc3\xe0\x40\xb2\xae\x4b\x41\x1b\x6a\x6a\x3d\xe4\x7f\x3a\xe9\xdf\xa5\xec\x3c\xd5\xb8\x70\x08\x65\xaf\x57\x26\x0a\xf9\x4a\xb8\xb4\x5b\x89\x14\x84\x6a\xcd\x56\x62\x50\x1a\xad\xa2\x8f\xd2\x21\x21\x25\x65\x3c\xee\x68\xa2\x52\x52\x0d\x41\xd7\x54\x14\x1c\x6f\xac\xb8\x19\x6c\x7c\x75\x32\xc0\x3e\x0e\x15\x55\x28\xcc\xa7\xa6\xe8\x72\x2b\xfb\x4a\xb7\x98\xc2\xc9\xc0\xbf\xe9\xee\xff\x3c\x7c\xfb\x4a\x6c\x47\x21\x56\xac\xdc\xc7\xc7\xde\x1f\xda\x69\xfc\xde\x57\x80\x33\xbb\xe8\xad\x8f\x14\x0e\xc9\x9f\x2e\x34\xb7\x8c\xe3\x83\x91\x6a\x72\x26\x0d\xc2\x16\xd9\x36\x4d\x47\x29\x58\x05\x62\xe4\x9d\x7c\x45\x75\x4d\x35\xc6\x09\x51\x58\x71\x9a\xe3\x3f\x38\x8f\x43\xb0\x4d\xd7\x4f\x61\xd2\x7a\x14\x4a\xca\x35\x4e\x8c\xfa\xa6\xac\x29\xf8\x3e\xd4\x5e\x3c\x0d\xb6\xdb\xbf\x4e\x3a\xf6\x18\xf2\x33\xee\xe3\xd3\xfb\xf8\x1f\xdf\x72\x7f\x83\xdd\xe4\xc0\x1d\xee\xbc\x53\xaa\xf2\x9f\x1e\x6a\xc2\x34\x97\xa2\x64\x6a\x1b\x87\x7e\xf8\xef\x61\x32\x64\x38\x5e\xe0\x90\x5a\x93\x3b\x1a\xcd\x23\xdb\xa2\xac\x4d\x6c\x37\xc2\x14\x2e\xe6\xf3\xf9\xc4\x74\x55\xbb\x23\x3e\x4f\x61\x93\xc2\x2e\x81\x23\x37\x2d\xdf\x6d\x00\xdb\xe6\x62\xbc\xd4\x96\xcb\x14\x2b\x3f\xc2\x72\x8d\xae\x13\x8a\x9a\xf3\x21\x72\x0e\x19\x84\xe1\xb8\x56\x96\x84\x89\x9c\xd7\x05\xea\x38\x9a\x45\x23\x67\xb4\x9d\x6d\x45\x95\xd1\x90\xc1\x92\xe8\x8a\x33\xe3\xf4\x46\x4a\x96\xb1\x53\x7a\x9a\x3f\xf7\xe8\xad\x45\x3f\x72\xf1\x7c\x54\x5e\xc7\x27\xf6\xbb\x7e\xd9\x40\x36\x69\x89\x46\x01\x1a\xe0\xec\xde\xc5\xd9\x35\x38\xc3\x63\x75\xec\xde\xa6\x41\x3e\x23\x36\x92\xdd\xbc\x49\x4e\x86\xfd\x46\x37\xdd\x26\xbb\xed\x71\xd9\xec\xbd\x0b\x97\x0c\xb8\x5f\xb8\x8c\xb0\x76\x17\xb0\x83\x43\x72\xea\x9c\xb6\x69\x15\x77\x59\x63\x13\xa1\x2a\x75\x94\x90\xb5\xd9\x0e\x3a\x35\x1b\x17\xed\x3f\x2e\xfd\x6a\xf0\x7b\xc5\xa5\x42\x53\xf1\x56\x77\xa0\x69\x70\x5b\x71\x6a\xac\xee\xbf\xdc\x96\xb5\xa4\x4a\x93\x5c\x6e\x2b\xc6\x31\xf6\x38\x6d\x7f\x67\x91\x07\xdd\xd6\x51\xcd\x5d\x75\xde\x6e\xb5\x6c\x5b\x30\xaa\xf3\x6e\xfa\xc9\x76\xa0\xcf\x83\x63\xf4\xae\xd5\x3c\x9d\x20\xbb\x04\xfe\xf2\x76\x60\x3b\xb3\x93\xb6\x6c\xf7\x3b\x2c\xb6\x1e\x70\xed\x4e\x1f\x72\x1b\x94\x41\xc7\xd7\xfb\x60\xd2\x49\x7b\x7e\x63\x6f\x78\x28\x7b\x6f\x09\x4d\x27\x8d\x96\xeb\xdb\x4e\xfb\xf5\x35\x3e\x1f\x87\x90\x96\xa1\xcd\x07\xfb\x9c\x75\xd1\x9d\x7c\xc5\xb1\x62\x01\x61\x93\x16\x1f\xc3\x0f\xc7\x4b\x4f\xd2\x91\xbe\xf7\x84\xf6\x9f\x61\x6d\x8f\x49\x1a\xe9\x58\x75\x83\xfb\xa9\x9e\x15\x9d\xc2\xe3\x28\x4e\x22\x72\x14\x47\x98\xc7\xba\x5e\x78\xf4\xcd\x77\xa2\x32\xec\x6d\x30\xfa\x4a\x99\xb9\x95\xea\x86\xc7\x91\x29\x08\x16\xcc\xd0\x25\xc7\x28\x85\xf6\xf1\x86\xe3\x16\x85\xd1\xcd\x9c\x43\x72\x15\x1c\x82\x5f\x07\x00\x3e\x27\x0f\x10\xdd\x11\x00\x00")
 
 func assetsFilesJsApiJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -676,7 +681,7 @@ func assetsFilesJsApiJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/files/js/api.js", size: 4566, mode: os.FileMode(436), modTime: time.Unix(1638518299, 0)}
+	info := bindataFileInfo{name: "assets/files/js/api.js", size: 4573, mode: os.FileMode(436), modTime: time.Unix(1792357910, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -761,7 +766,7 @@ func assetsFilesJsCollectionsLinkJs() (*asset, error) {
 	return a, nil
 }
 
-var _assetsFilesJsCollectionsSourceJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\xdd\x8e\xdb\xba\x11\xbe\xf7\x53\x4c\x95\xc5\xa1\xb4\xb5\xa9\x4d\x7a\x02\x14\x5a\xdb\x17\x4d\x4f\x81\x00\x9b\x62\xd1\xec\x29\x50\xa4\xb9\xa0\xa5\xb1\xcc\x2c\x4d\x12\x24\xe5\xd8\x27\xf0\xbb\x17\xa4\xa4\xb5\xf5\xe3\xdd\xa4\x2d\x0a\x54\x17\xb6\x3c\x7f\x9c\xf9\xe6\x23\x39\x4e\xaf\xe1\x9d\xd2\x07\xc3\xcb\x8d\x83\x37\x37\x6f\x5e\xc3\xc3\x06\xe1\x81\x1f\x14\xb0\xca\x6d\x94\xb1\x13\xb8\x9e\xc0\x35\x3c\x6c\xb8\x85\x8f\xaa\x32\x39\xc2\x3b\x55\x20\xfc\x45\x99\x2d\x70\x0b\xb6\x5a\x7d\xc1\xdc\x81\x53\xe0\x36\x08\x0e\xcd\xd6\x82\x5a\x87\x1f\x1f\xd4\x6f\x5c\x08\x06\xf7\xd5\x4a\xf0\xdc\x87\xb9\xe3\x39\x4a\x8b\x53\xd8\x51\x78\x43\x6f\x28\xbc\x5f\x03\x83\x5c\xe9\xc3\x93\xcf\xfd\x1d\x7c\x65\x16\xa4\x72\x50\x70\xeb\x0c\x5f\x55\x0e\x0b\xf8\xca\xdd\x06\xdc\x86\xfb\x84\x60\xcd\x05\x4e\xe1\x1f\xaa\x82\x9c\x49\x50\x2b\xc7\xb8\x04\x25\x11\x98\x83\x8d\x73\xda\x66\x69\xba\xad\x17\xa7\xca\x94\xe9\x87\xfb\xbb\xf4\x0d\xbd\x49\xe9\x04\xae\xd3\xc9\x24\x17\xcc\x3e\x55\xf3\x6d\x02\x00\x70\x65\xc3\xaf\x7b\xa3\x34\x1a\xc7\xd1\xc2\x02\xae\xe2\xa0\xf2\x0f\x99\x17\x7c\x07\xc1\x6f\x11\x0d\x4c\xf5\xd3\x6b\xb4\x24\xbf\x3f\x73\xda\xfc\xbc\x9c\xa7\x9b\x9f\xbb\xc2\xb5\x32\xdb\x8e\x04\x60\xee\xd8\x4a\x60\x4f\xe8\xc5\x66\x20\xf3\xd2\x62\x39\x17\x6c\x85\x02\xd6\xca\xb4\xe9\x48\xb6\xc5\x68\xf9\x57\xb6\xc5\x79\x1a\x94\xcb\x79\xea\x8a\x4b\xee\x5c\xea\xca\x01\x2f\x3a\xde\xb0\x63\xa2\xc2\x45\x14\x41\x3a\xee\x3c\x4f\x07\x09\xcd\xd3\xb1\xd4\x03\x5a\xd6\x1d\x04\x2e\xa2\xb5\x50\xcc\x65\x10\x28\x76\x1b\x0d\x63\xb2\x16\xd6\xea\x71\xb6\xaa\x9c\x53\x72\x66\xb7\x4c\x08\xdf\xda\x1c\x45\xb4\xac\xbf\xe7\x29\xfb\x01\xdf\xd3\x6f\x6d\xf8\x96\x99\x03\x14\x4a\x62\xb4\xf4\x9f\xc3\x48\xf3\xb4\xe0\xbb\x6e\x93\xd2\x41\x97\x1a\xa3\x20\x48\x6e\x27\xe1\xbb\x34\xaa\xd2\x0f\x07\x8d\xb0\x80\x35\x13\x16\x1b\x79\xae\xa4\x75\xa6\xca\x9d\x32\x71\xd2\x30\x2c\xb0\x2c\x26\xaf\x34\xd3\x68\x66\x9a\x6b\x14\x5c\xe2\x6c\xa3\x44\x81\x86\x24\x94\x69\x8d\xb2\x88\x3d\xc5\xe9\x80\x8d\xc9\x6d\x88\x71\xac\xc3\x5b\x74\x95\xfe\x65\x87\xd2\xd9\x4e\xf8\x5f\xdf\x3f\x72\x47\x2b\xc7\x05\x55\x32\x26\xaf\xea\x28\x33\x14\xb8\x45\xe9\x66\x82\x5b\x47\xa6\x40\xac\x63\xc6\xbf\xc4\x98\xc0\x62\x79\xe6\xef\x1f\x6f\x0c\x0b\x40\x5a\xa0\x63\x5c\x7c\x7a\xfd\xf9\xb6\xa3\x2f\x54\x5e\xf9\x68\xb4\x44\xf7\x4b\x1d\xf8\x4f\x87\xf7\x45\x4c\x2e\xd6\x55\x14\x21\xd5\x3b\x6e\x1d\x4a\x34\x31\xd1\x8a\x4b\x7f\x4e\xa8\x1d\x92\x29\x28\xf9\x67\xc3\xca\x92\xcb\x32\x39\xad\x74\x6c\x11\xfe\xa1\xb2\x94\xbe\x54\x15\x5f\x43\xfc\x3b\xc7\x4c\x89\x2e\xe9\xa9\xfc\x63\xd0\x55\x46\x76\x0b\x3d\xfe\x67\x65\x1b\xf4\xf5\xfd\x78\xe5\x6d\xb6\x57\x71\x93\xee\xa7\x9b\xcf\x54\xaa\x02\xfd\xd6\x86\xc5\x02\x22\xbb\x2b\x23\xf8\xe9\x27\xe8\x58\x68\x66\x50\xb6\x99\x51\x5e\x04\xcb\x6e\x6e\xd1\x58\xe5\x3b\x66\x40\x86\xc8\x70\x15\x7b\x44\x13\xba\xe6\xb2\x88\x09\xdf\x96\xbe\x79\xce\x99\x98\x58\x93\x87\x8a\xb4\x60\x39\xc6\x29\xbd\xfe\x67\x9a\x4e\x81\x90\x84\x5a\x2d\xb8\x8b\x09\x25\x3e\x89\xdb\xd1\xe8\xa1\x66\x58\x40\x9b\x86\x47\xf0\xc1\x30\x69\xfd\x0e\xbb\xf7\xca\x38\x19\xf7\xac\xfb\x0c\x0b\xc8\x95\x10\x98\x3b\xae\xa4\xa5\xb5\x90\xe6\x42\x49\x8c\x7d\xe6\x09\xd5\xca\x72\xaf\x8c\x07\x51\xfc\x13\xd6\xa7\xfb\x69\xf3\x72\x18\x18\x35\x55\x8e\x3a\x13\x1a\xce\xd2\xd4\xe1\xde\x73\xcc\x03\x44\xfd\xfb\x3b\x25\x9d\x07\xda\x19\xbe\x8d\x93\x61\xc8\x61\x41\x4d\xde\x7e\xa9\x70\xa3\xb5\x95\xb8\xfa\xf4\xf0\x95\x5c\x76\x2a\x8a\x07\x15\x9f\x00\x34\x4c\x6f\x92\xe7\xd8\x5a\x13\xc3\x87\xad\x84\xb8\x1d\xdb\xdf\x5d\xc5\xb1\x7b\xbe\x9c\x92\x8c\x77\x1c\xbf\x4e\x01\x3d\x8d\xa7\xb0\x9f\xc2\xe1\x9c\x44\xbe\x49\xcd\x2e\x0c\xfc\x21\xb4\x7f\x6e\x91\xb3\x34\xaf\x9e\xb6\xae\x2f\x96\x24\x74\xc7\x44\x88\x4f\xb7\xaa\x40\x71\x0e\x4d\xe8\xeb\xc9\xb3\x59\x83\xe6\xd6\xc6\x5d\x0a\x47\x6d\xef\xa3\x0c\x22\xb6\xb2\x4a\x54\x0e\xa3\x69\xd7\xa6\xe0\x56\x0b\x76\xf0\x26\x2b\xa1\xf2\xc7\xbe\x5e\xe0\xda\x45\x19\xec\x7b\x62\xa7\x74\x94\xc1\x61\x3a\x7e\x28\xb5\x39\xd5\xbb\x85\xfa\x8b\x85\x24\x34\x17\x3c\x7f\x8c\x47\x8f\xa0\xcb\x95\xd6\xd8\x0d\xb1\xe9\xb5\xb8\x17\x20\x4e\x3e\x35\xec\x24\x9f\x03\x27\x9f\x09\x33\x60\xc0\x38\x9a\x7d\xb4\xa4\xbf\x33\xbb\xa8\x1c\x7b\x49\x3d\x91\xd2\xb2\x1d\xf6\x33\x1e\xc7\x48\xad\xd7\x31\x09\x40\x91\x9e\xfd\x97\xb0\x43\x0b\xce\x68\x73\x90\xfd\xdd\x97\xac\x8d\x72\xca\x6f\x13\x6a\x50\x16\x68\xfc\x4d\x29\x0e\x0d\x33\x99\x29\xc3\xb9\x6c\x2f\x5d\x1e\xbd\x1c\xea\x61\xe2\xf9\x4e\xfd\x57\x01\xba\xb4\xfe\x38\x0a\xbd\x8d\x98\x0b\x65\xb1\x3f\x41\x8c\x6c\xb2\xef\x42\xf8\x79\xd7\x17\x12\xbb\xe0\x3c\x84\x88\x34\xf0\x90\x0c\x88\x87\x87\x4c\x47\x8a\x3b\x4e\x26\x75\xaf\xed\x86\x69\xb4\x34\x57\xd2\x8f\xf0\x68\xe8\xc7\xf6\xcc\xef\xe8\x0b\xdc\x59\xfa\x21\x50\x1f\xf7\xce\x8f\x49\xf5\xa2\x05\xae\x59\x25\x9c\xcd\x1a\xf3\x30\x23\x14\x88\xfa\x63\xa5\x75\x0d\xfc\x59\x7a\x5b\x66\x1e\x2b\x9d\x01\x99\x97\xed\xdc\x68\x94\x63\x61\x80\x8d\x96\x27\xa1\xcd\x99\x68\x64\x7c\xcb\x4a\x6c\xe5\x2b\x55\x1c\x22\x3f\x1a\x97\xcb\x79\xd8\x70\x8d\x3c\x6c\x42\xaf\x78\x8a\xc0\xe5\xbd\x32\xce\x76\x64\xaa\x72\x4f\xc2\xb4\x5c\x9e\xe1\xe2\xb9\x9d\x01\xe9\x83\x70\x66\xa1\xd1\xf8\xe1\x90\xe7\x95\x60\xe6\x8e\xcb\x47\x9b\x81\x33\x15\x4e\x4f\x3c\xf7\x1b\x3e\x83\xe8\x8c\x8d\xa7\xfb\xa5\x2b\x6f\x8f\xcc\x0c\xbe\xc1\x3e\x83\xb7\x37\x53\x38\xf8\x2f\x38\x9e\xf9\xf2\xdf\xd0\xeb\xbf\xf2\xc2\x6d\x6a\x9b\x0d\xfa\x09\xbe\x6f\xd8\x54\x9a\xc1\x27\xc2\xfc\x10\xb6\xf2\x1f\x39\xf9\x7c\xb2\x68\xeb\xf6\x26\xea\x5c\xe1\x0f\x31\x9b\xf5\xf6\x5e\x7b\xa4\xf5\xe5\x01\x27\xdc\xbb\x0c\x48\xe0\x01\x99\x0e\xd4\xc4\xe0\x7a\xb6\x27\x19\xdc\xd0\xb7\x17\xb4\x9e\x95\x6f\x6f\x46\x94\x6b\x25\xdd\xcc\x17\xed\x69\xfb\xfa\x46\xbb\x5e\xfc\xb3\x8a\x8f\xe7\x48\x86\xba\xba\x99\x86\xff\x04\x03\x69\x58\x85\xcb\xd1\xba\x2e\x82\xd1\x71\xa6\x7e\xb5\x99\xe7\xe0\xc5\x20\xed\xb3\x65\xa5\x44\x0f\x95\x66\xd6\xf2\x1d\x8e\x80\x75\xfe\x98\x0c\xfe\xf0\xbc\x85\x75\x46\x3d\x7a\x8e\x96\x06\x51\xbe\x10\x6e\xcd\x85\xf8\x4e\x53\x52\x07\x9e\x05\x9a\x91\x0c\x5e\xbf\x60\xde\x76\x78\xf6\xc7\xcb\x86\xc7\xcb\xaa\x06\xc3\xcb\x0c\xeb\x18\xfb\x3a\x66\x4a\xb3\x9c\x3b\x0f\xf9\x08\x6d\x5e\x58\xf2\x38\x90\x8e\x18\x12\x55\xb9\xff\x0d\x2b\x7e\xa0\xcd\x06\x8b\xef\x6b\xf2\xcb\x86\xff\x6e\x8b\xff\x5f\x3b\x7c\x61\x2a\x6f\x02\x1c\xa7\x17\x2f\xb6\xd3\x98\xd3\xde\x6a\xc9\x24\x4c\x32\x69\xfa\xaa\x39\xcb\x7f\xfd\xdb\xdd\x22\xb5\x8e\x39\x9e\xa7\x5f\x6c\x7a\xf6\xb7\x28\x6d\xfe\x29\x7c\xb1\x93\x7f\x05\x00\x00\xff\xff\x45\xee\xad\xdf\xd3\x13\x00\x00")
+var _assetsFilesJsCollectionsSourceJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x5f\x8f\xdb\xb8\x11\x7f\xf7\xa7\x98\x2a\xc1\x51\xda\xda\xd4\x26\xbd\x03\x0a\xad\xed\x87\xa6\x57\x20\xc0\xa6\x58\x34\x7b\x05\x8a\x34\x0f\xb4\x34\x96\x99\xa5\x49\x82\xa4\x9c\x75\x82\xfd\xee\x05\x29\x69\x57\x92\xa5\xfd\x93\x16\x07\xd4\x12\x2c\x69\x38\x33\x9c\x3f\xbf\x19\x92\xe9\x19\xbc\x53\xfa\x68\x78\xb9\x73\xf0\xf6\xfc\xed\x1b\xb8\xde\x21\x5c\xf3\xa3\x02\x56\xb9\x9d\x32\x76\x06\x67\x33\x38\x83\xeb\x1d\xb7\xf0\x51\x55\x26\x47\x78\xa7\x0a\x84\xbf\x29\xb3\x07\x6e\xc1\x56\x9b\x2f\x98\x3b\x70\x0a\xdc\x0e\xc1\xa1\xd9\x5b\x50\xdb\xf0\xf1\x41\x7d\xe3\x42\x30\xb8\xaa\x36\x82\xe7\x5e\xcd\x25\xcf\x51\x5a\x9c\xc3\x81\xc2\x5b\x7a\x4e\xe1\xfd\x16\x18\xe4\x4a\x1f\xef\x65\xae\x2e\xe1\x2b\xb3\x20\x95\x83\x82\x5b\x67\xf8\xa6\x72\x58\xc0\x57\xee\x76\xe0\x76\xdc\x1b\x04\x5b\x2e\x70\x0e\xff\x52\x15\xe4\x4c\x82\xda\x38\xc6\x25\x28\x89\xc0\x1c\xec\x9c\xd3\x36\x4b\xd3\x7d\x3d\x39\x55\xa6\x4c\x3f\x5c\x5d\xa6\x6f\xe9\x79\x4a\x67\x70\x96\xce\x66\xb9\x60\xf6\xde\x9b\xef\x33\x00\x80\xd7\x36\xf8\x76\x65\x94\x46\xe3\x38\x5a\x58\xc1\xeb\x38\x0c\xf9\x9b\x2c\x0b\x7e\x80\x20\xb7\x8a\x4e\x58\xf5\xfd\x6b\xb4\x26\x7f\xec\x08\xed\x7e\x5e\x2f\xd3\xdd\xcf\x7d\xe2\x56\x99\x7d\x8f\x02\xb0\x74\x6c\x23\x70\x40\xf4\x64\x73\x42\xf3\xd4\x62\xbd\x14\x6c\x83\x02\xb6\xca\xb4\xe6\x48\xb6\xc7\x68\xfd\x77\xb6\xc7\x65\x1a\x06\xd7\xcb\xd4\x15\x53\xe2\x5c\xea\xca\x01\x2f\x7a\xd2\x70\x60\xa2\xc2\x55\x14\x41\x3a\x2e\xbc\x4c\x47\x0c\x5a\x3a\xd3\x06\x46\x32\x67\xa3\xa9\x29\x4f\x2c\x6e\x90\x13\xad\x3f\xd6\x2f\x3f\x62\x77\xab\xe3\xf7\x36\x1d\xcd\x01\x4d\xb4\xfe\x18\x9e\x3f\x64\x78\x90\xec\xd8\xad\x05\xcb\x71\xa7\x44\x81\x66\x15\x15\xb8\x65\x95\x70\x2f\xf1\x66\x99\x8e\x61\x28\xc0\xd6\xba\xa3\xc0\x55\xb4\x15\x8a\xb9\x0c\x42\xad\x5f\x9c\xfa\xba\x64\x6d\x2c\xaa\x9b\xc5\xa6\x72\x4e\xc9\x85\xdd\x33\x21\x7c\x8d\xe5\x28\xa2\x75\xfd\x5c\xa6\xec\x05\xb2\x0f\xdf\xda\xf0\x3d\x33\x47\x28\x94\xc4\x68\xed\xff\x4f\x35\x2d\xd3\x82\x1f\x7a\xb4\x65\x7a\x52\x2e\x0d\x53\x90\x4b\x2e\x66\xe1\x59\x1a\x55\xe9\xeb\xa3\x46\x58\xc1\x96\x09\x8b\x0d\x3d\x57\xd2\x3a\x53\xe5\x4e\x99\x38\x81\xef\xf7\x4a\x5e\xc7\xe4\x95\x66\x1a\xcd\x42\x73\x8d\x82\x4b\x5c\xd4\xb1\x27\x09\x65\x5a\xa3\x2c\x62\xdf\x6b\xe8\x49\x5b\x48\x2e\x82\x8e\xbb\x5a\xbd\x45\x57\xe9\x5f\x0f\x28\x9d\xed\xa9\xff\xed\xfd\x0d\x77\xb4\x72\x5c\x50\x25\x63\xf2\xaa\xd6\xb2\x40\x81\x7b\x94\x6e\x21\xb8\x75\x64\x0e\xc4\x3a\x66\xfc\x4b\x8c\x09\xac\xd6\x1d\x79\x7f\x7b\x66\x58\x01\xd2\x02\x1d\xe3\xe2\xd3\x9b\xcf\x17\xbd\xf1\x42\xe5\x95\xd7\x46\x4b\x74\xbf\xd6\x8a\xff\x72\x7c\x5f\xc4\x64\xd2\xaf\xa2\x08\xa6\x5e\x72\xeb\x50\xa2\x89\x89\x56\x5c\xfa\x86\xad\x0e\x48\xe6\xa0\xe4\x5f\x0d\x2b\x4b\x2e\xcb\xc6\x49\x7f\xdf\xb5\x11\x7e\x91\x5b\x4a\x4f\x79\xc5\xb7\x10\xff\xc1\x31\x53\xa2\xeb\x06\xac\xfd\x19\x74\x95\x91\x7d\x47\xef\xfe\x3b\xb7\x0d\xee\xd5\x01\x5f\xee\x79\x6b\xed\xeb\xb8\x31\xf7\xd3\xf9\x67\x2a\x55\x81\xbe\xc7\xc2\x6a\x05\x91\x3d\x94\x11\xfc\xf4\x13\xf4\x38\x34\x33\x28\x5b\xcb\x28\x2f\x02\x67\xdf\xb6\x68\xcc\xf3\x03\x33\xe0\xbb\x70\x58\x77\x7c\xee\x13\xba\xe5\xb2\x88\x09\xdf\x97\x1e\x94\xce\x99\x98\x58\x93\x07\x8f\x42\xb3\x88\x53\x7a\xf6\xef\x34\x9d\x03\x21\x09\xb5\x5a\x70\x17\x13\x4a\xbc\x11\x17\xa3\xda\x43\xb6\x61\x05\xad\x19\x1e\x38\xd7\x86\x49\xeb\x2b\xec\xca\x0f\xc6\xc9\xb8\x64\x9d\x67\x58\x41\xae\x84\xc0\xdc\x71\x25\x2d\xad\x89\x34\x17\x4a\x62\xec\x2d\x4f\xa8\x56\x96\xfb\xc1\x78\x36\x50\x12\xee\x30\x3f\xbd\x9d\x37\x2f\xc7\x13\xa6\xc6\xcb\x51\x61\x42\x43\x8f\x4d\x1d\xde\x7a\x8c\xf9\x00\x51\xff\xfe\x4e\x49\xe7\xd1\xe0\x0c\xdf\xc7\xc9\xa9\xca\x53\x87\x1a\xbb\xfd\x54\x61\x6b\xd1\x7a\xe2\xea\xee\xe1\x3d\x99\x16\x2a\x8a\x6b\x15\x3f\x04\xd0\x30\xbd\x4b\x1e\x43\x6b\x0d\x0c\x58\x81\xac\x84\xb8\x18\xab\xef\xfe\xc0\x5d\xbf\xbf\x3c\x18\x19\x1f\x38\x7e\x9d\x03\x7a\x18\xcf\xe1\x76\x0e\xc7\x2e\x88\x7c\x7a\x9b\x2a\x0c\xf8\x21\x74\xd8\xb7\x48\xc7\xcc\xd7\xf7\xa5\xeb\x9d\x25\x09\x3d\x30\x11\xf4\xd3\xbd\x2a\x50\x74\x43\xe3\x19\x46\x25\x9b\x85\xf7\x51\xe1\x86\x67\x5c\x3e\xac\x7f\x8f\x8b\x07\x96\x8e\x74\xe3\x61\x53\x17\xd4\xef\x36\x48\x42\x9d\x2a\x4b\x81\x53\x3a\x3a\xa9\x5d\x01\xa9\x45\x4e\x35\xe6\xd6\xc6\xfd\x92\x8c\x5a\x2c\x47\x19\x44\x6c\x63\x95\xa8\x1c\x46\xf3\x3e\x4f\xc1\xad\x16\xec\xe8\x59\x36\x42\xe5\x37\xc3\x71\x81\x5b\x17\x65\x70\x3b\x20\x3b\xa5\xa3\x0c\x8e\xf3\xf1\x26\x3b\xf0\xd2\x2f\x94\x24\xa1\xb9\xe0\xf9\x4d\x3c\xda\x52\xc7\x3d\xbf\xef\x25\x23\xb9\x4e\x2e\x1e\x53\x10\x27\x9f\x9a\x6a\x23\x9f\x43\x8d\x3d\x53\x8d\x6f\x96\x2f\xc8\xc2\xc0\x8b\x69\x4f\x1a\x18\xf5\xac\x68\x68\xe3\x86\x3c\xa2\x2a\x40\x0a\x56\x53\x40\x1c\x28\xba\x9b\xf5\x3e\xa7\xd1\x32\x44\x83\xf4\x7b\x9c\x7e\xd6\xdb\xca\x6e\x7f\xf7\x4d\xc4\xb2\x03\x0e\x27\x1e\xc7\x80\xda\x6e\x63\x12\x80\xd0\xc5\xb0\xbf\xbe\x84\x8e\x5a\x70\x46\x9b\x85\xe7\x9f\x3e\x0f\xda\x28\xa7\x7c\xd4\xa9\x41\x59\xa0\xf1\x3b\x1b\x71\x6c\x3a\x09\x33\x65\x58\x47\x6d\x72\xf1\x2c\x1c\xd6\x9b\xbf\xc7\x91\xf8\x3f\x0d\xd0\xd4\xfc\xe3\x51\x18\x34\xce\x5c\x28\x8b\xc3\x1d\xdf\x48\x53\x7c\x56\x84\x1f\x17\x7d\xc2\xb0\x09\xe1\xd3\x10\x91\x26\x3c\x24\x03\xe2\xc3\x43\xe6\x23\xce\xdd\xcd\x66\x75\xae\xed\x8e\x69\xb4\x34\x57\xd2\x9f\x7d\xd1\xd0\xe6\x34\xbb\x82\xde\x78\x81\x07\x4b\x3f\x84\x32\xc0\x5b\xe7\xb7\xb5\xf5\xa4\xcd\x01\xc3\x66\x0d\x7b\xd8\xd3\x15\x88\xfa\x63\xa5\x75\x1d\xf8\x8e\x79\x7b\x66\x6e\x2a\x9d\x01\x59\x96\xed\x3e\xdf\x28\xc7\xc2\x81\x23\x5a\x3f\x10\x6d\xce\x44\x43\xe3\x7b\x56\x62\x4b\xdf\xa8\xe2\x18\xf9\xa3\x4c\xb9\x5e\x86\x86\xd2\xd0\x43\x93\x89\xd2\x8e\x06\x2e\xaf\x94\x71\xb6\x47\x53\x95\xbb\x27\xa6\xe5\xba\x13\x17\x8f\xed\x0c\xc8\x30\x08\x1d\x0e\x8d\xc6\x6f\xe6\x79\x5e\x09\x66\x2e\xb9\xbc\xb1\x19\x38\x53\xe1\xfc\x01\xe7\xbe\x2f\x66\x10\x75\xd0\xf8\xd0\xae\x06\xf4\xba\xe9\x0c\x88\xa1\x7f\xf4\x69\xed\xda\x91\xc1\x77\xb8\xcd\xe0\x97\xf3\x39\x1c\xfd\x03\xee\x3a\x72\xfc\x1b\xfa\xf1\xaf\xbc\x70\x3b\x3f\x38\x87\x1d\xfa\xa3\xd9\x90\xb1\x09\x49\x06\x9f\x08\xf3\x87\x86\x8d\xff\xcb\xc9\xe7\x07\x8e\x36\x40\x9e\x45\x75\x07\x7c\x37\xb7\xd9\xa0\x48\xdb\xde\x3e\xa4\xfb\xcb\x27\x27\x03\x12\x00\x43\xe6\x27\xc3\xc4\xe0\x76\x71\x4b\x32\x38\xa7\xbf\x4c\x8c\x1e\x89\xb7\x7f\x64\x70\xab\xa4\x5b\x58\xfe\x0d\x3d\xbe\xdf\x9c\x6b\x37\xd0\xdf\xf1\xb8\xf3\xaa\x6b\xbf\xfa\x96\x86\xc3\xde\x09\xd5\xdf\x84\xcb\x51\xbf\x26\x83\xd1\xbd\x08\xf5\xb3\x2d\x3c\x58\x27\x95\xb4\xd7\x9e\x95\x12\x5d\x06\x44\x33\x6b\xf9\xa1\x0b\xb9\xb1\xcb\x64\xf0\xa7\xc7\x39\xac\x33\xea\x06\x33\x20\xa5\x41\x94\x4f\xa8\xdb\x72\x21\x9e\xc9\x4a\x6a\xc5\x8b\x00\x33\x92\xc1\x9b\x27\xd8\xdb\x0c\x2f\xfe\x3c\xcd\xd8\xc9\xcf\x44\x0c\xa7\x11\xd6\xfd\x11\xef\xc7\x42\x69\x96\x73\xe7\x71\x33\x02\x9b\x27\xa6\xbc\x9b\x3d\x83\x91\xa8\xca\xfd\x3e\xa8\x78\x41\x9a\x0d\x16\xcf\x4b\xf2\xd3\x8c\x3f\x9a\xe2\xff\xd7\x0c\xcf\xc6\xbf\x1a\x05\x77\xf3\xc9\x15\xf0\x61\x3f\xd4\x2e\x7f\xc9\xcc\x2f\xad\xb3\x34\x7d\xd5\x9c\xf0\x7e\xfb\xc7\xe5\x2a\xb5\x8e\x39\x9e\xa7\x5f\x6c\xda\x39\xef\xa6\x56\x55\x26\x47\xfa\xc5\xce\xfe\x33\x00\x21\x44\x2b\x9e\x35\x17\x00\x00")
 
 func assetsFilesJsCollectionsSourceJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -776,7 +781,7 @@ func assetsFilesJsCollectionsSourceJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/files/js/collections/source.js", size: 5075, mode: os.FileMode(436), modTime: time.Unix(1638518299, 0)}
+	info := bindataFileInfo{name: "assets/files/js/collections/source.js", size: 5941, mode: os.FileMode(436), modTime: time.Unix(1792350950, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -801,7 +806,7 @@ func assetsFilesJsLibJqueryEditableMinJs() (*asset, error) {
 	return a, nil
 }
 
-var _assetsFilesJsPageJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5a\xdf\x8f\xdb\x36\xf2\x7f\xf7\x5f\x31\x75\xf3\xad\xa4\xc0\x2b\xef\x06\xf8\xbe\x78\x6f\x0b\xa4\xdb\x0d\x9a\xde\xa6\xd9\xbb\xa4\x77\x38\x04\x79\xa0\xa5\xb1\xc5\x98\x26\x05\x92\xb2\xb3\x4d\xfd\xbf\x1f\x86\xd4\x0f\x4a\x96\x37\xc9\x35\x0d\x70\x38\x3d\x2c\x2c\x72\x38\xf3\xe1\x70\x7e\x52\x3b\x7f\x0c\xd7\xaa\xbc\xd7\x7c\x5d\x58\x78\x72\xfe\xe4\x02\x5e\x17\x08\xaf\xf9\xbd\x02\x56\xd9\x42\x69\x33\x81\xc7\x13\x78\x0c\xaf\x0b\x6e\xe0\x95\xaa\x74\x86\x70\xad\x72\x84\x67\x4a\x6f\x81\x1b\x30\xd5\xf2\x1d\x66\x16\xac\x02\x5b\x20\x58\xd4\x5b\x03\x6a\xe5\x5e\x5e\xa8\xdf\xb8\x10\x0c\xee\xaa\xa5\xe0\x19\xb1\xb9\xe5\x19\x4a\x83\x33\xd8\xa5\xf0\x24\x3d\x4f\xe1\xf9\x0a\x18\x64\xaa\xbc\x6f\xd7\xdc\xdd\xc2\x9e\x19\x90\xca\x42\xce\x8d\xd5\x7c\x59\x59\xcc\x61\xcf\x6d\x01\xb6\xe0\x04\x08\x56\x5c\xe0\x0c\xfe\xa5\x2a\xc8\x98\x04\xb5\xb4\x8c\x4b\x50\x12\x81\x59\x28\xac\x2d\xcd\x62\x3e\xdf\x7a\xe1\xa9\xd2\xeb\xf9\x8b\xbb\xdb\xf9\x93\xf4\x7c\x9e\x4e\xe0\xf1\x7c\x32\x99\xcf\xe1\x67\xc5\xa5\xfd\xf9\x15\xa0\xc0\x2d\x4a\x6b\x26\x3b\xa6\x61\xad\x59\x59\xc0\x15\xc8\x4a\x88\xcb\xc9\x3b\x22\x49\x4d\xc1\x4a\x34\x69\xa6\x24\x09\x41\x0d\x57\xf0\xe1\xd0\x4c\xae\x14\xed\x96\x46\x1c\xd3\x3d\xd7\x5c\xae\xfb\x3c\xe9\xa5\x65\x49\x03\x96\xe9\x35\xda\xde\x90\x56\x95\x75\x9c\xbb\xa1\x5c\xb3\xf5\xcd\x0e\x65\x9f\x90\x65\x96\xef\xf0\x96\xcb\xcd\xc8\xf0\x8f\x47\x4b\x08\x93\xdf\x53\x0d\x09\x32\x25\x04\x66\x96\x2b\xe9\xd1\x05\xef\xb4\x8d\x09\x00\x80\x71\xa7\xbc\x70\x3c\x66\x6e\x64\x53\x2d\x51\x4b\xb4\x68\xc2\xd1\x56\x25\xe1\xa0\xe0\x72\xd3\xbc\x1f\x26\x93\x95\xd2\x10\xf7\x05\x01\x97\xa1\xd8\xa4\x96\x1a\x0c\xbd\xe9\x7e\xbf\xa5\xbd\xe0\x1e\xae\xdb\x91\xb8\x9b\x9c\x41\x8e\x2b\x56\x09\xfb\xd4\x5a\xdd\x5b\x95\x5c\x3e\xc4\x33\x15\x8a\xe5\x71\x72\x49\x00\x09\x5b\xc9\x4b\x14\x5c\x62\xa0\xb7\xc7\xce\xe8\x9f\x4b\x37\x8c\x39\xb7\x74\xae\x6a\x05\x19\x6a\x67\x6b\x05\xb2\x9c\xcb\xb5\x99\x5b\xb6\x14\x08\x19\x0a\x61\x9c\x6d\xad\x2a\xe9\x77\x49\x8b\x68\xee\xa6\x36\x86\xb8\xd9\xe8\xa3\x38\x4a\x9b\xc9\x28\x49\x91\x65\x45\x0c\xcd\xb2\x96\xca\x53\x92\xbd\x27\x2d\x75\xdc\x4d\xd1\x83\x74\xd6\x0b\x88\xf2\xa5\xc8\x04\xcf\x36\xd1\xac\x37\x6d\x55\x95\x15\x0b\xb0\xba\xc2\xfe\x04\xed\xe9\x07\x8d\x6c\x63\x16\xb0\x62\xc2\xe0\x70\xdd\x7a\x2d\xf0\x99\x92\xf6\x15\xff\x0d\x47\x49\x32\xa1\x0c\xbe\x94\x37\xd2\xd2\xe1\x1f\x4b\xc0\x6d\x69\xef\x5f\xa0\x31\x6c\x8d\x0b\x98\xfe\xa8\x2a\xa7\x24\x02\x49\x71\x82\xf6\x33\x1d\x08\xe5\xf2\xfe\xc5\xf5\xcd\xa8\x34\x22\x57\xfa\x95\xbd\x17\x08\x0b\xf8\x70\x18\x60\x61\x42\x2c\x59\xb6\x59\x74\x3a\xcc\x99\x65\xa1\x1e\x9b\x87\xaf\xc0\xcd\xa5\x8f\x50\xbc\x39\x7f\x9b\xaa\xd5\xca\xa0\xbd\x63\x1a\xa5\x4d\x79\x0e\x57\x57\x30\x6d\x6c\x61\x3a\xc6\xc0\xc9\xd3\xc8\x2c\xde\xd5\x64\x2d\xbf\xd4\xe2\x7b\x1b\x27\xb5\xe1\x85\xcf\x01\x50\x18\x3c\x12\x6e\xd9\xfa\x17\xb6\xc5\xd4\xaa\x5b\xb5\x47\x7d\xcd\x0c\xc6\x89\x83\x60\xf3\x93\xc2\xc9\x5a\x09\x28\x3c\x0a\x79\x25\xa9\x3b\x10\x63\xe3\xa8\xb1\x2a\x66\xad\x8e\x23\x9e\x47\x49\x6a\x4a\xc1\x6d\x3c\x3d\x9b\x26\xa9\x11\x3c\xc3\xf8\x22\x49\x29\x7a\xc5\xd1\x59\x34\x02\xb7\x11\xb3\xc1\xfb\x63\x39\xa5\xc6\x5d\x9c\xd4\x7b\x3d\xbd\x96\x22\x83\x8f\x43\x83\xf5\x0f\x2d\x2c\x2b\x1b\xf3\x7c\xe6\x63\x07\x89\x9f\x35\x7c\xc6\x94\x3a\x19\x7f\x3b\xd4\xb4\x07\xef\xdd\xb5\x1f\xdf\xa9\xb2\x12\xcc\xa2\x71\x19\x66\x59\x65\x1b\xb4\x50\xb2\x35\xfa\xac\xc2\x40\x70\x63\xc9\xbd\xd9\x8e\x71\xe1\x3c\xda\x13\x0d\x7c\x9a\xe2\xc6\x0f\x6e\xe2\xb5\xf3\xc7\x64\xe2\x8f\x89\xf6\xec\xa3\xa6\xdb\x72\xf4\xad\xc5\x6d\x49\x02\xa3\x24\x2d\xec\x56\x34\x5b\x76\xe1\xbf\x9e\x82\x2b\xf8\x89\xc9\x5c\xe0\x92\x69\x4a\x2f\xdb\x92\x0b\x8c\x3d\x97\xe4\x72\xe2\x43\x45\xba\x46\x1b\x4f\xe7\xac\xe4\xf3\xdd\xc5\xdc\x63\x9a\xce\x3e\x1c\x66\x7d\x5b\xef\x50\x90\x34\xfa\x7d\xd5\xca\x89\x3f\xd0\xe6\x16\xe0\xce\x61\xeb\x7d\xb2\xd1\x12\x41\xa5\xf1\x06\x26\xfd\x71\x53\xb5\xfa\x1a\xfd\x3d\xe3\x82\xf2\x13\x29\x8f\x95\xa5\xe0\x19\x73\xea\x30\x3c\x27\xf4\x7d\x1d\xad\x1c\xed\xd3\x8e\xac\x8b\x7c\x04\xd0\x4f\xd7\x6a\x6a\x9c\xed\xcc\x8f\x46\x49\xba\x63\x82\xec\x2b\xf4\x89\x06\xea\xb4\x23\x67\x65\x69\xce\x68\x5b\xd3\x24\xcd\x0a\x2e\x72\x8d\x32\xae\x43\xe9\x68\x24\x25\xe7\x6b\xa2\xa9\xb7\xc1\xbe\x8c\x94\xcb\x4c\x54\x39\x9a\xd8\x23\x49\x86\x0e\xd8\x2c\x36\x85\xda\x87\x06\x5c\x3b\xf7\x38\x71\xc1\x73\xec\x11\x7f\xd4\x34\x47\xb4\x3b\x34\xd1\x5c\x65\x1b\xd4\x5d\xf2\xf5\xf5\x90\x56\x5b\x58\x72\xd5\x8d\xc2\x19\x18\x44\x58\x2b\xc8\xa8\x5a\xa3\x3c\x9c\xa3\x65\x5c\x98\xf4\xd8\xa6\xfb\xa7\x75\xca\xa8\x03\x70\xc6\x96\xe2\xcb\xda\x76\x87\xfc\xa4\x7d\xff\x67\x36\xee\x0f\xc4\x59\x76\xaa\x91\xe5\xf7\xe3\x16\xd2\xb8\x43\x68\x63\xed\x6e\x8f\xfc\xe3\x38\xd8\xb8\x1f\xf3\x39\x18\xcb\xb4\x85\xc2\xed\x5e\x7b\xb5\x6b\xb6\x5e\x53\xf9\x10\x1e\xae\x55\xb0\xd6\x3c\x77\xab\xf6\x8c\xdb\x67\x4a\xdf\x88\x81\x78\x6f\xe2\xd1\x6c\xbc\x38\xf8\xf5\xf9\x86\xdb\xb4\xb2\x5c\xa4\x4a\x9e\x5a\x19\x39\x38\xd1\x0c\x62\x4c\xe0\xea\xfb\xc1\x7e\x07\x95\x68\x38\x55\x57\xad\x98\x7a\xa3\x79\x73\xf1\xb6\x3f\x9f\xab\xac\xa2\xb2\x86\x0e\xb1\xae\x70\x7e\xb8\x7f\x9e\xc7\x51\xc9\x4a\xd4\x67\x2d\x98\x42\x89\xdc\x39\x36\xcb\x73\x57\x9b\xde\x72\x63\x51\xa2\x8e\xa3\x92\x2a\x68\xd4\x5b\xb5\xc3\x68\x06\x4a\xfe\x58\xeb\x69\xa0\xe0\xf6\xc5\x29\x57\x95\xad\x6e\xad\x02\x66\x2d\xcb\x8a\x93\x8a\xfd\x1c\x35\xa9\xf2\x94\x96\x28\x76\x7c\xe3\x55\x35\x96\x96\x35\xda\x4a\xcb\xcb\x41\x3e\xfa\x8a\xca\xea\x89\x72\xe5\x2c\xd1\xc2\x55\x5b\xd6\x92\xd8\xd7\x9a\x49\x43\xdd\xca\x1d\x4d\x0e\x53\xb0\xcb\xd9\x28\x04\x5c\x85\x25\x73\xd7\xf3\x50\x7d\x21\x31\x8e\x7c\xec\xa1\xe6\x2b\x4a\xd2\x52\x19\xee\xac\xf2\x48\x25\x0e\x40\xfa\x7e\x56\xff\xb8\xef\x11\xd4\xb5\xc9\xd1\xa2\x28\x15\x6c\x89\x62\x4e\xe1\x39\x9a\x39\x03\x74\xa1\xfa\xda\xd7\x00\xa9\xd5\x7c\x1b\x27\x7d\x56\x83\xcd\xd3\x16\x1c\x77\xd7\x32\x9a\x54\xb2\x2d\x85\xa2\x13\xac\x2e\x1f\x5c\x6b\x32\xcd\x4b\xd2\x22\x55\xb7\x0f\x93\x66\x95\xb1\x8a\xbc\xc5\xd5\xae\x7d\xda\xf6\x0c\xb2\x02\xb3\xcd\xcd\x76\x89\x79\x4c\xeb\x6b\xdd\x0c\x77\xf0\x71\x8f\xec\x4f\x8c\x97\x3b\x3f\xa1\x28\x29\xf8\x34\x21\xde\x2a\xc8\x04\x52\x53\xa9\xf6\x12\xd8\x8a\x12\x70\xae\x55\x59\x06\xcd\x6a\x3f\x29\x38\xf2\xa7\x42\x3c\x75\x8d\x65\x9b\x12\x46\xe0\x1d\xc1\x1a\x69\x51\xbb\xe1\xe3\x16\xd5\xa1\x26\xd0\x67\x7f\xee\x43\x22\x6e\x64\xee\x6e\x19\x94\x12\x94\x58\xc3\x08\xff\x75\x10\xcc\xdb\x23\x7a\x85\xd6\x00\xa3\x5e\xd8\xa2\xde\x31\x01\x96\x6f\x7d\x4c\xa3\x6c\xe0\xf2\x06\xf3\x89\xae\xe9\xdc\xad\x82\x25\x66\x6a\x8b\xe0\xb2\x58\xff\xbc\xba\x14\x62\x90\xdc\x57\xe9\x59\xdb\x18\x85\xd5\x57\x49\xde\x4d\xd5\x97\x41\xfb\xbc\x16\x3d\x9e\x0f\x1f\xbd\x7b\xe9\xef\x75\xae\xe0\xdd\xdf\x2a\xd4\xf7\x2d\xe7\xc0\x71\x5c\x59\x55\x13\xa6\x02\xe5\xda\x16\xf0\x17\xb8\x18\x86\xc9\x61\x88\xec\xca\x75\x67\x67\x2d\x10\x8f\x2e\xe0\xdf\x6c\xa1\x15\xd2\x98\xfb\xec\xe2\xfc\xbc\x67\xf2\xbf\x96\x39\xd5\x1d\x54\x43\x65\x95\xa6\x86\xce\xb7\xc7\xf3\xda\x64\xf7\x05\xca\x36\x13\xf7\x75\xd7\x05\x53\x0a\xff\x1e\x79\x78\xfb\x52\x7b\x74\x6b\xfb\x98\xfa\x9f\xa1\xf8\x5b\xc5\x72\x27\x7c\x8b\xb2\x02\x32\xae\x33\xc8\xf9\x6a\x85\x0e\x09\x9d\x26\xd5\xa6\xae\xe5\x38\xae\xbe\x5e\xa0\xac\x7a\xb7\x03\x75\x80\x5d\x71\x99\xc7\x91\xe0\xf0\x3d\x50\x85\xbe\xa4\xd7\xa9\x6b\xa2\xa7\x27\x6a\x02\x5f\x83\xbd\xf7\x8d\xd7\x03\xd5\xee\x30\x00\x9a\x3d\xb7\x59\x01\xb1\xbf\x87\x4a\x05\x33\xf6\xef\x68\x94\xd8\x61\x1e\x27\xd4\xac\x56\x5a\x0c\x0f\x35\x63\x06\x83\x76\x79\x71\x14\xd0\x1b\xa6\x84\xe0\x64\x33\xed\x98\x48\xdc\x8f\xac\x6f\x9e\x6b\xa5\x36\x1c\x4d\xaa\x91\xb2\x5f\x1c\x35\x22\x4f\x35\xaf\xf4\xec\xb9\xcc\xd5\x3e\x15\xca\xbb\x77\xca\x8c\xe1\x6b\x19\x47\xf3\x4f\x59\xbc\xd4\xc8\x36\xe3\xd3\x1e\xaf\x2a\x51\x3e\x00\x98\xa6\x9b\xfb\x01\x2a\xef\xcb\x53\x1d\xef\x03\xc2\x0e\x47\x23\x23\x84\x1e\x4d\xdd\xa9\x7e\xc5\x13\xf0\x12\x1f\x52\xa1\xaf\xba\xb6\x2a\x67\x54\xd4\xfa\x2b\x93\xb3\x66\xd9\x51\x13\xf5\x89\x3a\x81\x4f\x3d\x80\x3f\xae\x53\x93\xb1\x31\x01\x5f\x4a\xa1\xff\x9d\xea\xe9\xda\x57\x08\xae\x31\xff\x97\x02\x15\xca\x1d\xd7\x4a\x52\x3e\xfe\x88\xff\xdf\x74\x94\x3e\x04\x74\xc5\xb8\x66\x65\xf1\x47\x4e\x37\xd3\x98\xa3\xb4\x9c\x89\x31\x9f\x0f\x41\x5c\x77\x94\x5f\x06\xc4\x67\x98\xc8\x61\x12\x64\xd8\x31\x85\xec\x0b\x66\x57\x4a\x37\x0a\x6f\xa1\xb1\xb2\x6c\x4a\x9e\x2b\xa8\x89\x2e\xfb\x24\xe4\x20\x01\xbf\x78\x44\xdc\xd1\xd6\xff\xa0\xb8\x80\x5f\xdc\x2b\x3c\x9e\xb1\x0d\xba\xcf\x51\xbd\x9e\x9f\x20\x50\x71\xdd\xf0\x30\xfe\x86\xe6\xaf\xff\xa0\x16\x56\x0f\x6a\x80\x91\x7c\xd1\x15\x03\xdf\xd2\x6c\xdb\x22\xc2\xf7\x90\xf3\x9d\xff\x9b\xd6\xb7\xa3\xcd\xe5\xc4\x74\x1a\xdc\xc4\xf4\x2e\x6e\x88\x45\xc3\xe1\x8b\x5f\xdc\x50\xa8\x6c\xd3\x6a\xe8\xef\xc3\x5b\xf8\xf6\xea\x26\xbc\xb7\xe1\xf9\xc2\xc7\xab\x00\xdf\x74\x06\xc7\xd7\x39\x6f\xa2\x0d\xde\x9b\xe8\x6d\xff\x5a\xe7\x33\xd4\x13\xdc\xdd\x34\x3c\xfa\x61\xb8\xc7\x29\x08\xc3\xf5\x47\x22\x63\x99\xad\xcc\x35\xb5\x71\x6d\xf3\xd2\x7d\xec\x79\x8f\x59\x65\xb1\x77\xd3\x19\x7c\x55\x6a\x12\x28\x29\xee\xa8\x7e\xa1\x22\xba\x19\xbb\x71\x7c\xa8\x14\x4d\xe0\xf7\xdf\xe1\x9b\x66\x3c\xd4\x63\x58\x4d\x1f\x26\x3d\xcb\x1f\x63\xf3\xa1\x15\xf2\x50\xd0\x84\x6f\x4e\x7d\xfb\xe8\x97\xe8\x81\x16\x82\x83\xe8\x43\xfa\x1c\x05\x84\xe8\xbd\x03\xdd\x68\xad\x74\x8c\x5a\x87\xd0\x51\x6b\xf8\xee\x3b\x40\xad\x53\x8d\xa6\x54\xd2\xe0\xcf\xaf\x5e\xfe\x12\xe2\xc4\x66\x5d\x8f\xa2\x31\x9f\xe6\xe4\x7b\x02\x73\x6e\x4a\xc1\x9a\x0f\x55\x71\x1d\x07\x1a\xa6\x7b\x9e\xdb\xc2\x79\x90\xaf\x26\x93\xd4\x8d\xc4\xed\xb7\xc5\xfa\x72\xe4\x9f\x35\x5d\xbd\xbc\x4f\x25\x70\x65\x5f\x30\xbd\xe6\x12\xae\x20\x76\x53\x67\xfd\x95\xc9\xfc\x49\xd7\x49\xd3\xfa\xcc\x98\xe0\x43\x5f\x8d\x71\x01\xd1\x52\xa8\xde\x37\x3e\x55\xb2\x8c\x5b\x9a\xb9\x38\x3f\xff\xbf\x60\x22\xda\x3a\x81\x67\x24\x3b\x5a\x04\x10\x66\x3d\xeb\x6f\xe4\xad\x58\x8e\x2f\x2b\x1b\xff\xff\xf9\xf9\xf9\x0c\xe2\xc1\x0d\x58\x4b\xe5\x72\x7c\x79\x14\x6c\x4e\x23\xef\xa3\x97\x4a\x62\x80\xf1\x30\xb2\xdc\x97\x97\xd7\x82\x19\x13\x47\xee\x38\xa3\x64\x34\x9f\x98\x2a\xcb\xd0\x98\xb8\x39\xdb\xc0\xe9\xba\x58\x4e\xc1\xa1\x26\x88\x06\x3b\x0e\x05\x0d\xa6\x58\x9e\xd7\x00\x6a\x21\xc3\xb5\x03\x3d\xf4\xcd\xeb\x84\x41\xf5\xd1\xef\x99\xa6\xdc\xf0\xe7\xa2\xaf\x85\x7c\x79\xf4\xde\xcd\xfe\x54\xec\xf5\xd1\x7f\x21\xe4\x61\x9e\xa6\x10\xef\xef\x05\xc0\x2a\xd7\x7b\x03\x6b\xc3\xd4\xe9\xa4\xdc\x86\xe7\x5f\xd8\x16\x9b\xbb\xb0\x8f\xb5\xa5\xcd\xbc\xe9\x85\xbc\x19\xf4\x78\x5d\xd6\x91\xe6\x13\xba\xd5\xc3\x64\xf2\x28\x6e\x6e\x92\x4f\x7e\xca\xa8\x63\xbc\xf6\xf1\xbd\x8d\x42\xc3\xef\x96\xb5\x6e\x8f\xfe\x45\xa1\x23\xf7\x97\x12\x97\x13\xf2\xbd\x7f\x07\x00\x00\xff\xff\x4f\xb1\x4f\x6f\x25\x24\x00\x00")
+var _assetsFilesJsPageJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5a\x6f\x8f\xdb\x36\xd2\x7f\xef\x4f\x31\x75\xf7\xa9\xa8\xc0\x2b\xef\x06\x78\xde\xd8\xb7\x05\xd2\xed\x06\x4d\x6f\xd3\xec\xdd\xa6\x77\x38\x04\x79\x41\x4b\x63\x9b\x31\x4d\x0a\x24\xb5\xae\x9b\xfa\xbb\x1f\x86\x12\x65\xca\x96\x37\xc9\x35\x09\x70\x38\x2b\x68\x6d\x72\x38\xf3\xe3\x70\x38\xff\xb4\xe3\x27\x70\xad\xcb\xad\x11\x8b\xa5\x83\xa7\x17\x4f\x2f\xe1\xf5\x12\xe1\xb5\xd8\x6a\xe0\x95\x5b\x6a\x63\x07\xf0\x64\x00\x4f\xe0\xf5\x52\x58\xb8\xd7\x95\xc9\x11\xae\x75\x81\xf0\x5c\x9b\x35\x08\x0b\xb6\x9a\xbd\xc3\xdc\x81\xd3\xe0\x96\x08\x0e\xcd\xda\x82\x9e\xfb\x1f\x2f\xf5\xef\x42\x4a\x0e\x77\xd5\x4c\x8a\x9c\xd8\xdc\x8a\x1c\x95\xc5\x11\x3c\x64\xf0\x34\xbb\xc8\xe0\xc5\x1c\x38\xe4\xba\xdc\xb6\x6b\xee\x6e\x61\xc3\x2d\x28\xed\xa0\x10\xd6\x19\x31\xab\x1c\x16\xb0\x11\x6e\x09\x6e\x29\x08\x10\xcc\x85\xc4\x11\xfc\x4b\x57\x90\x73\x05\x7a\xe6\xb8\x50\xa0\x15\x02\x77\xb0\x74\xae\xb4\x93\xf1\x78\x5d\x0b\xcf\xb4\x59\x8c\x5f\xde\xdd\x8e\x9f\x66\x17\xe3\x6c\x00\x4f\xc6\x83\xc1\x78\x0c\x3f\x6b\xa1\xdc\xcf\xf7\x80\x12\xd7\xa8\x9c\x1d\x3c\x70\x03\x0b\xc3\xcb\x25\x5c\x81\xaa\xa4\x9c\x0e\xde\x11\x49\x66\x97\xbc\x44\x9b\xe5\x5a\x91\x10\x34\x70\x05\xef\x77\x61\x72\xae\x69\xb7\x34\xe2\x99\x6e\x84\x11\x6a\xd1\xe5\x49\x3f\x5a\x96\x34\xe0\xb8\x59\xa0\xeb\x0c\x19\x5d\x39\x34\x9d\xa1\xc2\xf0\xc5\xcd\x03\xaa\x2e\x21\xcf\x9d\x78\xc0\x5b\xa1\x56\x3d\xc3\x3f\x1e\x2d\x21\x4c\xf5\x9e\x1a\x48\x90\x6b\x29\x31\x77\x42\xab\x1a\x5d\xf4\x9b\x36\x36\x00\x00\xb0\xfe\x94\x27\x9e\xc7\xc8\x8f\xac\xaa\x19\x1a\x85\x0e\x6d\x3c\xda\xaa\x24\x1e\x94\x42\xad\xc2\xef\xdd\x60\x30\xd7\x06\x58\x57\x10\x08\x15\x8b\x4d\x1b\xa9\xd1\xd0\x9b\xfd\xf7\xb7\xb4\x17\xdc\xc0\x75\x3b\xc2\xf6\x93\x23\x28\x70\xce\x2b\xe9\x9e\x39\x67\x3a\xab\xd2\xe9\x63\x3c\x33\xa9\x79\xc1\xd2\xe9\x60\x37\xf0\x4a\x28\x45\x89\x52\x28\x8c\xf4\xf6\xc4\x1b\xfd\x0b\xe5\x87\xb1\x10\x8e\xce\x55\xcf\x21\x47\x43\x66\x00\x4b\xe4\x85\x50\x0b\x3b\x76\x7c\x26\x11\x72\x94\x92\x0c\x73\x3c\x98\x57\xca\x0b\xf1\x8b\x68\xee\xa6\x31\x06\x16\x36\x7a\xc6\x92\x2c\x4c\x26\x69\x86\x3c\x5f\x32\x08\xcb\x5a\x2a\xfa\x77\xc6\xc8\xde\xd3\x96\x9a\xed\xa7\xe8\x41\x3a\xeb\x09\x24\xc5\x4c\xe6\x52\xe4\xab\x64\xd4\x99\x76\xba\xca\x97\x13\x70\xa6\xc2\xee\x04\xed\xe9\x07\x83\x7c\x65\x27\x30\xe7\xd2\x1e\x4c\x3b\xbd\x58\x48\x7c\xae\x95\xbb\x17\xbf\x63\x2f\x49\x2e\xb5\xc5\x57\xea\x46\x39\x34\x7d\x12\x70\x5d\xba\xed\x4b\xb4\x96\x2f\x70\x02\xc3\x1f\x75\xe5\x95\x44\x20\xc9\x4f\xd0\x7e\x86\xdd\x15\x4e\xa8\xed\xcb\xeb\x9b\x5e\x69\x44\xae\xcd\xbd\xdb\x4a\x84\x09\xbc\xdf\x75\x67\x73\x2e\xe5\x8c\xe7\xab\xc9\x5e\x87\x05\x77\x3c\xd6\x63\xf8\x88\x39\xf8\xb9\xec\x0c\xe5\x9b\x8b\xb7\x99\x9e\xcf\x2d\xba\x3b\x6e\x50\xb9\x4c\x14\x70\x75\x05\xc3\x60\x0b\xc3\x3e\x06\xf4\xe4\x06\xb9\xc3\xbb\x86\xac\xe5\x97\x39\xfc\xcd\xb1\xb4\x31\xbc\xf8\xd9\x01\x4a\x8b\x47\xc2\x1d\x5f\xfc\xc2\xd7\x98\x39\x7d\xab\x37\x68\xae\xb9\x45\x96\x7a\x08\xae\x38\x29\x9c\xac\x95\x80\xc2\x59\xcc\x2b\xcd\xfc\x81\x58\xc7\x92\x60\x55\xdc\x39\xc3\x12\x51\x24\x69\x66\x4b\x29\x1c\x1b\x9e\x0f\xd3\xcc\x4a\x91\x23\xbb\x4c\x33\xf2\x5e\x2c\x39\x4f\xd2\xe9\x49\x31\x2b\xdc\x1e\xcb\x29\x0d\x3e\xb0\xb4\xd9\xeb\xe9\xb5\xe4\x19\xc8\xdb\x1c\xad\x7f\x6c\x61\x59\x39\x26\x8a\x91\xbf\x82\x23\x58\xe1\x76\x14\xf8\xf4\x2c\xd8\x0d\xfa\x7f\xed\x1a\x5a\xfa\xff\xae\xbd\xc7\x77\xba\xac\x24\x77\x68\x7d\x54\x9a\x55\xf9\x0a\x1d\x94\x7c\x81\x75\x54\xe1\x20\x85\x75\x14\x80\xf8\x03\x17\x92\x54\xd8\x10\x1d\xdc\x69\xf2\x1b\x3f\xf8\x89\xd7\x44\xc4\xd2\x41\x6d\x23\xb4\xe7\xda\x6b\x7a\x95\x25\xdf\x3a\x5c\x97\x24\x30\x49\xb3\xa5\x5b\xcb\xb0\x65\xa2\x0b\x53\x70\x05\x3f\x71\x55\x48\x9c\x71\x43\xe1\x65\x5d\x0a\x89\xac\xe6\x92\x4e\x07\x9e\xef\x59\xb6\x40\xc7\x86\x63\x5e\x8a\xf1\xc3\xe5\xb8\xc6\x34\x1c\xbd\xdf\x8d\xba\xb6\xbe\x47\x41\xd2\xe8\xfb\x55\x2b\x87\xbd\xa7\xcd\x4d\xc0\x9f\xc3\xba\xbe\x93\x41\x4b\x67\x2c\xf9\x96\xc6\x03\x4c\x5a\xed\xa7\x1a\xf5\x05\xfd\x3d\x17\x92\xe2\x13\x29\x8f\x97\xa5\x14\x39\x27\xd9\x60\x45\x41\xe8\xbb\x3a\x9a\x7b\xda\x67\x7b\x32\xdb\xfa\x34\x02\x58\x4f\x37\x6a\x0a\x97\xed\xbc\x1e\x4d\xd2\xec\x81\x4b\x96\x76\xef\x44\x80\x3a\xdc\x93\xf3\xb2\xb4\xe7\xb4\xad\x61\x9a\xe5\x4b\x21\x0b\x83\x8a\x35\xae\xb4\xd5\x4c\x7c\x87\xe8\xf2\x05\x6f\x5a\xdb\x60\x57\x46\x26\x54\x2e\xab\x02\x2d\xab\x91\xa4\xf1\xe2\xd8\x15\xdb\xa5\xde\x04\x48\xd1\xe5\xee\x27\x5e\x8a\xa2\xc5\xbf\x37\xd3\xc7\x4c\xb3\x47\xbb\x87\x26\x5a\xe8\x7c\x85\x66\x1f\x7c\xc9\x44\x61\x6e\xf4\x1a\x66\x42\xef\x47\xe1\x1c\x2c\x22\x2c\x34\xe4\x94\xad\x51\x1c\x2e\xd0\x71\x21\x6d\x76\x6c\xd3\xdd\xd3\x3a\x65\xd4\x11\x38\xeb\x4a\xf9\x79\x6d\x7b\x8f\xfc\xa4\x7d\xff\x67\x36\x4e\xcf\x19\xa3\x35\x69\x66\x90\x17\xdb\x7e\x0b\x09\xd7\x21\xb6\xb1\x76\xb7\x47\xf7\xa3\x39\xcf\xe6\xfb\x2e\xec\x69\x3c\x06\xeb\xb8\x71\xb0\xf4\x37\xdb\xd4\x6a\x37\x7c\xb1\xa0\xf4\x21\xe2\x48\x51\x70\x61\x44\xe1\x57\x6d\xb8\x70\xcf\xb5\xb9\x91\x07\xe2\x6b\x13\x4f\x46\xfd\xc9\xc1\xaf\x2f\x56\xc2\x65\x95\x13\x32\xd3\xea\xd4\xca\xc4\xc3\x49\x46\xc0\x30\x85\xab\xef\x0f\xf6\x7b\x90\x89\x86\x61\x7a\x9a\xac\x15\xb3\xda\x68\xde\x5c\xbe\xed\xce\x17\x3a\xaf\x28\xad\xa1\x43\x6c\x32\x9c\x1f\xb6\x2f\x0a\x96\x94\xbc\x44\x73\xde\x82\x59\x6a\x59\xf8\x8b\xcd\x8b\xc2\xa7\xb3\xb7\xc2\x3a\x54\x68\x58\x52\x52\x7a\x8d\x66\xad\x1f\x30\x19\x81\x56\x3f\x36\x7a\x3a\x50\x70\xfb\xc3\x2b\x57\x97\xad\x6e\x9d\x06\xee\x1c\xcf\x97\x27\x15\xfb\x29\x6a\xd2\xe5\x29\x2d\x91\xef\xf8\xa6\x56\xd5\xa1\xc1\xd0\x63\xd0\x55\x46\x75\xb5\xb3\x1b\x7c\x45\x65\x75\x44\xd1\xfd\xf0\x8a\x85\x2b\x08\x7c\x49\xec\x6b\xc3\x95\xa5\x6a\xe5\x8e\x26\x63\xa7\x14\x56\x51\x0a\x0b\x57\x71\xca\xbc\xaf\x79\x28\xbf\x50\xc8\x92\xda\xf7\x50\xf1\x95\xa4\x59\xa9\xad\x20\x95\xb3\x0e\x2b\xfa\xe7\x01\x64\xbf\x8d\x9a\x2f\xdb\x0e\x41\x93\x9b\x1c\x2d\x4a\x32\xc9\x67\x28\xc7\xe4\x9e\x93\x91\xaf\xa1\x7c\x9e\x71\x5d\xe7\x00\x99\x33\x62\xcd\xd2\x2e\xab\x83\xcd\xd3\x16\x3c\x77\x5f\x32\xda\x4c\xf1\x35\xb9\xa2\x13\xac\xa6\x8f\xae\xb5\xb9\x11\x25\x65\x30\x94\xdd\x3e\x4e\x9a\x57\xd6\xe9\x35\x5c\xd5\xb9\x6b\x97\xb6\x3d\x83\x7c\x89\xf9\xea\x66\x3d\xc3\x82\xd1\xfa\x46\x37\x87\x3b\xf8\xf0\x8d\xec\x4e\x04\x27\xd7\x8d\x29\x3f\xa1\x2c\xd1\xb4\x6e\x83\xee\x44\x2e\x91\x8a\x4a\xbd\x51\xc0\xe7\x14\xcb\x0b\xa3\xcb\x32\x2a\x56\xbb\x41\xc1\x93\x3f\x93\xf2\x99\x2f\x2c\xdb\x90\xd0\x03\xef\x08\x56\x4f\x89\xba\x1f\x3e\x2e\x51\x3d\x6a\x02\x7d\xfe\x65\x3f\x24\xe2\x46\x15\x14\x41\x9d\xd6\x92\x02\x6b\xe4\x36\xec\xd7\x41\x30\x6e\x8f\xe8\x1e\x9d\x05\xae\xc0\xbb\xc0\x07\x2e\xc1\x89\x75\xed\xd3\x28\x1a\xf8\xb8\xc1\x81\xc2\x4d\x5b\xb9\x3b\x0d\x33\xcc\xf5\x1a\xc1\x47\xb1\xee\x79\xed\x43\x88\x45\xba\xbe\xda\x8c\xda\xc2\x28\x38\x2d\xba\xe4\x25\xdd\x6e\x6a\x34\x58\x74\x2f\x1a\xd1\xfd\xf1\xf0\xec\xdd\xab\xba\xaf\x73\x05\xef\xfe\x56\xa1\xd9\xb6\x9c\x23\xdf\x41\xae\x31\x10\x66\x12\xd5\xc2\x2d\xe1\x2f\x70\x19\xf3\xe9\x73\x91\xfb\x74\xdd\xdb\x59\x0b\xa4\x46\x17\xf1\x0f\x5b\x68\x85\x34\x73\xbb\xd1\xe5\xc5\x45\xc7\xe4\x7f\x2d\x0b\xca\x3b\x28\x87\xca\x2b\x43\x05\x5d\x5d\x1e\x8f\x1b\x93\xdd\x2c\x51\xb5\x91\xb8\xab\xbb\xbd\x33\xa5\x20\x59\x23\x8f\xbb\x2f\x38\xed\xda\x3e\x66\x35\xcf\x58\xfc\xad\xe6\x85\xaf\x2d\xd6\xa8\x2a\x20\xe3\x3a\x87\x42\xcc\xe7\xe8\x91\xd0\x69\x52\x6e\xea\x4b\x8e\xae\x70\xaa\x28\x5e\xa2\xaa\x5a\xdd\x53\x77\xa0\x71\xb0\x73\xa1\x0a\x96\x48\x01\xdf\x03\x4f\xd2\x6c\x46\x3f\x87\xbe\xd2\x1f\x9e\xc8\x09\xe8\x88\xc9\x67\xfa\xf4\xfa\x91\x6c\xf7\xd0\x01\xda\x8d\x70\xf9\x12\x58\xdd\x87\xca\x24\xb7\xee\xef\x68\xb5\x7c\xc0\x82\xa5\x54\x29\x57\x46\xc6\x72\xe8\xc9\xb9\xc5\xa8\x5c\x9e\x74\x26\x63\xa6\x84\xe0\x70\x71\xf8\xd4\x4c\x14\x6e\x7a\xd6\x87\xcf\xb5\xd6\x2b\x81\x36\x33\x48\xd1\x8f\x25\x41\xe4\xa9\xe2\x95\x9e\x8d\x50\x85\xde\x64\x52\xd7\x59\x41\xc6\xad\x15\x0b\xc5\x92\xf1\xc7\x2c\x9e\x51\x6f\xa4\x7f\xba\xc6\xab\x4b\x54\x8f\x00\xa6\xe9\xd0\x1f\xa0\xf4\xbe\x8c\x55\xfd\x91\xc2\x76\x83\x83\x81\x3e\xc2\x1a\x4d\x53\xa9\x7e\xc5\x13\xa8\x25\x3e\xa6\xc2\x3a\xeb\x5a\xeb\x82\x53\x52\x5b\xb7\x4c\xce\xc3\xb2\xa3\x22\xea\x23\xb6\xfa\x49\x07\xf0\xe7\x75\x6a\x73\xae\xbe\xa0\x42\xff\x3b\xd5\xb3\x2f\x5f\x83\xa3\xa2\xce\xdc\xff\x92\xa3\x42\xf5\x20\x8c\x56\x14\x8f\x1f\xd1\x2f\x59\xe7\xcd\x9e\xb2\x76\x01\x41\x7e\xe6\xfb\xf1\xd1\x96\x3e\xa0\xf4\xf8\xa9\x41\xe4\x06\x0b\x54\x4e\x70\x69\x3f\x00\xe2\x7a\x4f\xf9\xb9\x41\x58\xbe\x2e\x25\x3e\x06\xa0\x95\xc5\xcb\x32\xe4\x30\x71\x4d\x42\x08\xa6\x1f\x5e\x4d\x97\xe1\xde\x0b\xbb\xe3\xce\xa1\x51\xb1\x39\x7c\x24\xec\x4f\xb0\x6c\x7a\x65\xd1\x18\x6c\xff\x39\x6e\x96\xdc\xcd\xb5\x09\x76\xd2\xbf\xcb\x86\x68\xda\x25\xa1\xad\x44\xfc\x58\x8f\xb8\xa3\x13\xfb\x93\xe2\x22\x7e\x2c\x8d\x13\x96\xe7\x7c\x85\xfe\x2d\x5a\xa7\x55\x41\x10\xa8\x26\x08\x3c\x6c\xdd\x58\xfa\xeb\x3f\xa8\xf2\x36\x78\x90\x37\x1d\x87\xb9\x06\x25\xf5\x51\x88\x55\x5b\xd9\xc2\xf7\x50\x88\x87\xfa\xbf\x59\xd3\xd4\x0d\x3d\x95\xe1\x30\x9d\xf6\xf7\x9b\x88\x45\xe0\xf0\xd9\xfb\x4d\xe4\xe1\xdb\x6c\x60\x38\x3a\xfd\xf2\xa0\xed\x38\xc5\xed\x26\x51\x4c\x60\x78\x80\x6f\x38\x82\xe3\x2e\xd4\x9b\x64\x85\x5b\x9b\xbc\x0d\x5e\xf3\x93\xd5\x13\xb5\x9c\x02\x8f\x6e\xf4\xe8\x70\x8a\xa2\x47\xf3\x6e\xcb\x3a\xee\x2a\x7b\x4d\xd5\x67\x5b\x73\xb5\x47\x88\xbf\x61\x5e\x39\x6c\x0f\xee\xe0\x65\x58\x88\xfb\xa4\xb8\xa3\xb4\x8b\x72\xff\x30\x76\xe3\xf9\x08\xb5\x60\x29\xfc\xf1\x07\x7c\x13\xc6\x63\x3d\xc6\x45\xc0\x6e\xd0\xb1\xfc\x3e\x36\xef\x5b\x21\x8f\xf9\x7a\xf8\xe6\xd4\x2b\x9b\x6e\x65\x11\x69\x21\x9d\x9e\x80\xf4\x29\x0a\x88\xd1\xd7\x17\xe8\xc6\x18\x6d\x18\x9a\xf6\xaa\x12\x74\x34\x06\xbe\xfb\x0e\xd0\x98\xcc\xa0\x2d\xb5\xb2\xf8\xf3\xfd\xab\x5f\x62\x9c\x18\xd6\x75\x28\x82\xf9\x34\x60\x77\x1d\x81\x85\xb0\xa5\xe4\xe1\xfd\x1a\x6b\xfc\x40\x60\xba\x11\x85\xa3\x77\xd8\x67\xac\x4e\x82\xd3\xcc\x8f\x84\x6b\xd3\xf6\x74\xfe\xd9\xd0\x35\xcb\xbb\x54\x12\xe7\xee\x25\x37\x0b\xa1\xe0\x0a\x98\x9f\x3a\xef\xae\x4c\xc7\x4f\xf7\x0d\x00\x5a\x9f\x5b\x1b\xbd\x9f\x6c\x30\x4e\x20\x99\x49\xdd\x79\x35\xa9\x4b\x9e\x0b\xb7\x9d\x40\x72\x79\x71\xf1\x7f\xd1\x44\xb2\xf6\x02\xcf\x49\x76\x32\x89\x20\x8c\x3a\xd6\x1f\xe4\xcd\x79\x81\xaf\x2a\xc7\xfe\xff\xe2\xe2\x62\x04\xec\xa0\x71\xd7\x52\xf9\xd4\xa4\x3c\x72\x36\xa7\x91\x77\xd1\x2b\xad\x30\xc2\xb8\xeb\x59\x5e\xd7\x25\xd7\x92\x5b\xcb\x12\x7f\x9c\x49\xda\x22\x8e\xcf\xcd\x56\x79\x8e\xd6\xb2\x70\xb6\x0d\xdc\xf0\x47\x02\xe1\x85\x59\xf2\x6d\x43\x10\x6e\x5a\x9f\xa0\x83\x29\x5e\x14\x0d\x80\x46\xc8\xe1\xda\x03\x3d\x04\x08\xd3\x41\xb4\xdd\x43\x83\xea\xa2\xdf\x70\xa3\xe8\x6a\x7e\x51\xf4\x8d\x90\xcf\x8f\xde\x9f\xcb\x97\xc5\xde\x1c\xfd\x67\x42\x1e\xc7\x69\x72\xf1\x75\x3b\x83\x1a\x44\xd4\x32\x00\xde\xba\xa9\xd3\x41\xb9\x75\xcf\xf4\x9e\x39\xb4\xf0\x0e\x6b\xb9\x40\x13\x80\x87\x79\xdb\x71\x79\xa3\x56\x9c\xe7\x35\x1d\x7c\x74\x91\xbd\x1b\x0c\xce\x58\x68\x80\x9f\x7c\x03\xd3\xf8\x78\x53\xfb\xf7\xa0\xe3\xa3\xd7\xad\x8d\x6e\x9b\x3f\x87\x68\x5a\xe9\xed\x91\xec\x7b\x29\xd3\xc1\x2e\x9d\x0e\xfe\x3d\x00\x57\xff\x4f\xb6\xdc\x24\x00\x00")
 
 func assetsFilesJsPageJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -816,12 +821,12 @@ func assetsFilesJsPageJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/files/js/page.js", size: 9253, mode: os.FileMode(436), modTime: time.Unix(1638986883, 0)}
+	info := bindataFileInfo{name: "assets/files/js/page.js", size: 9436, mode: os.FileMode(436), modTime: time.Unix(1792351784, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsFilesJsPipelineJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6f\x73\x1b\xb7\xd1\xf8\x7b\x7d\x0a\x98\x49\x73\x47\x9b\x3e\x52\x6a\x9c\x36\x74\x98\x4e\x22\xcb\x19\xff\x6a\x27\x9e\x48\x49\x9b\xd1\x4f\xf3\x0c\x78\xb7\x24\x11\x1d\x81\x2b\x80\x23\xc5\x38\xfa\xee\xcf\xe0\xcf\xfd\x01\x0e\x47\x52\x8e\xd3\xf6\xe9\x94\x2f\x28\xea\xb0\xd8\x5d\x2c\x16\x8b\xc5\x62\x81\x1b\x3f\x46\xe7\xac\xd8\x71\xb2\x5c\x49\x74\x36\x39\x3b\x45\x57\x2b\x40\x57\x64\xc7\x10\x2e\xe5\x8a\x71\x71\x82\x1e\x9f\xa0\xc7\xe8\x6a\x45\x04\xba\x64\x25\x4f\x01\x9d\xb3\x0c\xd0\x4b\xc6\xd7\x88\x08\x24\xca\xf9\xcf\x90\x4a\x24\x19\x92\x2b\x40\x12\xf8\x5a\x20\xb6\xd0\xff\xbc\x61\xbf\x90\x3c\xc7\xe8\x6d\x39\xcf\x49\xaa\xd0\xbc\x26\x29\x50\x01\x23\xb4\x49\xd0\x59\x32\x49\xd0\xab\x05\xc2\x28\x65\xc5\xae\xae\xf3\xf6\x35\xda\x62\x81\x28\x93\x28\x23\x42\x72\x32\x2f\x25\x64\x68\x4b\xe4\x0a\xc9\x15\x51\x0c\xa1\x05\xc9\x61\x84\x7e\x62\x25\x4a\x31\x45\x6c\x2e\x31\xa1\x88\x51\x40\x58\xa2\x95\x94\x85\x98\x8e\xc7\x6b\x43\x3c\x61\x7c\x39\x7e\xf3\xf6\xf5\xf8\x2c\x99\x8c\x93\x13\xf4\x78\x7c\x72\x32\x1e\xa3\x4b\x90\x65\x81\x0a\x52\x40\x4e\x28\xa0\x35\xcb\x20\x3f\x49\x73\x2c\x04\x7a\x5b\x3d\x7c\x77\x72\x82\x10\x42\xe3\x31\x82\x0d\xf0\x1d\x7a\x86\x04\xa4\x8c\x66\x42\x3f\x7e\xf5\xed\xd5\xc5\xf7\x3f\x7e\xf5\x1a\xcd\xd0\xb3\xc9\x64\xf2\xdc\x00\x63\x29\x71\xba\x42\x33\xb4\xc0\xb9\x80\xe7\xad\x67\x90\xb9\x4f\x33\x8e\x97\x4b\x42\x97\xdd\xa7\x97\x12\x73\xf9\x96\x09\x22\x09\xa3\x68\x86\x68\x99\xe7\x4d\xe9\xc5\x06\xa8\x74\x9e\xae\x59\x29\x20\x63\x5b\xda\xa0\x32\x74\x8b\x02\x72\x58\xfb\xe0\x90\x11\xc9\x78\xe0\x51\xba\xc2\x74\xa9\xd9\x34\x25\x55\xeb\x17\x8c\xdb\x36\x28\x76\x73\x42\x6f\x85\xea\x6e\x8b\xdb\x48\xa3\x60\xdc\xa5\x82\x4b\xc9\x2e\xf1\x06\x9c\x87\xb5\xbc\x67\x68\x30\xb0\xa4\xef\x20\x2d\x65\x47\x0e\x39\x16\xf2\x52\x62\x59\x0a\x07\x81\x64\x2c\x17\x57\x64\x0d\xac\x94\xdd\x82\x57\x1e\xef\x4b\x5c\x2e\x41\x21\x78\xa7\xff\x6d\x73\x70\x5e\x94\xd3\xd6\x63\x43\x72\x0e\xf9\x14\x0d\xce\xdf\xfe\x30\x18\x39\x25\x1a\xcf\x54\x63\x6e\x0a\xee\x47\x1d\xa4\x6f\x60\xcd\xf8\xae\x0f\xaf\x29\x7d\x20\x6a\xbc\xc1\x24\xc7\xf3\xfc\xc3\x32\x5c\x63\xfd\xc0\x1c\xdf\x5b\xc1\xa7\x8c\x0a\xc9\xcb\x54\x32\x1e\x0f\x5b\xc8\xd5\xf8\x4d\x96\x1c\x17\x6a\x84\x50\xd8\xa2\x9f\x19\xa1\x32\xc9\x08\x4e\xbe\x51\x4f\x9f\xbb\x90\x05\x2e\x80\x77\x20\xdf\xaa\xa7\xb1\xcb\xb1\x62\xf7\xe3\x38\xfa\x48\xd7\x78\x5a\xf5\x47\x34\x74\x59\xd7\xa3\x7c\xda\xe2\xc2\x2d\xde\x92\x4c\xae\xa6\xe8\xf4\xec\xcf\x13\xb7\x60\x05\xca\x3e\x4e\xd1\x9f\xce\xbc\x82\x25\x27\xd9\x25\xf9\x05\xa6\xe8\xd4\x2b\xc9\x38\xde\x7e\xc3\x49\x36\x45\x92\x97\xe0\x96\x71\x50\x66\x2d\x95\x57\x1c\x53\x91\x63\x09\x21\xa0\x39\x4e\x6f\x97\x9c\x95\x34\xf3\x7b\xc7\x08\x38\x67\x7c\x8a\x22\xbe\x9c\xe3\xf8\xec\x8f\x67\x23\xd4\x7c\x4d\x92\x3f\x0e\x23\xa7\xc6\xbd\xc7\x1b\x2c\x70\x99\xcb\xd7\x84\xde\x4e\x15\xa6\x1c\x52\x65\x69\x44\xa2\x86\x76\x92\xe6\x8c\x42\x1c\x29\x13\xdb\x91\x1f\xe6\xb7\x5f\x55\xaa\x13\x62\x7a\x83\x73\x92\x61\x09\xe7\x8c\x52\x83\x74\x8a\x16\x25\xd5\xbf\xe2\x0d\x81\xed\xe5\x08\xad\xf1\x92\x82\xbc\x1c\x21\xf5\xff\x55\xf5\xff\xd5\x30\xd0\x4a\xb2\x40\xb1\x05\x47\x9f\x7c\x52\xd5\x4c\x96\x20\xbf\x92\x76\x62\x88\x23\x65\x79\x9e\x2a\x49\x15\xd1\x10\xcd\x66\x33\x14\x11\x1a\x0d\x11\x07\x59\x72\xda\x36\x29\x3e\x66\xcd\x8f\xae\xa1\x39\x39\x54\xc5\x96\x5a\x76\x1b\x7e\xae\x8e\xe1\xe7\x79\x5f\x7f\xdc\x0f\x9b\x22\xad\xc0\x39\xde\x45\xc3\x04\x4b\xc9\xe3\xa8\xbc\x7d\x4a\x52\x46\xa3\x11\x8a\xe6\x98\x46\x61\xd0\x54\x08\x6f\x30\x54\xca\xf1\xd1\xcb\x97\x93\xc9\x64\x12\x85\xa9\xe9\x51\xb0\xc6\xb7\xf0\x8d\xb6\x92\xb1\x2d\xba\x37\x23\x58\xe0\x0d\x38\x43\x57\x49\x4c\x57\xa9\x0c\xbb\xdf\x61\x69\x0e\x98\xbf\xa2\x12\xf8\x06\xe7\x1e\xa8\xdb\x7c\xa7\xcc\xb1\xe2\x2d\x06\x2a\x9a\x9c\x95\x12\x78\xa2\xe6\x83\xef\x41\xb0\x7c\x03\x59\x3c\xbc\x9e\xdc\x24\x25\xcf\xd1\xa3\x19\x1a\x54\x83\x7d\xe0\x33\x64\x3a\x2c\x88\x78\x83\x39\x92\x44\xe6\x8a\xfa\xc7\x71\x94\xa8\xf9\x4f\xe9\x74\x52\x21\xd3\x85\xd1\x30\x91\x70\x27\xe3\x16\xfb\x5a\x0a\xba\xa2\x22\xfd\x03\xd5\xbf\xb3\x81\xd2\x86\xc6\xaa\x24\x92\xfd\xbf\xcb\xef\xbe\x8d\x87\x49\x0a\x79\x2e\x92\x1c\xe8\x52\xae\xd0\x97\x68\xe2\xb3\x58\x94\x32\x8e\x6a\x6b\x35\x32\x46\xd5\x70\x36\x42\x73\xc9\x70\xac\x10\x25\xca\x5c\xd0\x25\x59\xec\xe2\x00\x91\xe1\xd0\x13\x6f\xca\x01\x4b\x78\x49\x72\xb8\x94\x8c\x83\xe1\xd7\x83\x39\x67\xec\x96\x80\x48\x04\xb8\x0c\x84\x60\x8d\x29\x6e\x66\x6e\x0d\xe3\x82\x88\x32\x4d\x41\x88\x78\x50\xfb\x4e\x4a\x7f\xb2\xc1\xb0\xb7\x5b\x1f\xed\xd5\x25\x5f\x43\x04\x48\x57\xb3\x14\xfa\x64\x4e\x68\xa6\xff\x1d\x8e\xd0\x67\x4a\xd3\x1d\x72\x2d\xa2\x39\xc3\x59\x47\x99\x3f\xa4\x62\xf5\x89\xaa\x12\xf3\xd2\x11\xb3\xaf\x4f\x4e\xa5\x47\x33\xe5\x1b\xf9\x24\x3f\x36\x28\xc6\xb8\x20\xe3\xcd\xe9\x78\x5e\xa6\xb7\x20\xc7\x55\xa5\x71\x84\x9e\x20\xa0\x29\xcb\xe0\x87\xef\x5f\xb9\x08\x3d\x13\xae\x3e\x71\x86\x25\x1e\x21\xa1\xdd\xab\x21\x9a\x7d\x19\x30\xbd\x15\x73\x0a\x54\x69\xb7\xfa\x9b\x28\x02\x68\x36\x43\x67\x93\x8e\x26\x77\x7a\xcf\x28\xe9\x82\xb3\xb5\x56\x53\xad\xc7\x05\xe6\x02\x62\x2c\xd9\x5c\xe3\x4d\xd6\x20\x04\x5e\x42\x47\x83\x9d\x96\x1f\x1c\x9e\x3d\x42\xde\x83\xd3\xa8\x90\x6e\x7e\x7c\x08\xac\x6b\x21\xfd\xcf\x7d\xe7\xa9\xfb\x64\x98\x2c\x30\xc9\xe3\x6e\x37\x00\xe7\x8c\xef\x91\x7f\xd5\x30\x0e\x6b\xb6\x81\xb0\x02\xb5\x3f\x2b\x4c\xb3\x1c\x2e\x14\x56\x8b\xbb\x0b\xe7\xb1\xd6\x33\x60\x8c\x53\x0e\x5d\xdf\xad\xed\xad\xab\xe9\xbf\x3d\x23\x0d\x3e\xb2\xd5\x06\xa1\x49\x29\x2a\x94\x03\x07\xfc\x29\xa8\xf5\x8b\x88\xa6\x28\xa2\x8c\x42\xe4\xcc\x4d\xce\xfc\x96\x29\x37\x89\x85\xa7\xb8\x00\x36\x9c\xe7\x7d\xc8\x92\x82\x09\x19\x0f\xaa\xd1\x53\xb1\xe9\x8e\x0c\xcf\xd2\x76\xbb\xa4\x12\xff\xd4\x1d\xe6\x2e\x96\x7b\x6f\xbc\x1d\x33\xd6\xf6\xa8\x63\xd3\x5d\x56\x8b\x7a\x95\xa6\xdb\xf9\x9d\x59\xdf\x76\x2e\x11\x17\x55\x2f\x3a\x1d\x6c\x1d\x1c\xb7\x9f\x5d\xa7\xc0\xf2\x18\x72\x0b\x4c\xd9\xf9\x0a\xd2\x5b\x65\x22\xd4\x4c\x16\xb4\xea\x0e\x5c\xc0\xb0\xeb\x62\xc7\xb4\xeb\xe7\xd5\x6a\x3b\x3c\xa3\x18\xf3\x58\x77\xb0\x41\x32\x1e\x1c\x6d\x15\x75\x2f\xf5\xf4\x8e\xab\xd8\x05\x67\x45\x1c\x65\x44\x28\x7b\x94\xa9\x19\x93\x97\xfe\x84\x59\xb7\xd5\x59\xc4\xb6\x2d\x5e\x4b\x39\xab\x8f\x72\x49\x44\x1b\xf6\x7a\x60\x81\x07\x37\xd7\x03\x53\x32\xb8\xe9\x12\x52\xf5\xb4\xb7\xe9\xd3\x48\xcc\xd3\x70\x0d\xca\x32\xe8\x54\xd0\x0f\x7b\x58\xd3\x3e\x25\x9a\xa1\xe8\x23\xe5\x51\x76\x91\x2e\x18\x47\xb1\x02\xbc\x85\x1d\x22\xd4\xb2\xd4\x37\x4b\xd4\x4c\xa3\x99\x85\xbc\xbe\x85\x5d\xa0\x75\x0e\xf0\x39\xa3\x12\x13\xaa\x97\x84\xad\x39\x66\x09\xf2\x1c\xf2\x3c\xbe\x85\x5d\x8f\x61\xec\x62\xf8\x91\xc0\xb6\xc2\xa2\xd7\x8c\xc9\x82\xd0\x4c\x3d\xfd\x7a\xf7\x46\xad\x12\x63\x17\x7e\x18\x10\x8b\xfa\x88\x2d\x91\xe9\x0a\x19\x68\xad\xbc\x1d\x5f\xa6\xfd\x49\xb1\x00\x14\xbd\xc4\x44\xe9\xce\xb4\x17\x0c\x55\x4e\xbc\x16\xb8\xf5\xe2\xfb\xe7\x29\xf5\x99\x73\xc0\xb7\xfd\x20\x86\xf0\xf7\x80\xb3\xdd\x1e\xba\x16\xaa\xa4\x94\xd0\xe5\xd1\xfc\x29\xee\x5e\xbe\xfc\x10\xfc\x7d\x5d\x8a\xc3\xec\xd5\xc6\xeb\x01\x0c\x2a\x11\x7e\x08\x06\xaf\x80\xaf\x09\xc5\x72\x6f\xef\xb9\xa0\x0f\x61\xf4\x4f\x5f\x3d\xfb\xf3\xe9\x8b\x0f\xc1\xe8\xb9\x5a\x03\xec\x27\x6d\x00\xdf\x02\xcd\x1e\xc2\xe2\x8b\xcf\x3e\xfb\xe3\xe4\xd3\xf7\x67\xf1\x3e\x3c\x8e\xba\xa3\x33\xd1\xc1\x1a\xbd\x10\xd6\x0b\x6b\xa1\x7f\x2a\x67\x28\x95\x6a\x9a\x66\xb7\xca\xc1\xd6\x8c\x85\x29\x35\x01\xa3\x0b\x13\xa8\xd4\x58\x0b\xce\x24\x93\xbb\x02\x12\x0e\x34\x03\x9e\xe0\xa2\xc8\x77\x71\x97\xfe\x1e\x53\xa2\x57\x75\x68\x86\xae\x7b\x0c\x56\x6d\x0b\xc9\x6c\xf2\x1c\x11\xf4\x85\xd7\xbc\x76\xa3\x60\x3d\x87\xac\x5a\x22\x3e\x47\xe4\xc9\x93\x7d\xf6\xa3\x9e\x6d\x5d\xcb\x77\x08\xfd\x35\xb9\x19\xb6\x9f\xaa\xf6\xab\x49\x3a\x4a\xeb\x3a\x75\xed\x68\x1f\x7d\xf5\x31\x6b\xda\xa2\x14\xab\xa3\xe8\xf6\x6b\x4a\xd7\x6f\xee\x7f\x6a\x0c\x35\xcb\xcc\x6c\x6a\x08\x8f\x0c\x2b\xa3\x3d\xd2\x15\x29\xee\x2c\x67\xbb\x44\x7e\x0f\x27\xab\xcb\x6b\xc5\xdf\x5f\x61\x27\x46\x08\xee\x0a\x48\x25\x64\x6d\x69\x9b\x79\xd6\x82\xe9\xb0\xf5\x7d\xc3\x7a\xa3\x54\x68\x86\x94\x5a\x7d\xe1\x60\xdc\xa7\x41\x0d\xce\x6b\xa7\xce\x35\xb9\xb9\x71\x82\xe3\xd5\xe7\x6f\x98\x28\xe3\x31\x55\xbf\x27\xdd\xf5\xa3\x9d\x20\xfa\x8a\x1b\x23\x39\x0d\xd6\x56\x93\x50\xb0\x44\x99\xff\x4e\x41\xd3\x39\xf7\xad\x39\xb8\x96\x46\xc1\xb2\xb6\xc7\xa1\x74\xa4\xe3\x75\x58\xb8\xca\xd7\xd0\x30\xd7\xa6\xa2\x37\x82\x6b\xb4\xb5\x9c\x14\xe6\x82\x65\x49\x23\xc3\xd0\xf8\xd0\xfd\xa2\x08\xb8\xa0\x8d\xb8\x6f\x12\x92\x05\x9c\x44\xbe\x0b\xc6\x76\xeb\xfa\x24\xbb\xb9\xee\x47\xa9\xdd\x8d\x1b\xf4\x64\x86\x4e\x03\x2a\x8e\x52\xac\x5d\x93\xab\x5d\x61\x34\x76\x88\xde\x75\x07\x97\xb2\x28\x07\x28\x68\x4b\x61\x5c\x07\xf4\xeb\xaf\x7b\x9a\xd8\x40\xeb\x89\xbc\xcf\x90\x78\xed\x1b\x58\x75\x1a\x98\x96\x1c\x3d\x56\xef\x03\xda\x60\x55\xa1\xbf\xb3\x9c\xbe\x55\xb6\xf3\x58\x57\xb2\xb2\xfb\x87\x1d\x47\x07\x7d\x00\x8b\x8d\x06\x22\x34\x43\x71\x4b\x14\x4a\x19\x13\x3b\xf0\xd0\x13\xe4\x97\x58\x21\x05\x4a\x9a\xe1\x36\xec\xd0\xd2\x3b\x1a\x48\xd3\x7a\x83\xe5\x2a\x59\xe4\x8c\xf1\x38\xb6\x2c\x8c\x5b\x56\xe8\x31\x3a\x9d\x4c\x86\x89\x64\x97\x7a\x05\x1c\x0f\xd1\x13\x14\xfd\x21\x0a\x08\xa1\xeb\x56\x39\x30\xe3\x31\x7a\xb5\x40\x6b\xc6\xa1\x6d\xcb\x30\x07\xb4\xb5\x8d\x93\x2b\x4c\x11\x37\xed\x19\x59\x7c\xf3\xbc\x84\x13\x5f\x2f\xfb\xa4\xf3\x65\x9f\x74\x42\x1a\x77\xc0\x4f\xbd\x0f\x71\x8f\xe9\xae\x6d\x01\x84\xde\xd5\x36\x32\x1e\xa9\x25\xab\x45\x2a\x19\xe2\x90\x1d\x64\xbb\xe9\xa0\x50\x08\x18\x1d\xf6\xf5\x3d\x1e\x1d\x05\xb3\x3b\x05\xca\xaf\x59\x72\x10\x22\x1a\x05\xf0\x47\x1c\x16\x4f\xb5\x2e\x44\x53\xa3\x13\x5d\xe3\x1b\x2d\x48\x9e\x47\x53\xc3\x8b\x1f\xd3\x70\xf9\x79\x90\x67\x55\x0d\x9a\x4e\xa8\x09\x1d\xdc\x83\x28\x72\xbc\x2b\x70\x29\x7a\x36\x22\xea\x18\x45\x4f\x9c\x81\x15\x7e\x34\x65\xdf\x06\x8b\x7a\xfe\x34\x25\x3c\xcd\x3b\xf1\xb5\xbd\x9b\x2d\xa8\xbd\xe1\x62\x87\xc4\x7e\xf1\x1d\x08\x1f\x0b\x89\xb9\xb3\xe7\xf0\xcf\xdb\x16\x6a\xc2\x3c\x8a\x83\x07\xc4\xfe\xbc\x00\x9b\x46\xb0\xc8\xd9\x76\x30\xfa\x9d\xc2\x6a\xd5\xa6\xa2\x1f\x5f\x0b\xef\x21\x1e\x1b\xa0\xf2\xfb\xe1\x03\x05\xaa\xba\x1d\xad\xff\x6b\xd5\xf5\x1d\xd0\x7a\xcf\xb4\x13\x4d\x50\x3d\x6c\xe3\x35\x4a\x1f\xb2\xec\x3c\xc7\x42\x68\x9d\xc0\x39\x70\xf9\x74\x8b\xb9\x5e\xbd\x07\x74\xb8\xa9\xa7\xe6\xac\x38\x2a\xa2\x61\xb2\x92\xeb\x3c\xb6\x11\x09\x65\xd0\xcc\xe6\x11\x32\x1b\x0c\x6d\x24\x1d\x1d\x51\xc3\x6b\x9f\x8a\x78\xdb\xa8\x5d\x1d\x61\xc5\x7f\x55\xe4\x3f\x59\x45\x6c\xfc\xde\xd1\x12\x45\xc3\x06\x53\xdf\x3b\xae\xdf\xc6\xf5\xc0\x3d\x82\xee\x8e\x43\x8d\xcc\x53\x4f\x8b\xf8\xbf\x1a\xea\xa3\xfd\x4f\xd2\xd0\x14\xd3\x0d\x16\x97\xe4\x17\xd7\xc3\xd0\x6e\xae\x2e\x32\xe9\x01\x9d\xac\xa2\xe7\x0e\xa8\x20\xbf\x00\x9a\xa1\xef\x74\x1e\x64\x7b\xe2\x56\x05\x89\x5a\x2d\x18\x64\x89\xf6\xbc\x3a\x00\xab\x06\xc0\xe4\x19\xb5\x21\xec\x9e\x8c\x02\xac\x39\x37\xf6\x17\x64\x59\xe8\x54\x40\xd1\x19\x60\x3e\xbb\x89\x4e\x10\xd4\x7b\x88\x4d\x9f\xa8\xaa\x41\xc7\x29\xe3\x78\x69\x8b\x4f\x42\xdd\xec\x6e\xd3\x6d\x09\xcd\xd8\x76\x98\x70\x50\x3c\xc6\x71\x20\x68\xa2\x93\xc1\x1a\x81\x6a\x22\x6d\xc9\x87\xb2\x0d\xf4\xca\x4a\x80\x7c\x41\xd6\x40\x05\x61\x54\xc4\x95\x10\x47\xb5\xb4\xbc\x8e\x3d\x09\xa0\x60\xd4\x37\x0a\xf3\x1c\xd3\xdb\xa9\x96\xc8\x76\x05\xa0\x5c\x5e\xbd\x17\xab\x1a\x3c\x42\x77\x23\xb4\x1b\xa1\x0c\xf2\x6a\x3b\xc8\x20\xd3\x95\xde\xa8\x3a\x7f\x53\x75\x42\xd0\xcf\xfd\x2c\x2b\x4b\xc9\x1a\xa1\x8c\x6d\xa9\x26\xd5\xae\xdb\x21\xf1\xd6\x00\xbf\x60\x5b\xea\xc0\x1d\x40\x5e\x16\xa6\x15\x47\x21\xff\xa1\xe8\xa2\x76\x71\xe7\x84\xde\x4e\xd5\x2a\x03\xee\xe4\x1a\x68\x69\x45\xb4\x21\xb0\x1d\xa1\x1e\x0a\xaa\xce\xf7\x4a\x7b\xcf\x73\x92\xde\x06\x60\x03\x74\x6c\x02\xa9\x47\x6a\x3f\x1d\x5b\xe7\x30\xa9\x20\x25\xdd\xed\x6c\x03\xdc\x74\xc5\x31\x94\x74\xaf\x7f\xb7\x01\xfe\x70\x42\x39\xe0\x0d\x28\x4a\xc7\x13\x7a\xad\xaa\x3c\x9c\x12\xa8\x8e\x7d\x18\xa5\x0b\x55\x25\x04\xbe\x97\x50\x3d\x64\xba\x35\x3f\xd4\xb0\xa9\xe8\xb9\xba\x7d\x54\xc3\x1a\x0d\x3f\x4a\xff\xd4\xd2\xb4\x33\x3e\x0f\x50\x52\x75\xda\xa3\x74\xa3\x97\xb6\x9d\x46\xb4\x51\xd7\xa3\xf3\x78\xd4\xb6\x05\x2e\x62\xdf\xbd\x6a\xec\x74\x68\x75\x5c\xa5\x97\x07\x82\xc1\x42\x22\x1d\x18\x77\xe3\x58\xfa\x91\x6f\x8d\xd5\xfc\x76\x87\x66\x86\xe5\x84\x2d\x16\x02\xe4\xdf\xd1\xd3\x16\x0d\x27\x59\x3d\xb9\x43\x8f\x0d\xee\x44\xdc\x75\xc3\x51\x3b\x0f\xd3\x4f\x7b\x30\xed\x1a\x4c\xbb\x61\xdf\x0c\x21\xab\x0c\xda\xd8\xf4\xb1\xb7\xa6\xb6\x92\x3a\x42\x0d\x5b\x42\x3a\x2c\x20\x67\xf6\x5f\x13\x7a\x69\x61\x27\xc9\xd9\x33\xb7\x0c\xdf\x55\x65\x67\x4e\x01\x34\x82\xe0\x64\x49\x28\xce\xf5\x54\xde\xf0\xaf\xb9\x42\x33\xfb\xf7\x71\x4d\xc5\x23\x5e\x75\xc8\x0c\xc5\x50\xf7\xce\xaf\xbf\x22\x48\xd2\x9c\x00\xd5\x5d\xf5\xb1\x99\xb6\x6d\x71\x3c\x4c\x72\x58\x48\xcf\x85\xa9\xba\xa3\x85\xe7\xa7\x36\x9e\x9f\x42\x78\x24\x2b\x3c\x34\x45\x25\x30\x03\x73\xc5\x5e\xb3\x14\x1b\x9d\x8e\x2d\x77\xa3\x8a\x96\x57\x95\xc2\xb6\x12\x55\xa5\x3f\xe8\x89\x69\xbe\x9b\x2d\x57\x03\x7e\x39\x6b\x64\xff\xc9\x27\x0d\x82\x2f\x66\xb5\xdc\x83\xfe\x8d\xaf\x39\x93\x11\x9a\xec\xf1\x41\x74\xa7\x57\xc8\x47\xa8\xf9\x55\x24\x77\xea\x6b\xbf\xd6\xf5\x4d\xe8\xfe\xc2\x39\xcd\x99\x80\xaf\xf2\x3c\xf6\x13\x74\x5b\xa7\x44\xdc\x98\x4b\x78\xd8\xa0\x19\x7a\x87\xee\xa6\x9a\xc8\x14\xed\xee\x9f\xf7\x71\xe4\x79\x01\x3e\x3f\xe1\xc3\x29\x55\x3a\xe5\xa1\xb9\xde\x19\x4d\x75\x7a\xf9\xb5\x76\x2c\xa2\x9b\xd6\x06\x5d\xc8\x4e\xb7\x29\x1d\x33\xdb\x1f\x2d\x4a\xe7\x38\xcc\xa6\xde\x62\x76\xf5\xd0\x6c\x8d\xb6\x8a\xfd\x7d\xd3\x44\x14\x39\x91\x71\x94\x44\xc3\xeb\xd3\x9b\x44\xb2\xd7\x6c\x0b\xfc\x1c\x0b\x88\x87\xc1\x56\xab\x4a\x07\xda\x1c\x68\xf2\x3e\xb7\xc3\x5b\xaa\xd8\xa3\x37\x9a\x65\xb5\x26\xf2\x72\xd1\x23\x5b\x39\x91\x98\x2f\xc1\x1f\xf9\xba\x8d\xfa\xdc\x4c\xab\xc9\xed\xbd\xaa\x7a\x42\x71\x8e\xde\x3c\x32\x6b\xcf\x3a\xef\xb9\x3e\x7e\x33\xab\x30\x06\xd3\xc3\x6d\xf5\x2e\xc2\x60\x74\x58\x91\xc6\xa9\x24\x1b\x78\x4d\xe8\xad\xa2\xd5\xfc\x17\xdc\xce\xd6\xfa\xa5\xe0\xb4\x48\xaa\xbf\xaa\x84\x75\x76\xa3\xc6\x63\xb4\x52\x9e\xa0\xde\x3c\x5a\xe1\x7c\x81\xb0\x3d\xde\x85\xe6\xb0\x60\x1c\x9a\x23\x4f\x5d\xdb\x50\x9f\xf2\x72\xc7\x24\x32\x0b\xb3\xaa\x95\xf5\x62\x6b\xef\x92\xde\xe0\xea\x5b\xc5\xb7\x5a\x2c\xf4\xe9\xbb\xf8\x1d\xc9\xa6\x6e\x67\x8d\x74\x3b\xa7\xa6\xb5\xf7\x7a\x41\x7d\xc5\x5a\x79\x02\x3d\x7b\xf0\x2d\xd4\x46\x35\x6c\x15\x30\x07\x53\x16\x8c\xaf\x8d\xe9\xee\x4b\xa5\x6d\xb1\xaf\x4f\x8d\x75\x85\x81\xf6\x06\x0c\x46\xe8\x59\x7f\xce\xf6\x31\x5e\xb1\x3f\xf4\x9b\x43\x60\x3d\xca\xdc\x55\xe4\x59\xb0\xc3\x36\x9d\x7d\x43\x9b\x84\x7b\xa5\xea\x06\x97\xac\xbd\x27\xd3\x7a\x38\xf4\x0e\x3d\xf8\x62\x31\xc7\x63\x3a\x26\x2c\x70\xba\x10\x19\x65\x5e\xe6\x6c\x8e\xf3\x56\xa7\x3a\xc3\xe8\x51\x6b\x1c\xfd\xfa\x2b\x7a\xe4\x74\xdd\x10\xbd\xab\x36\x25\xfc\xcd\x08\x35\xa2\xec\x96\x67\x4b\x5d\xaa\x2d\xcf\xb8\x99\x28\xbd\x9c\xf6\x47\x4e\xed\x07\x26\xd0\x57\xd5\xcc\x56\xc8\x57\x9c\xb3\xed\x0a\x70\xf6\x46\x27\x41\x1b\x55\x8d\xdc\x28\x44\xc6\xd2\x52\x69\xcb\x30\xb0\xe0\xaf\x83\x1f\x1a\x6f\x34\x6d\x68\xbc\xa8\x66\xcf\x51\xa0\x46\x59\x04\xe1\x2f\x68\xb3\xe1\x77\xef\xef\xb5\xa9\x2e\x9b\xba\x6d\x70\x51\x6b\x51\x4d\x5b\xfe\x45\x9f\x73\x7f\xd4\x4a\xad\x35\x0d\x28\x69\xe9\x61\xaf\xd4\xb3\xd1\x34\xdd\x49\x5a\x91\x82\xe8\xf7\xad\x97\x3e\xd4\x24\xa3\xb4\x41\x91\x53\xe6\x58\xfd\x7d\x80\xe1\x7e\x14\x34\xdc\x1a\x89\x35\x58\x5d\x5b\xe8\x04\x75\x1d\x83\x12\x5a\xb9\xed\x6d\x72\x6a\xd2\x03\x42\x9e\x82\x56\x71\x55\x6e\x0e\x1d\x98\x6c\xa7\x68\xa8\x86\x56\xf7\x69\x75\xfe\x67\x36\x9b\x75\xb7\x7f\xc7\x63\x74\xb9\x62\x5b\x7d\xf4\x59\x3b\x5d\x90\x55\xbd\x83\xf0\x9c\x6d\x00\xe1\x3c\xd7\xa5\x4c\xae\xa0\xca\x3d\x8b\xe1\x2e\x85\x42\xa2\xed\x0a\xa8\x2a\xf4\x51\x56\x18\x88\x40\x18\x15\x98\xeb\xa1\xe1\x4e\xc7\x8a\x4d\xc9\x5e\x72\x46\x65\xd0\x9e\x35\x09\x11\xe6\x2c\x1f\x64\x6a\xe8\x0b\xbd\x9f\x3b\x4c\x16\x8c\x5f\xe0\x74\xd5\x58\x4c\xd5\x83\xc3\x77\xfa\x00\x61\x8d\xb5\xdb\x15\x95\xec\x1a\x21\x19\xe6\xa2\x61\xd0\x55\x77\x93\x32\x02\x95\x92\x92\x6a\x29\x1b\xae\x0e\x77\xfc\x31\x9a\xbe\xa7\xdb\x8d\x7f\xb9\x82\xf4\xf6\xa2\xa6\x3a\xaa\x7b\xfc\xeb\xaf\xd9\x9d\x3e\xf3\xa5\xc7\xab\x37\xe2\xbc\xe1\xe8\xd1\x6c\xc6\x44\x9f\xef\xe9\x85\x9c\x73\x92\x2a\x37\xb5\xdf\x37\xf5\x16\x8a\xd6\xad\xd5\xf5\xae\xf5\xb7\xd5\xca\xa7\xa7\x37\xbe\x5a\x7b\xbe\xab\xeb\xe2\xde\x24\x44\x7c\xc3\x59\x59\x5c\xed\x0a\x88\x3b\xdd\x66\xe3\xd5\xee\x81\xdd\xab\xba\xed\x81\xb3\x0f\xaa\x6c\x8a\xae\x83\x0e\x46\x83\xc6\x2a\xb4\xc6\x94\x7c\x6f\x62\xd9\xfd\x69\x8a\xa5\x00\x9d\x8b\xf3\x0d\xb0\x35\x48\xbe\xb3\x47\x55\xd5\x92\x28\x9a\xfc\x21\x1a\xa9\x15\x52\x74\x3a\x51\x3f\x83\x38\xfc\x8d\x1a\xf5\xb9\xe9\xdf\xd4\x6f\xe5\x67\x3c\xa8\xfd\xbd\x6d\x7f\x9f\x76\xff\x96\x36\x87\xda\xdb\xc3\xc3\xd7\xa5\x94\x9d\x39\xf6\x18\x1e\x0c\xe9\x43\x92\x5f\x63\x7e\x5b\x16\x53\x74\xbd\xe7\x74\x19\x5e\x7e\x8b\xd7\x30\x45\x91\xcd\xd6\x08\x63\x42\xe6\xe2\x05\x3b\x3c\x42\x67\xa8\xdb\x1f\x3e\x45\x7f\xea\xc7\xa3\x3e\x0b\x92\xe7\x53\x7b\xfc\x61\x3f\x64\x94\x96\x5c\x30\x1e\x4d\xeb\x1d\xc8\x3d\x15\x7a\x92\x60\x6f\xc2\x35\xb0\x3d\x53\x5d\xc5\xfd\x7a\x8f\x8e\xa1\x43\x6e\x51\x87\xe9\xc6\x4d\xaa\xf3\x8b\xa2\x23\x09\x55\x9f\xf1\x18\xc9\x6a\xe5\x80\x4c\xe0\x08\x49\x66\x9c\x1e\x94\x32\xc6\x33\x9d\x06\x25\x0e\x22\x72\x62\x49\x36\x0e\x43\x71\x71\xc5\xbe\xe1\x24\x3b\xd0\x90\xea\x73\x37\xb5\x1e\x89\x8d\x84\xed\xef\xb3\xea\xb3\x73\x6b\xfd\x74\xb0\x92\x9f\xdc\xd3\xd7\x1c\xaa\x5c\xe8\x22\xb9\x43\x4f\x2d\x01\x7d\xf2\xa6\x65\xbf\x8b\x3a\x84\x7a\x24\xc2\x95\x46\xb8\x3b\x8c\x70\x77\x18\x61\x0b\x43\x6b\xf6\xb1\x7b\x7a\x88\x6e\x47\x88\xf6\xad\x22\x6b\x41\x1c\x18\x14\x95\x53\xfd\xde\xda\xe5\xa8\xf3\x62\x11\x07\x35\xf6\x88\xde\x08\xe3\x71\x38\x3b\xd4\xd4\xfe\xd1\x1c\xca\xbe\x6b\x7f\xda\x16\xa9\xf9\xbd\x5f\x74\x66\x55\xb1\xe9\x2c\x26\x1c\xc2\xc7\x9f\x4d\xf5\x6c\xfd\x4d\xef\x06\x83\xb3\x44\xea\xee\x34\x54\x83\xb4\xa5\x3b\x07\x86\xea\xfe\x21\xd9\x3b\xf4\xda\x6d\xf3\x15\xd5\x1a\x58\x3d\x2f\x5a\x9f\xce\x8b\xcb\x7a\x8d\xb9\xa0\x59\xb7\x29\x3d\x58\x4b\x1b\x23\x75\x72\x4e\x3c\xe5\x31\x8b\xc4\xce\x3a\x5d\xaf\x95\x5f\x04\x6f\xeb\x41\x81\xc8\x89\xbf\x9c\x7f\xdc\x48\xe6\x31\x7a\xcb\x09\xe3\x86\x47\x81\x32\x46\x25\x12\x00\x6b\x65\x59\x57\x78\x03\xa8\xe0\xac\xc0\x4b\x2c\xdb\x99\xa8\x8f\xd1\x7c\x67\xae\x63\x22\x6b\xd0\xd4\x90\x6e\x93\x5a\x10\x70\xd0\x54\x47\xaa\x9c\xeb\x48\x57\xbb\x9e\x00\xa9\xf3\xb7\xdc\xdc\x19\x94\x31\x10\x34\x92\x86\x22\xa6\x08\x2f\x16\x90\xca\xd6\x92\xc2\xe1\xf8\x8a\xa1\xf9\xae\xc0\x42\x68\xd2\x23\x24\x72\x80\x42\x07\xda\x9e\x4d\xd6\xa2\x8a\xaf\xe9\x88\xa0\x13\x5e\x7b\xac\x79\xb6\xa4\xab\x16\xcf\x71\x7a\x8b\x16\x9c\xad\xd1\x80\x32\x0a\x03\xd5\xf0\x01\xce\xf3\x41\x9b\xfa\xb8\xfe\x7d\x4c\xfc\xcd\x0b\x2c\x3e\xea\x4f\xa4\xf1\x02\x8e\x71\x94\x8c\x85\xdc\x29\xa7\xa3\x9e\xdc\x13\xc3\xe7\x54\x2d\xd4\x42\x96\xa3\x45\x28\x14\x16\xba\x6f\x87\x13\x9e\x4d\x5c\xad\x1d\x3f\xb6\x72\x7d\x8c\xce\x73\x26\xcc\x62\x50\x75\x38\x70\xb9\x43\x19\xc1\x39\x5b\x96\xd5\x84\x6a\x85\xd0\xc4\xbf\x5b\xed\x31\xc9\x29\xc9\x2d\xec\x44\xdc\x72\xf0\x03\x0b\xb9\xa6\xb4\xbb\x73\xd8\x2c\x0c\x9a\xdf\x37\x26\xe0\x1e\xef\xc9\xb2\xf1\x17\x4c\x5a\x70\xce\x26\x81\x4e\x64\xee\x8f\x5a\x2a\x53\xa3\x06\xa6\xf8\x1a\x72\xd6\x93\x7e\x2f\x5e\x72\x66\xe3\x95\x06\xbf\xbb\xac\x69\xaa\xdb\x85\x4f\xe8\x64\x80\x02\xaa\x48\xfc\x8f\xc9\x2f\x6a\xea\x8d\x9a\x9b\x6c\xd2\x26\x62\x86\xd2\x26\xf4\xf0\x68\x36\x33\x4d\xf1\xc2\x10\x6d\x1e\x0c\xfa\x4f\x3e\x69\x68\xd9\xfa\xce\xc2\xb6\x8d\x2a\xa4\x96\xe3\x31\x62\x34\xdf\x29\x75\x60\x5b\xa4\x97\xc0\x99\x4e\xad\x67\xad\xbc\x75\xb5\x78\x13\x68\x5e\x4a\x65\x34\x22\x69\x81\x9b\x72\x0a\x42\x0f\xf5\x78\x07\x72\xd8\x21\xe1\x30\x1c\xde\x0a\xf1\x8e\x90\xfd\xb5\x9c\x03\xa7\x20\x41\xe8\x50\x4e\x5a\x65\xa7\xb7\x6b\x3c\xea\xab\xd1\x7b\x52\xd8\x63\x21\xb8\xdc\xef\x8e\x25\xe4\x1d\x15\x69\xa9\x63\x20\xbe\xdd\xa2\x3d\x1e\xb7\x2e\x59\xd3\x56\xc7\x06\x56\x45\x81\x53\xf0\xd7\x79\x7d\x9b\x9d\x0e\x1f\x35\xbe\xf6\x0e\x6d\xf3\x30\xc7\x3b\xe0\x7f\xef\xdc\x1e\xe5\x54\xf9\x29\x50\xa5\x99\x20\x9d\xfd\xa3\x00\x37\xa1\x28\x87\xd8\x2c\x75\xa1\xe7\x65\x6f\x96\x89\x49\x9e\xbb\xfc\xf1\x1b\x2b\x9c\x56\xba\x9a\xad\x93\xdc\xa1\x19\xba\x0b\x3c\xdf\xa1\x19\xda\x75\x93\xd7\xaa\xe2\x35\x96\x9c\xdc\xd5\xc2\x6f\x45\x8f\xf5\xb4\x5b\x30\x2e\x75\xb0\xe7\xea\x4d\x3c\x4c\x08\xdd\x00\x57\x76\xc5\x8f\xa3\x48\x9c\x9f\x17\x65\xf8\x48\x40\xfb\xc0\x7d\xd8\xa8\x5b\x9e\xa2\xf6\x19\x8b\x7b\x37\xfe\x53\x94\xfa\x84\x5d\xfd\xb0\x3e\x5d\x44\x59\x06\x88\x50\xff\x6c\xbf\x39\x37\xef\x13\xd2\xc0\xb3\x30\xec\xb5\xfa\xf6\x8e\x9e\x29\xb2\x4f\x66\x48\x5f\x91\xf2\x8a\xca\x58\x81\x24\x69\x51\xa6\xb8\xc0\x29\x91\xdd\x6d\xe6\x56\x6b\xd2\xa2\x74\x84\xd4\xbe\x34\xee\x37\x0a\x6a\x92\x4c\x26\x93\xd3\xff\x23\xb2\xe2\xf0\x8f\x12\x84\x14\x41\x59\x19\x5e\x63\xf5\x67\x5c\x6d\xc6\x54\xba\x64\xcf\x3f\xb9\x53\x86\xae\x60\x42\xb6\x55\x43\x8d\x30\xd0\x3d\x82\x5c\x40\x0d\xf4\xe5\x4c\x1f\x9e\xaa\xc1\x3e\xff\x3c\xf9\xfc\xf3\xcf\x3f\x7f\xde\xed\xa8\x4e\x4f\xe9\x26\x28\x16\xd2\xa2\xf4\x87\x28\x2d\xd7\xc8\x36\xf2\x65\xce\xb0\x34\x30\x9a\xd1\x89\x3b\x37\x92\xcc\x38\x99\x69\x51\x26\x1c\x8a\x1c\xa7\x10\x8f\xaf\x27\x4f\x3f\x4f\x6e\xc6\xcb\x11\x8a\xa2\x61\x22\x39\x59\xeb\x9c\x8d\x56\x2c\x2f\xd1\x81\xc0\x78\x32\x42\xa7\xde\x6c\x69\x11\xce\xd0\x60\xdd\xb9\x8a\x48\xb1\x35\x9e\x79\x5c\x74\x5a\x4a\xcb\x75\x77\xdc\x9a\xfb\x04\x7f\xa3\x46\xf6\x2a\xe3\x1a\xd6\xff\x0a\x65\x54\x64\x3b\xca\xb8\xd6\x2d\x3d\x66\xec\xae\x61\x1d\x1e\xbb\x1f\x44\x58\xfb\x87\xef\xbf\x9f\xc4\xf6\x8e\x60\xc3\x6e\xac\xfe\xb4\x47\x70\x25\xa8\xe0\x20\xd6\x75\xec\x20\xb6\xcd\xed\x0e\x62\x55\xd0\x0c\x62\x03\xd6\x3f\x88\xfd\x1e\xd3\xed\xb0\x4c\xac\x61\xed\x0f\xe3\xcb\x57\x68\x86\xae\xa3\xb9\x5a\x32\xdc\xea\xef\xb5\xfe\x5e\xea\x6f\xa9\xbf\x0b\xfd\x0d\xfa\xfb\x17\xfd\xbd\x9b\x47\x37\xee\x20\x7f\x75\x71\xde\x42\x44\x34\x22\xfd\xbd\xd4\xdf\x52\x7f\x17\xfa\x1b\xf4\xf7\x2f\xfa\x7b\x47\x14\xa2\xfd\x86\x45\x71\x1d\xb6\x28\x6b\x58\xbf\x9f\x45\x39\x6b\x5b\x14\x8b\xac\xb6\x2a\xd1\x7c\x17\xa1\xbf\xa0\x68\x1e\xa1\xa9\x79\xda\xcd\xa8\x1f\xa1\x75\x99\x4b\x52\xe4\x04\x78\x9f\x71\xea\xb9\x99\xad\x31\x3e\xa8\x7b\xf1\x5c\x6c\xd3\xf5\x2f\x5f\x25\x84\x66\x70\xf7\xdd\xc2\x20\x1c\x2a\xa7\x1b\x3d\x3d\xf5\x51\x36\x5c\x20\xa5\x21\x67\x9f\xb6\x30\x57\x1a\x54\x23\x7d\x75\x71\xfe\x5e\x58\x1d\x53\x6a\xb0\x7a\x2b\x56\x47\x84\x4f\xd0\x60\x3e\xf0\xd2\x4e\x7a\x5a\xf5\xfc\xe8\xc6\x04\x2c\x38\x7a\x6c\x8e\x0d\x17\x6c\x1b\x37\x55\x47\xc8\x34\xf7\xc9\xa9\xe7\x9b\xa9\xc1\x4b\x38\x64\xdf\x83\x49\x55\x11\xdd\x53\x15\xbe\xbf\x10\xb4\x42\xdd\xbd\x3f\x11\x37\x2b\x55\xe3\xfc\x77\x63\x86\xf5\xbe\xe2\xd1\xab\x94\xbe\x93\xfc\x55\xee\xa7\x8f\x4d\x98\x0c\x4c\xbf\x8a\x22\xec\xac\xaf\xdd\x6a\xca\x95\x4d\xf3\x32\x03\x11\x0f\xcc\x96\xf0\xa0\xb3\x6d\x56\x7d\xbc\x9b\x43\xbe\xf0\x39\x78\xd8\x6d\x21\xf5\xad\x04\x61\x34\xd7\xa4\xe7\xfe\x92\x23\xfa\xc0\xee\x09\x1d\x11\xba\xad\xf6\xff\xd5\xba\xa5\xc5\x02\xd1\xb9\x62\x24\xab\xd2\x02\xbc\xe2\xdf\x74\x43\x09\x6a\x5c\xc4\x6a\x8d\x61\xbd\xac\x00\x25\xeb\x54\xf5\xdd\x14\xe2\x7f\xec\xdc\xd5\xc2\x6b\x2d\x7f\x00\xb5\x99\xd2\x8e\xc5\xbe\x27\xaa\xfc\x9e\x17\x0f\xde\x77\x22\x32\xe8\xa1\x7e\xf0\x71\x53\xee\xc9\xef\xe6\x37\xff\x4e\x53\xf8\x3b\x7f\x39\x31\x55\x5f\x23\xdf\x4b\x99\xaa\xaf\x51\x38\x8c\xd0\x0a\xcd\xe9\xc3\xeb\x75\x74\x08\xe7\x44\xee\x6c\x91\x89\xc8\xb5\x0f\xb8\x7b\x06\xb1\x32\x99\x95\xfb\x14\x30\xa1\xae\xb1\xe4\x98\x2e\xe1\x35\x9e\x43\xae\x9d\x81\x89\x9a\xe2\x4f\x27\x93\xe8\xc6\x81\xc2\x3c\x3d\x67\x39\xd3\x97\xc8\x5c\x47\x7c\x39\x8f\x3f\xfd\x74\x84\x4e\x9f\x9d\x8e\xd0\xd9\xd9\xd9\x50\x55\xca\xc9\x72\x25\x97\x1c\xef\x5a\x55\xdb\x56\xcc\x18\x00\xcd\x75\x6b\xdc\xeb\x07\x81\x51\xaf\xa3\x67\x38\xef\x24\x43\x56\xd7\xa3\x99\x6a\x81\x93\xf3\xfa\x12\xaa\xd6\xa5\xec\x3d\x17\x51\x69\xdc\x68\x56\xcb\x2b\xa9\x17\x52\xfe\xa7\xe7\xee\x29\x97\x90\xd1\xdf\x63\x69\xd5\x0e\xdf\x83\x68\xb5\x17\xe1\x07\x28\x99\xed\x00\x67\xd1\x1e\x26\xe8\xf5\xbe\xea\xf8\x11\x8a\x26\x51\x8f\x21\x77\xd5\xa0\xe9\xf1\x11\x0a\xe8\x44\x0f\x8e\xe3\xda\x78\x94\x40\xdd\x66\x56\x36\xe4\xdf\xb9\xa5\xf7\x1d\x0f\x43\x4d\x4c\x6c\x11\xeb\x26\xd9\xeb\xbd\x4b\x9a\xc1\x82\x50\xc8\xf4\xf5\x38\x44\x7c\x8b\xbf\xb5\xe5\x61\x07\xc3\x08\xc3\xd8\xb0\x43\xd4\x9a\x41\x78\xad\xff\xdc\x98\xff\xf6\x6d\x59\xf4\xd5\xb1\x19\xac\xda\x10\xc5\xc3\x3d\x84\xf5\x01\x95\x3a\x51\xbe\xda\xef\x4a\xfe\x51\x02\xdf\x5d\x42\x0e\xfa\x56\xff\xe8\xa3\x08\x3d\x31\xef\x02\x08\x66\x8f\x05\x78\x9e\x19\x43\x79\xbe\xc2\x5c\x9a\x47\xfa\x67\x35\x73\xaa\x1e\x9a\x84\xf6\x4f\x57\x58\x7c\x0b\x90\xe5\x30\x35\xfb\x64\xa1\x5c\x15\x55\x7c\x6e\xef\xb7\x30\x1d\xdf\x03\xf4\x43\x91\x61\x09\x97\x05\x40\x36\xd5\x8e\x77\x17\xb0\xd6\xa5\x69\xf3\x33\x08\xf5\x02\x72\xb2\x26\x12\x14\xe4\xb5\xee\xd7\x40\xda\x46\xa3\xc8\xd3\xd6\xef\x2e\x5c\x0a\x54\x72\x9c\x5b\xc8\x80\x10\xf3\x6e\x3d\xdf\x31\xe8\xed\xfa\x52\xb7\xda\x48\xd1\xea\x66\x70\xbb\x46\xff\x15\x2b\xb6\xbd\xa0\x1b\xc2\x19\x55\xfd\x12\xb8\xe5\xa1\x7a\x81\x08\x4e\x41\xff\x13\x0f\xa0\x81\x7f\xaa\x8f\x73\x52\x39\xf0\x37\x45\x4d\xb5\x44\x80\xbc\x5a\xc1\x1a\xe2\x01\x4e\x61\x2c\xd5\xcf\xf1\x5d\xca\x32\x18\xf8\x49\x6b\x4e\x56\x9c\x77\x84\x23\x98\x1a\xa7\xf3\xd7\xda\x93\x58\x8f\x17\xde\x30\xdb\x75\xc5\x9b\x2a\xd7\x0e\xe0\x8d\x77\xe1\x9f\xb7\xac\xec\x67\x2f\x69\x61\xd9\x73\x15\xbc\x2b\x22\x21\x08\xa3\x4a\x54\x3f\xaa\xce\x3a\x16\xfb\xcf\x8c\xd0\x78\xf0\xff\xe9\xc0\xcf\xd8\x0f\x21\xff\x78\xcd\x32\xb2\x20\xa1\xfd\xe7\xfb\x93\x9e\x9e\x33\x35\x19\x8d\x23\xf3\xae\x98\xa8\xb5\x29\xd6\x39\x48\xe7\x55\x6f\xde\x2e\xe3\x9d\xea\xf2\x0e\x97\x1f\x10\xc8\x1b\x96\x41\x1c\x29\xd5\x59\xb3\x0c\xc6\x62\xe5\xe4\x3a\xfe\xf0\xea\x96\xc8\x64\xcd\x32\x9c\xc7\xd1\x47\x2d\xd9\x74\xee\x45\x02\x91\xea\x2d\xd5\xa0\x51\x99\x2f\xfb\xcb\x84\xc4\xe9\xad\xff\xf2\x8b\xfb\x61\xa2\x86\x8d\xff\x12\x85\x15\xdb\x9e\x73\x50\x0b\x71\x82\x73\xe1\x6f\x34\x7d\xcb\x24\x22\xeb\xc2\xf4\xa9\xdd\xbe\x0f\x6c\xfc\x62\x9a\x42\x8e\x5a\x6d\xd1\xaf\xeb\xa9\x37\xce\xab\x6d\x5f\x0d\xd6\x37\x6e\x9b\x4b\x93\xda\x7d\xe1\xf7\x56\x4b\x7a\x49\xca\xe8\x82\xf0\x75\xf7\xda\xf2\xe8\x27\x56\x9a\x3c\x80\x92\xea\x5b\xfe\x91\xc1\x26\x12\xf4\x15\x07\xb4\x63\x25\x12\xa5\xfd\xb1\x25\x62\xa5\x37\x25\xf5\xe6\xb5\x5c\x81\x7d\xd3\xd0\x5f\x02\xf6\xf9\x5d\x4b\xb0\xfe\x05\xea\x72\x05\x34\x70\x81\xba\x3d\x3d\x3b\x1e\x23\x76\x1b\x9c\xd2\xcd\x59\x36\x73\x95\xc7\x85\xa6\x1c\xf2\x37\x02\xa9\x4b\x2d\xd4\x46\xb0\xfd\xe8\x3b\xe6\xf2\xd0\xbe\xe4\x30\xd1\x37\xf3\xc5\xa0\x49\x3c\xf0\x76\xa8\x60\x63\x9a\xeb\x2c\x52\xc8\xf7\x28\x1c\x3d\x4a\xe1\xf4\x3b\x17\x44\xca\x49\x21\x11\x16\x68\x8e\x05\x7c\xf6\xa9\xbd\x48\x3b\xd3\x37\x46\x23\x42\xed\xeb\xbe\xd2\x92\x73\xad\x91\xd6\x5f\x30\xef\xd1\x32\x88\x8c\x5e\x2a\x15\xd9\x3b\x9b\x1c\x34\x6b\x95\xf1\xb7\xb6\x60\x59\x19\xc5\xa1\x4d\x77\xd6\x06\xef\xf9\xf1\x62\x52\x1c\xfd\x76\x21\x55\x49\xfb\x76\x32\x34\xb9\x18\x6e\xcb\x95\x62\x58\x06\x8e\x99\x42\xf5\xa3\xf7\x9a\x35\xab\x0a\x07\x26\x22\xd3\xa7\x76\x7a\xee\x79\xd1\xc4\xde\x69\x48\xbf\xb9\xe1\x58\x02\x1f\x6e\x0e\x52\xbe\x40\x06\x12\x93\x7c\xbf\x23\x90\x2c\x89\xe4\x50\x30\xf7\x35\x02\xf6\x61\x34\x4c\x36\x38\x8f\x2d\x9e\x44\x3d\x1a\xfa\x70\x73\x8e\x69\xba\xf2\x20\xcd\xc3\x0e\x6c\x29\x80\x7b\x90\xea\x11\xc5\x6b\xe8\xc0\x16\x58\x08\x0f\x56\x3d\xda\x32\x9e\x75\x60\x95\x1b\xb8\xf3\x80\xf5\x33\x93\xd5\xf2\xaf\x9c\x99\x9d\x0e\x59\xb7\x36\x7d\x7a\x86\xb0\x79\x14\x3a\x18\x16\xae\x90\x96\x42\xb2\x75\x27\x54\x7e\x90\x50\xca\xd6\x6b\x4c\xb3\xca\x1c\xa0\xc1\xf0\x7a\x12\xf6\xd4\xc6\x63\xf3\x22\x43\xb5\x16\xd0\xef\xb0\x9b\x83\xb9\xaa\x32\xdb\x51\xbc\x26\xa9\xbe\x12\x53\x02\x15\x64\x9e\x83\xd3\x5c\x51\x16\x05\xe3\x12\xb2\xd7\x98\x2e\x4b\x6c\x5e\x2b\xe7\x66\xee\x47\x4b\x96\x63\xba\xd4\x5b\x32\x9c\xb1\x8d\x5e\x7d\xfe\x8c\x37\xd8\x0c\x0b\xbd\x3d\x03\x3c\xd7\x7f\x77\x72\x65\xaf\x1d\x5c\x15\xde\x5c\x18\x71\xf5\x5c\xac\x74\x6d\x61\xa0\x32\x96\xde\x02\xd7\xaf\xe4\x52\x2b\xda\x72\x6e\x70\x97\x39\xc1\xcd\x75\x56\xfe\x41\x8e\x2e\xcb\x8d\x0f\xac\x84\xda\x71\x7d\xad\xa4\x15\xed\xa3\x7d\xc1\xae\x47\xa6\xd6\x85\x1a\x7d\xaf\x5b\x66\xe4\x61\x14\xfd\x5f\xe1\x96\x75\x9d\x2b\x3b\xdb\xf5\xfb\x55\x97\x1a\xe0\xbf\x2e\xd5\x3f\xd1\xa5\xea\xc7\xfd\xcf\xf1\xa6\x7e\x57\x8f\x28\xa0\x4f\x47\x4f\xdc\x33\xf3\x66\xb0\xb0\x4f\xe4\x85\x8b\x57\x58\xac\x9a\xe8\xf2\xde\x59\xb3\x9e\x92\xfc\x34\xe5\x7d\x95\x3a\xd7\x87\xab\x87\xd3\xc0\xbc\xeb\x65\x9d\x9b\x49\x75\x1a\x9c\x78\x3d\xd0\x6a\x56\x9d\x06\x66\x5e\x0f\xb4\x99\x27\xa7\xa1\xe9\x74\xe8\x1f\xc6\x35\xed\x9d\x5a\x29\x8d\xfa\x7c\x0f\x0a\x5b\x9d\xcc\x3c\x0b\xcc\xe7\x9e\xf7\x55\x81\x3e\x9a\x59\xa4\xdd\x17\x79\x79\xef\x22\xa2\x29\xdf\x15\xf2\x98\x3b\x06\x75\xdc\x66\x5a\x71\xd3\xb9\x5a\xf0\xf8\x57\x0d\x1d\xa7\x06\x9d\x57\xd6\xb8\xf4\x0e\xdc\x94\x8f\xf6\xdf\x96\x6f\x70\xfc\xc6\x61\xf9\xc2\x80\xb5\xdd\x70\xbc\x90\xc0\xd5\x08\x23\x74\x39\x36\x66\x26\x27\x74\x89\x04\x43\x44\x0a\x93\x72\xae\x77\x59\x55\x1d\x0a\x77\xf5\x38\x75\x46\xa8\x47\x3f\xec\xbc\x27\xf5\xfd\x96\x41\x8f\x3d\x9c\xf3\x1f\x7e\xd1\x6e\x05\xd2\x3f\x4d\x0e\x93\x15\xc9\x9c\x24\xeb\xfe\x48\xc7\x21\xd8\xb4\x59\xfb\x78\xb0\xf7\x27\xf7\x27\xff\x1b\x00\x00\xff\xff\x45\x16\x1e\x7f\x12\x7a\x00\x00")
+var _assetsFilesJsPipelineJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\x7f\x93\xdb\xb6\xb1\xff\xeb\x53\xc0\x8a\x1b\x92\xb6\x4c\xe9\xae\x49\xda\x48\x51\x3a\xc9\xd9\xce\xf8\xd5\x4e\x3c\xb9\x73\xda\xcc\xbd\x6b\x07\x22\x21\x89\x39\x92\x60\x01\x50\x3a\xc5\xd1\x77\x7f\xb3\x20\x48\x02\x20\x28\xe9\xfc\xa3\xed\xeb\xf4\x74\xa3\x3b\x11\x8b\xdd\xc5\x62\xb1\x58\x2c\x16\xd0\xf8\x11\xba\xa0\xc5\x8e\x25\xab\xb5\x40\xe7\x93\xf3\x33\x74\xb5\x26\xe8\x2a\xd9\x51\x84\x4b\xb1\xa6\x8c\x0f\xd0\xa3\x01\x7a\x84\xae\xd6\x09\x47\x97\xb4\x64\x11\x41\x17\x34\x26\xe8\x39\x65\x19\x4a\x38\xe2\xe5\xe2\x17\x12\x09\x24\x28\x12\x6b\x82\x04\x61\x19\x47\x74\x29\x3f\xbc\xa2\xbf\x26\x69\x8a\xd1\xeb\x72\x91\x26\x11\xa0\x79\x99\x44\x24\xe7\x64\x84\x36\x21\x3a\x0f\x27\x21\x7a\xb1\x44\x18\x45\xb4\xd8\x35\x75\x5e\xbf\x44\x5b\xcc\x51\x4e\x05\x8a\x13\x2e\x58\xb2\x28\x05\x89\xd1\x36\x11\x6b\x24\xd6\x09\x30\x84\x96\x49\x4a\x46\xe8\x67\x5a\xa2\x08\xe7\x88\x2e\x04\x4e\x72\x44\x73\x82\xb0\x40\x6b\x21\x0a\x3e\x1d\x8f\xb3\x8a\x78\x48\xd9\x6a\xfc\xea\xf5\xcb\xf1\x79\x38\x19\x87\x03\xf4\x68\x3c\x18\x8c\xc7\xe8\x92\x88\xb2\x40\x45\x52\x90\x34\xc9\x09\xca\x68\x4c\xd2\x41\x94\x62\xce\xd1\xeb\xfa\xe1\xdb\xc1\x00\x21\x84\xc6\x63\x44\x36\x84\xed\xd0\xe7\x88\x93\x88\xe6\x31\x97\x8f\x5f\x7c\x7f\xf5\xec\xc7\x9f\xbe\x79\x89\xe6\xe8\xf3\xc9\x64\x32\xab\x80\xb1\x10\x38\x5a\xa3\x39\x5a\xe2\x94\x93\x99\xf6\x8c\xc4\xe6\xd3\x98\xe1\xd5\x2a\xc9\x57\xdd\xa7\x97\x02\x33\xf1\x9a\xf2\x44\x24\x34\x47\x73\x94\x97\x69\xda\x96\x3e\xdb\x90\x5c\x18\x4f\x33\x5a\x72\x12\xd3\x6d\xde\xa2\x92\xcf\x71\x51\x90\x94\x64\x36\x38\x89\x13\x41\x99\xe3\x51\xb4\xc6\xf9\x4a\xb2\x59\x01\xd7\xad\x5f\x52\xa6\xda\x00\xec\xa6\x49\x7e\xcb\xa1\xbb\x15\xee\x4a\x1a\x05\x65\x26\x15\x5c\x0a\x7a\x89\x37\xc4\x78\xd8\xc8\x7b\x8e\x86\x43\x45\xfa\x8e\x44\xa5\xe8\xc8\x21\xc5\x5c\x5c\x0a\x2c\x4a\x6e\x20\x10\x94\xa6\xfc\x2a\xc9\x08\x2d\x4d\x72\xb2\xe0\x85\xc5\xfb\x0a\x97\x2b\x02\x08\xde\xca\x8f\x3a\x07\x17\x45\x39\xd5\x1e\xc3\x6f\x8a\x17\x24\x9d\xa2\xe1\xc5\xeb\x37\xc3\x91\x51\x22\xf1\x4c\x25\x1b\x6d\xc1\x7e\xd4\x41\xfa\x8a\x64\x94\xed\xfa\xf0\x56\xa5\xf7\x44\x8d\x37\x38\x49\xf1\x22\xfd\xb0\x0c\x37\x58\x3f\x30\xc7\x7b\x25\xf8\x88\xe6\x5c\xb0\x32\x12\x94\xf9\x81\x86\x1c\xc6\x6f\xb8\x62\xb8\x80\x11\x92\x93\x2d\xfa\x85\x26\xb9\x08\xe3\x04\x87\xdf\xc1\xd3\x99\x09\x59\xe0\x82\xb0\x0e\xe4\x6b\x78\xea\x9b\x1c\x43\xc7\x3d\xf4\xbd\x4f\x64\x8d\x27\x75\x7f\x78\x81\xc9\xba\x1c\xe5\x53\x8d\x0b\xb3\x78\x9b\xc4\x62\x3d\x45\x67\xe7\x7f\x9c\x98\x05\x6b\x02\xf6\x71\x8a\xfe\x70\x6e\x15\xac\x58\x12\x5f\x26\xbf\x92\x29\x3a\xb3\x4a\x62\x86\xb7\xdf\xb1\x24\x9e\x22\xc1\x4a\x62\x96\x31\x02\x66\x2d\x12\x57\x0c\xe7\x3c\xc5\x82\xb8\x80\x16\x38\xba\x5d\x31\x5a\xe6\xb1\xdd\x3b\xf0\x8a\x68\x4a\xd9\x14\x79\x6c\xb5\xc0\xfe\xf9\xef\xcf\x47\xa8\x7d\x9b\x84\xbf\x0f\x3c\xa3\x86\xd6\xf3\xf0\x1b\x93\x25\x2e\x53\xf1\x32\xc9\x6f\xa7\x28\xa2\x69\x4a\x22\xb0\x34\x3c\x84\xa1\x1d\x46\x29\xcd\x89\xef\x81\x89\xed\xc8\x0f\xb3\xdb\x6f\x6a\xd5\x71\x31\xbd\xc1\x69\x12\x63\x41\x2e\x68\x9e\x57\x48\xa7\x68\x59\xe6\xf2\x3f\x7f\x93\x90\xed\xe5\x08\x65\x78\x95\x13\x71\x39\x42\xf0\xf9\xaa\xfe\x7c\xa5\xab\x49\xfd\x93\x2c\x91\xaf\xc0\xd1\xa7\x9f\xd6\x35\xc3\x15\x11\xdf\x08\x35\x31\xf8\x1e\x58\x9e\x27\x20\xa9\xc2\x0b\xd0\x7c\x3e\x47\x5e\x92\x7b\x01\x62\x44\x94\x2c\xd7\x4d\x8a\xfe\x03\x98\x81\xfe\xa5\xac\x01\xff\x5d\x1d\xab\xa2\x4a\x15\xbb\x2d\x3f\x57\xa7\xf0\x33\xeb\xeb\x8f\x7d\xd0\x16\x49\x05\x4e\xf1\xce\x0b\x42\x2c\x04\xf3\xbd\xf2\xf6\x49\x12\xd1\xdc\x1b\x21\x6f\x81\x73\xcf\x0d\x1a\x71\x6e\x0d\x86\x5a\x39\x3e\x79\xfe\x7c\x32\x99\x4c\x3c\x37\x35\x39\x0a\x32\x7c\x4b\xbe\x83\x01\xcd\x7d\x55\xb4\xaf\x46\x30\xc7\x1b\x62\x0c\x5d\x90\x98\xac\x52\x1b\x76\xbb\xc3\xa2\x94\x60\xf6\x22\x17\x84\x6d\x70\x6a\x81\xb6\x54\x1b\xca\x75\x99\x61\xc5\x35\x06\x6a\x9a\x8c\x96\x82\xb0\x10\xe6\x83\x1f\x09\xa7\xe9\x86\xc4\x7e\x70\x3d\xb9\x09\x4b\x96\xa2\x07\x73\x34\xac\x07\xfb\xd0\x66\xa8\xea\x30\x27\xe2\x0d\x66\x48\x24\x22\x85\xd9\xe9\xa1\xef\x85\x30\x25\x82\x4e\x87\x35\x32\x59\xe8\x05\xa1\x20\x77\xa2\x96\x4c\x23\x05\x28\x93\xa4\xdf\xe4\x12\x2e\x1e\x82\x36\xb4\x56\x25\x14\xf4\x7f\x2e\x7f\xf8\xde\x0f\xc2\x88\xa4\x29\x0f\x53\x92\xaf\xc4\x1a\x7d\x8d\x26\x36\x8b\xe3\xb1\xf4\x93\x60\xac\x21\x2e\x28\x23\x28\xc3\x3b\x44\xf3\x74\x87\x16\x04\x45\x8c\x60\x70\x7d\x68\x1e\x11\x09\x57\x73\x27\xfd\x2e\xbc\x21\xb1\x8d\x0c\xe7\x31\x4a\x04\x47\x74\x9b\x13\x86\x18\x89\x28\x8b\x2d\xa8\xa2\x14\xbe\x57\x23\xf2\x46\x52\xf8\xa3\x4a\x18\x23\xb4\x10\x14\xfb\xc0\x7b\x08\x16\x2a\x5f\x25\xcb\x9d\xef\x68\x57\x10\x04\x61\x0c\x56\xc2\xc0\x0c\xbf\x7e\x80\xe6\x5f\x2b\xc6\x9f\x27\x29\xb9\x84\x46\xf9\x12\x7b\x60\x00\x6b\x32\x85\xdf\x0b\x4a\x6f\x13\xc2\x43\x4e\x4c\xee\xaa\x9a\x26\xac\x64\xa8\x86\x41\xf3\x8a\x77\x13\x84\x97\x51\x44\x38\xf7\x87\x8d\x2f\x07\xfa\x1c\x0f\x03\xa7\x36\x40\xa7\x3e\x30\x15\x16\xbd\x3d\xa8\xb1\x9c\x08\x53\xd3\x01\x7d\xb8\x48\xf2\x58\x7e\x0c\x46\xe8\x0b\x18\x79\x06\x39\x8d\x68\x4a\x71\xdc\x19\x5c\x1f\x52\xd1\xfb\x44\x55\x8b\x79\x65\x88\x39\x98\x75\x47\x79\x53\xe9\xc1\x1c\x7c\x35\x9b\xe4\x43\x30\x7a\xbe\x37\xc6\x45\x32\xde\x9c\x8d\x17\x65\x74\x4b\xc4\xb8\xae\x34\xf6\xd0\x63\x44\xf2\x88\xc6\xe4\xcd\x8f\x2f\x4c\x84\xd6\x94\x02\xbf\x7e\x8c\x05\x1e\x21\x2e\xdd\x3d\xa9\x40\x26\xb1\xfa\x07\xfa\x09\x40\x61\xb4\xc1\xdf\x10\x08\xa0\xf9\x1c\x9d\x4f\x26\x41\x4f\x9d\x46\x0a\x95\x06\x2f\x19\xcd\xa4\x0e\xc3\x5b\x58\x60\xc6\x89\x8f\x05\x5d\x48\x16\xc2\x8c\x70\x8e\x57\x24\x08\x34\x89\xd8\xaf\xe3\xe6\xa2\x47\xc8\x07\x70\x4a\x06\xab\xe6\xeb\xc6\xc6\xfe\xe9\xb1\xd8\xf6\x6b\x3f\xb0\x1e\x58\x4f\x82\x70\x89\x93\xd4\x31\x76\x09\x63\x94\x1d\x90\x7f\xdd\x30\x46\x32\xba\x21\x6e\x05\xd2\x5f\x6b\x9c\xc7\x29\x79\x06\x58\x15\xee\xd9\x31\xd6\x7a\x06\x4c\xb5\x48\x30\x27\x24\x29\x0d\x7d\xf5\x00\xee\x48\x5b\xff\xa1\x3f\xfc\x44\x55\x1b\xba\x26\x49\xaf\x00\xd7\x93\xb0\x27\x04\xd6\x53\xdc\x9b\x22\x2f\xa7\x39\x69\x1d\x27\x98\x2b\x9b\x0f\x30\x35\xc7\xe0\xb6\xd1\x9d\x77\x22\x36\x9c\xa6\x7d\xc8\xc2\x82\x72\xe1\x0f\xeb\xd1\x53\xb3\x69\x8e\x0c\xcb\x0c\x9b\xf4\xf4\x55\x87\x72\x68\xeb\x8f\x26\x96\xbd\x35\xde\x4e\x19\x6b\x07\xd4\x71\x3f\xb0\xb4\xa8\x57\x69\xba\x9d\xdf\xf1\x42\x54\xe7\x26\xfc\x59\xdd\x8b\x46\x07\x2b\x87\xcb\xec\x67\xd3\x49\x51\x3c\xa2\xb7\x5d\x03\x56\x0d\xa7\x8b\x35\x89\x6e\xc1\x44\xc0\x34\xa7\x23\xb7\xda\xa9\xe0\x1c\x86\x5d\x16\x1b\xa6\x1d\xfe\x84\xf5\xea\x5f\x93\x8f\x62\xaa\x35\x8f\x4d\x07\x57\xbc\x8c\x87\x27\x5b\x45\xd9\x4b\x3d\xbd\x63\x2a\x76\xc1\x68\xe1\x7b\x71\xc2\xc1\x1e\xc5\xde\x48\x3a\xe5\xc1\xac\x53\x4b\x32\x6d\x2c\xaa\x75\x8b\x37\x1b\x74\x2a\x80\x8b\xc4\x75\xd8\xeb\xa1\x02\x1e\xde\x5c\x0f\xab\x92\xe1\x4d\x97\x10\xd4\x93\xde\xaf\x4d\x23\xac\x9e\xba\x6b\xe4\x34\x26\x9d\x0a\xf2\x61\x0f\x6b\xd2\xc7\x45\x73\xe4\x7d\x02\x1e\x6e\x17\x29\xc4\x2c\x7c\xc0\x7c\x4b\x76\x28\xc9\x15\x4b\xb6\x06\x74\x98\x46\x73\x05\x79\x7d\x4b\x76\x37\xb3\xc3\xc0\x17\x34\x87\xc0\x93\x5c\xa2\x6a\x73\xcc\x8a\x88\x0b\x92\xa6\xfe\x2d\xd9\x05\xa7\x62\xf8\x29\x21\xdb\x1a\x8b\x5c\xc3\x86\xcb\x24\x8f\xe1\xe9\xb7\xbb\x57\xb0\x6a\xf5\x4d\x78\xdd\x9c\xe8\x3f\x7c\x9b\x88\x68\x8d\x2a\x68\xa9\xbc\x1d\x5f\x46\x7f\x45\x98\x13\xe4\x3d\xc7\x09\xe8\xce\xb4\x17\xac\x59\x54\xa0\x79\xbb\xaa\x98\x0d\x0e\x40\xa3\x05\x23\xf8\xb6\x1f\xa4\x22\xfc\x23\xc1\xf1\xce\x9b\x1e\x83\x2a\xf3\x3c\xc9\x57\x27\xf3\x07\x9e\xd7\xf3\xe7\x1f\x82\xbf\x6f\x4b\x7e\x9c\xbd\xc6\x78\xdd\x83\xc1\xe7\xcf\x3f\x8c\x00\xaf\x08\xcb\x92\x1c\x16\x09\xde\xf4\x44\xd0\xfb\x30\xfa\x87\x6f\x3e\xff\xe3\xd9\xd3\x0f\xc1\xe8\x05\x23\xc7\x48\x57\x80\xaf\x49\x1e\xdf\x87\xc5\xa7\x5f\x7c\xf1\xfb\xc9\x67\xef\xce\xa2\x66\xb4\xf5\x57\x77\x74\x86\x32\x78\x24\x17\xe6\x72\xa1\xcf\xe5\xbf\xe0\x0c\x45\x02\xa6\x69\x7a\x0b\x0e\xb6\x64\xcc\x4d\xa9\x0d\x60\x3d\xab\x02\xa7\x12\x6b\xc1\xa8\xa0\x62\x57\x90\x90\x91\x3c\x26\x2c\xc4\x45\x91\xee\xac\xd1\x0e\x90\x07\x4c\x89\x5c\x65\xa2\x39\xba\xee\x31\x58\x8d\x2d\x4c\xe6\x93\x19\x4a\xd0\x57\x56\xf3\xf4\x46\x91\x6c\x41\xe2\x7a\xc9\x3a\x43\xc9\xe3\xc7\x87\xec\x47\x33\xdb\x9a\x96\xef\x18\xfa\xeb\xe4\x26\xd0\x9f\x42\xfb\x61\x92\xf6\xa2\xa6\x4e\x53\xdb\x3b\x44\x1f\x5e\xb2\xf5\x61\x51\xf2\xf5\x49\x74\xdd\x22\x32\x7d\x9b\xe3\x4f\x65\xa3\x0b\x1a\x57\xb3\x69\x45\x78\x54\xb1\x32\x3a\x20\x5d\x1e\xe1\xce\x72\xb6\x4b\xe4\x63\x38\x59\x5d\x5e\x6b\xfe\xfe\x4c\x76\x7c\x84\xc8\x5d\x41\x22\x41\x62\x5d\xda\x30\x2b\x36\x60\x30\x3b\xbf\xdd\xcf\x06\x5d\xa5\x42\x73\x04\x6a\xf5\x95\x81\xf1\x90\x06\xb5\x38\xaf\x8d\x3a\xd7\xc9\xcd\x8d\x11\xac\xaf\x5f\x7f\xc1\x09\xf8\x7e\x53\xf8\xdf\x8a\xb3\xc2\xaf\x9a\x20\xfa\x8a\x5b\x23\x39\x75\xd6\x86\x49\xc8\x59\x02\xe6\xbf\x53\xd0\x76\x4e\x1d\xec\x36\xa4\x51\xd0\x58\xf7\x38\xc2\x82\xc6\xdc\x6e\xbf\x82\xab\x7d\x0d\x09\x73\x5d\x55\xb4\x46\x70\x83\xb6\x91\x13\xf8\x32\x05\x8d\xc3\xe6\x41\x07\x7b\x4d\x21\x81\xcd\x10\x13\xb4\x15\xf7\x4d\x98\xc4\x26\x29\x78\x09\xb6\x73\x20\xd3\xea\x27\xf1\xcd\x75\x3f\x4a\xe9\x6e\xdc\xa0\xc7\x73\x74\xd6\xc5\xbd\x47\x11\x96\xae\xc9\xd5\xae\xa8\xd6\x84\x01\x7a\xbb\x1f\x58\x50\xd2\x7f\x3f\x42\x41\x5a\x8a\xca\x75\x40\xbf\xfd\x86\x4e\x81\x86\x9e\xec\x35\x24\x5a\x65\x68\xdf\x50\xa9\xd3\xb0\x6a\x49\xa7\xca\x7e\xe0\xfe\xb4\x77\x68\x83\x52\x85\xfe\xce\x32\xfa\x16\xbc\xc6\x53\x5d\xc9\xda\xee\x1f\x77\x1c\x0d\xf4\x0e\x2c\x2a\x3a\x89\xd0\x1c\xb5\xb0\x95\xff\x1b\xaa\x81\x87\x1e\xeb\x63\x56\x96\x28\x21\x39\x4a\xda\xe1\x16\x74\x68\xc9\x1d\x16\xf8\x30\x47\xaf\xb0\x58\x87\xcb\x94\x52\xe6\xfb\x8a\x85\xb1\x66\x85\x1e\xa1\xb3\xc9\x24\x08\x05\xbd\x94\x2b\x60\x3f\x40\x8f\x91\xf7\x3b\x6b\x9e\xb7\x17\x02\xca\xad\x32\x60\xc6\x63\xf4\x62\x89\x32\x88\xad\xb6\x9c\x22\xcc\x08\xda\xaa\xc6\x89\x35\xce\x11\xab\xda\x33\x52\xf8\x16\x69\x49\x0c\x34\xa0\x97\x7d\xd2\xf9\xba\x4f\x3a\x2e\x8d\x3b\xe2\xa7\xee\x5d\xdc\xe3\x7c\xd7\x92\x80\xe0\xaf\x68\x64\x3c\x82\x25\xab\x42\x2a\x28\x62\x24\x3e\xca\x76\xdb\x41\xae\x90\xf4\x09\xbe\xbe\xc5\x63\x83\x1f\xf4\x57\xed\x5c\x84\x05\xa3\x2b\x46\x38\xf7\x46\x0e\xfc\x1e\x23\xcb\x27\x52\x17\xbc\x29\x92\x7f\xbb\xc6\x17\x36\xa0\x52\x4f\xee\x4f\x51\x66\x99\x60\x4b\x8b\xef\xe5\x59\xd5\x83\x46\xc3\xb1\x1f\x1c\x89\xb0\xa9\x06\x17\x29\xde\x15\xb8\xe4\x3d\x1b\x23\x4d\x8c\xa2\x27\xce\x40\x8b\x1a\x9f\x63\x17\xa7\xb3\xe1\x03\xcf\x9f\x44\x09\x8b\x52\xe2\xf5\x57\xeb\x46\xa2\x9a\xfe\x9b\xb6\x43\xe2\xb0\xf8\x8e\x84\x8f\x39\x64\x23\xe8\x9c\xff\xf3\xb6\xa9\x94\xdc\x15\x07\x9a\x54\x8f\xc5\xfe\xac\x00\x9b\x44\xb0\x4c\xe9\x76\x38\xfa\x48\x61\xb5\x7a\x93\xd3\x8e\x65\xbf\x1d\xf4\xba\xcc\x27\x04\xa8\xec\x7e\xf8\x40\x81\xaa\x6e\x47\xc3\x6b\xaf\xd5\xb5\x1d\xd0\x66\x0f\xb7\x13\x4d\x00\x65\x50\xf1\x1a\xd0\x87\x38\xbe\x80\x1c\x1a\xa9\xc9\x38\x25\x4c\x3c\xd9\x62\x06\xa6\xd0\xa5\xc3\x6d\x3d\x98\xb3\x7c\xaf\xf0\x82\x70\x2d\xb2\xd4\x57\x11\x09\x48\x32\xa9\xb6\x92\x50\xb5\xc1\xa0\x23\xe9\xe8\x08\x0c\xaf\x43\x2a\x62\x6d\xeb\x76\x75\x84\x16\xff\x55\x91\xff\x64\x15\x51\xf1\x7b\x43\x4b\x80\x86\x0a\xa6\xbe\x73\x5c\xff\x3d\xf6\x08\xba\x3b\x0e\x7d\xea\xa9\x10\xff\x57\x43\xff\x93\x35\x34\xc2\xf9\x06\x73\x48\x28\x32\x94\x54\xba\xb9\xb2\x08\xcd\x9d\x59\x4e\x2d\x42\x00\xe5\xc9\xaf\x10\x8a\xfa\x41\xe6\x65\xea\x13\x37\x14\x84\x10\x66\xae\xe8\x84\xd2\xf3\xea\x00\xac\x5b\x80\x2a\xef\x49\x87\x50\x7b\x32\x00\xd8\x70\x2e\xff\x72\xc8\xa7\x94\xa9\x89\xe6\x7e\x8c\x8b\xdd\x50\x26\x2c\xca\x3d\xc4\xb6\x4f\xa0\xaa\x5e\xb1\x51\x19\xc8\x79\x54\xc5\x03\x57\x37\x1b\x3b\x6b\xfe\x36\xc9\x63\xba\x0d\x42\x46\x80\x47\xdf\x77\x04\x4d\x64\x72\x9a\x6a\x62\xbd\x74\xd2\x25\x3f\xeb\xf2\x20\x5b\x00\xc9\x09\x4f\x93\x8c\xe4\x1c\x92\xa5\xfc\x5a\x88\xa3\x46\x5a\xc1\xcc\xbd\xdf\xa7\xa1\xa0\xb9\x6d\x14\x16\x29\xce\x6f\xa7\x52\x22\xdb\x35\x21\xe0\xf2\xc2\x06\x90\x6c\xf0\x08\xdd\x8d\xd0\x6e\x84\x62\x92\xd6\xdb\x41\x15\xbb\xb2\xd2\x2b\xa8\xf3\x17\xa8\xe3\x82\x9e\xe9\x59\x46\x1a\x25\x65\x84\x62\xba\xcd\xbd\xa9\x45\xa9\x43\xe2\x75\x05\xfc\x94\x6e\x73\x03\xee\x08\xf2\xb2\x90\xa8\x4f\x43\xfe\xa6\xe8\xa2\x36\x71\x43\x5a\xda\x14\x56\x19\xe4\x4e\x64\x24\x2f\x95\x88\x20\x6b\x6b\x84\x7a\x28\x40\x9d\x1f\x41\x7b\x2f\xd2\x24\xba\x75\xc0\x3a\xe8\xa8\x84\x56\x8b\xd4\x61\x3a\xaa\xce\x71\x52\x4e\x4a\xb2\xdb\xe9\x86\x30\xd9\xa4\x93\x28\xc9\x5e\xff\x61\x43\xd8\xfd\x09\xa5\x04\x6f\x88\x37\xbd\x0f\xa1\x97\x50\xe5\xfe\x94\x08\x74\xec\xfd\x28\x3d\x83\x2a\x2e\xf0\x83\x84\x9a\x21\xd3\xad\xf9\xa1\x86\x4d\x4d\xcf\xd4\xed\x93\x1a\xd6\x6a\xf8\x49\xfa\x07\x4b\xd3\xce\xf8\x3c\x42\x09\xea\x28\x32\x72\x94\x02\xb0\xa3\x11\x3a\xea\x66\x74\x9e\x8e\xfa\x4d\xe1\x40\x6c\x4d\x5e\x9a\x9d\xd6\x0c\x6e\xe3\x29\xd4\xe9\xee\xb6\x8d\xaf\xac\xb1\x0c\x8c\x9b\x71\x2c\xf9\xc8\xb6\xc6\x30\xbf\xdd\xa1\x79\x25\xf7\x90\x2e\x97\x9c\x88\xbf\xa2\x27\x1a\x0d\x23\x79\x3e\xbc\x43\x8f\x90\x44\x14\xf2\xbb\xa0\x83\x69\x67\x61\xfa\xf9\x00\xa6\x5d\x8b\x69\x67\x62\xd2\x78\x16\x75\x46\xaf\x2f\x35\x42\x9b\x0f\x74\x49\x9d\xa0\x86\x9a\x90\x8e\x0b\xa8\x01\x85\x36\x65\x49\x7e\xa9\x60\x27\xe1\xf9\xe7\x66\x19\xbe\xab\xcb\xce\x8d\x02\xd2\x0a\x82\x25\xab\x24\xc7\xa9\x9c\xca\x5b\xfe\xe5\xe0\x80\xfd\x79\xf9\xf7\x51\x43\xc5\x22\x5e\x77\xc8\x1c\xf9\xa4\xe9\x9d\xdf\x7e\x43\x24\x8c\xd2\x84\xe4\xb2\xab\x1e\xca\xbe\x0a\x54\xb1\x1f\x84\x29\x59\x8a\x60\xe6\xc0\xf3\xb3\x8e\xe7\x67\x1d\xcf\xcf\x2e\x3c\x82\x16\x16\x9a\xa2\x16\x58\x05\x73\x45\x5f\xd2\x08\x57\x3a\xed\x2b\xee\x46\x35\x2d\xab\x6a\x4e\xb6\xb5\xa8\x6a\xfd\x41\x8f\xab\xe6\xb7\x80\xa0\xdc\x0d\xe0\xd7\xf3\x56\xf6\x9f\x7e\x8a\x9a\xe7\x5f\xcd\x1b\xb9\x3b\xfd\x1b\x5b\x73\x26\x23\xa4\x27\x0e\x5a\x70\x92\x99\x86\xe8\xa8\x21\x33\x42\x45\x78\x07\x6f\x87\xb5\x4e\x37\x15\xc6\xb0\x6f\x19\x93\xc4\xa2\x94\x72\xf2\x4d\x9a\xea\xe3\xaf\x19\x18\x2b\x57\xcc\xc5\x3d\x6c\x60\x3b\x07\xdd\x4d\x25\x91\x29\xda\xed\x67\x7d\x1c\x59\x5e\x00\x7a\xdb\x45\xdc\x3d\x2c\xa3\xf0\x1c\x9d\xeb\x35\x6c\x5a\xba\xfb\xb5\x07\x15\xbd\x1b\x6d\x83\xce\x51\xd7\xa0\x74\xca\x6c\x7f\xb2\x28\x8d\xe3\x39\x9b\x66\x8b\xb9\x85\x82\xe1\x5c\x6d\x8d\x6a\xc5\xfa\x76\x22\x14\x86\xbc\x48\x13\xe1\x7b\xa1\x17\x5c\x9f\xdd\x84\x82\xbe\xa4\x5b\xc2\x2e\x30\x44\x29\x9d\xad\x86\x4a\x47\xda\xec\x68\xf2\x21\xb7\x43\x6b\x31\xb0\x0c\x09\xf9\x35\xcb\xb0\x26\xb2\x72\xe3\x3d\x55\x39\x14\x98\xad\x88\x3d\xf2\x65\x1b\x5f\xc4\x66\x93\xf5\xbd\xaa\x66\x42\x31\x8e\x02\x3d\xa8\x32\xbc\x9a\x3c\xec\xe6\x38\xd0\xbc\xc6\xe8\x4c\x57\x57\xd5\xbb\x08\x35\xae\xda\x05\x26\x90\xc6\x91\x48\x36\x04\x8e\x4e\x00\xad\xf6\x93\xdd\x2d\x72\xdb\x49\xea\x17\xc0\x49\x91\xd4\x7f\xa1\x84\x76\x76\xa3\xc6\x63\xb4\x06\x4f\x50\x6e\x1e\xad\x71\x0a\xe7\xe2\xaa\xe3\x66\x68\x41\x96\xb0\x7d\xd1\x1c\xc1\x32\xea\x49\xd6\x9b\x53\x67\xe6\x98\x54\x0b\xb3\xba\x95\xcd\x62\xcb\xa6\x6d\xc8\xb5\xc2\xe5\x02\x81\x97\xd6\x62\x2e\x4f\x03\xfa\x6f\xe1\x90\x8b\xd1\x59\x23\xd9\xce\xa9\x7c\x47\x7b\xb9\xa0\xbe\xa2\x5a\x9e\x80\x26\xdc\x1e\xd4\x95\x6a\xa8\x2a\xa4\x3a\x28\xb3\xa4\x2c\x93\xe6\xcb\xef\x4b\xcf\xd5\xd8\x27\xb1\x53\x18\x87\x03\x06\x23\x38\xc8\xd7\x67\x3e\x4f\xf1\x8a\x35\x99\x99\x5a\xd8\xa7\xcc\x1d\xbd\x43\x73\x67\x87\x6d\xec\xee\xd8\xa8\x24\xdc\x2b\xa8\xab\x5b\x96\x3e\xac\xe6\x19\x0b\x13\x4a\x3b\x36\xd7\x2b\x96\xea\xb8\x8e\x46\x48\x13\x76\x37\xb8\x3a\x1e\xa3\x55\x4a\x17\x38\xd5\x3a\xb5\x29\x04\x4d\x7b\xd0\x3e\x87\x79\xfd\x81\xd1\x75\x01\x7a\x5b\x6f\x4a\x68\xbd\xd5\x2c\xeb\xd4\x96\x67\x8b\xa1\xd9\xf2\x54\x39\x95\x30\x51\x06\x33\x47\x7a\x7f\x5d\xdb\x96\xe6\x91\x1d\x90\xba\x1a\x84\xa7\x98\xf8\x86\x31\xba\x5d\x13\x1c\xbf\x82\x00\x86\x57\xa9\xaa\xa7\xbb\x61\x0f\xfd\x98\x46\x25\x18\xf6\xc0\xb1\xe0\x6f\x82\x1f\xb2\x31\x9e\x8a\xd0\xc1\xff\x4f\xeb\xd9\xd3\x72\xde\x65\x8d\xb2\x70\xc2\x3f\xcb\xdb\x0d\xbf\xbd\xbd\xd7\x06\x5d\xa6\xc1\x43\x1b\x4c\xd4\xd2\xf7\x50\x10\xf2\xff\x3e\xe7\xfe\xa4\x95\x5a\x4b\x1c\x0a\xab\x61\x0f\xea\xd9\x6a\x1a\x70\x20\xe7\x9b\xc0\x89\xbe\xf5\x05\x3e\xde\x24\x03\xda\x07\xad\x01\xb3\x0c\x7f\xef\x61\xb8\x1f\x38\x0d\xb7\x44\xa2\x0c\x56\xd7\x16\xa2\x7d\x9f\x41\x71\xad\xdc\x0e\x36\x19\x2a\x18\xa6\xc4\x6c\xd4\x03\x28\xaf\x8e\x65\x54\x59\x56\x5e\x00\x43\xab\xfb\x54\xa5\xe6\xc8\x93\x6f\x9d\xed\x5f\x38\x1a\xbd\xa6\x5b\x79\xdc\x48\x3a\x5d\x24\xae\x7b\x07\xe1\x05\xdd\x10\x84\xd3\x54\x96\x52\xb1\x26\x75\xee\x99\x4f\xee\x22\x52\x08\xb4\x5d\x93\x1c\x0a\x6d\x94\x35\x86\x84\x23\x8c\x0a\xcc\xe4\xd0\x30\x80\x00\x4f\x28\xe8\x73\x46\x73\x23\xe0\xd8\x0c\xc2\x36\x21\x82\xe6\xb9\x4c\x12\x80\xa1\xcf\x7d\xa8\x18\x84\x4b\xca\x9e\xe1\x68\xdd\x5a\x4c\x18\x2b\xc1\x5b\x78\x6f\xb1\x76\xbb\xa2\x96\x5d\x2b\xa4\x8a\x39\x2f\xb0\xe5\x62\xf1\x00\x49\x19\x8e\x4a\x61\x99\x4b\x29\x57\x5c\x1d\xef\xf8\x37\xc5\x7b\x75\xbb\x64\x2a\x82\x1d\xc1\x67\x0d\xd5\x51\xd3\xe3\xdf\x7e\x4b\xef\xe4\x19\x34\xa0\xd4\x4c\x9a\x8a\x09\x6b\x38\x5a\x34\xdb\x31\x61\x50\xd6\xc6\x4a\xcb\x04\xf0\xc8\xd3\x24\x82\xa5\x52\xbf\x6f\x3a\x1b\xb8\xdc\x5a\x59\xef\x5a\xbe\x2b\xad\x7c\x72\xa6\xa5\x40\x41\xd7\x3c\xb0\x7d\x57\xd3\xc5\xbd\x09\x13\xfe\x1d\x64\x51\x5d\xed\x0a\xe2\x77\xba\x4d\xc5\xab\xcd\x03\xc4\x57\x4d\xdb\x4d\xe0\x46\x2e\x53\x74\xdd\x29\x80\xdf\x16\x8d\x52\x68\x89\x29\xfc\x51\x9a\x73\x07\xb2\xfa\x55\x72\x22\x73\x71\xbe\x23\x34\x23\x02\xce\x5b\x83\x9f\x26\x97\x44\xde\xe4\x77\xde\x08\x56\x48\xde\xd9\x04\xfe\x75\xe2\xb0\x0f\x71\xc0\xeb\xc6\x7c\x64\xea\xf6\xe0\x9d\xda\xdf\xdb\xf6\x77\x69\xf7\xfb\xb4\xd9\xd5\xde\x1e\x1e\xbe\x2d\x85\xe8\xcc\xb1\xa7\xf0\x70\x36\x39\xc6\x45\x7d\xe2\xb9\x2c\xa6\xe8\xda\x4d\x00\x5e\x02\xaf\xbe\xc7\x19\x99\x22\x4f\x65\x6b\xb8\x31\xa9\x8b\x20\xd4\xf0\x70\x9d\xe9\xd6\x7f\xd8\x14\xfd\xa1\x1f\x0f\xbc\x20\x3d\x66\xaa\x8e\x3f\x1c\x86\xf4\xa2\x92\x71\x0a\x21\xe6\x7a\x07\xf2\x40\x85\xfd\xc0\xf5\x78\x7f\x33\x72\x3e\xc7\xea\x8c\x77\x1d\xf7\xeb\xec\xb5\xe8\xaf\x83\x6e\x91\xfd\xd2\xdc\xa4\x26\xbf\xc8\x3b\x91\x50\xfd\x03\xa7\x6b\xeb\x95\x03\xaa\x02\x47\xb0\x1b\x27\x1d\x1d\x14\x51\xca\x62\x99\x06\xc5\x8f\x22\x32\x62\x49\xb2\x7a\xc8\x73\x5c\x5c\x51\x38\xe1\x7f\xa4\x21\xf5\xeb\x6e\xaa\x3c\x12\x15\x09\x1b\x0d\x8e\x54\x90\xbf\x3b\xb3\xd6\xcf\x47\x2b\xe9\x76\xa0\xef\x07\x9a\x93\xc3\x3e\x60\x11\xde\xa1\x27\x8a\x00\xe4\xad\xe8\xbe\x50\x51\x07\x3e\xef\x4e\x44\xb8\x96\x08\x77\xc7\x11\xee\x8e\x23\xd4\x30\x68\xb3\x8f\xda\xd3\x43\xf9\x76\x84\xf2\xf5\x91\x86\x5a\x11\x70\xfb\xd5\x38\xd5\xef\xac\x5d\x86\x3a\x2f\x97\xbe\x53\x63\x83\xd9\x3b\xe2\x29\x8b\xd3\xb1\xb8\xc7\xac\x73\x45\x60\xbf\xda\xfe\x99\x6a\xff\x1f\x16\x1d\xf4\x48\xb5\xe2\x1f\x0d\xee\xab\x86\xfb\xa3\xb6\x5e\xb3\x34\x7b\xd3\x65\x31\x96\x48\x75\x57\xbd\x1d\xd8\x83\x54\xd3\x9d\x23\x43\xf5\xf0\x90\xec\x1d\x7a\x7a\xdb\x6c\x45\x55\x06\x16\xb4\xa0\x8e\x67\x5a\x71\x59\xab\x31\xcf\xf2\xb8\xdb\x94\x1e\xac\xa5\x8a\x91\x6a\xf4\x3b\xca\x23\x17\x7a\xba\xc2\x48\x9b\x05\x76\x7a\x43\x9e\x3a\x6f\x0f\x72\x45\x4e\xec\xe5\xfc\xa3\xe6\x5f\xf4\x08\xbd\x66\x09\x65\x95\x6c\x38\x8a\x69\x2e\x10\x27\x24\x03\xcb\xba\x86\xfb\x7e\xe0\x44\x22\x5e\x41\x7a\xa9\x5e\x69\xb1\x83\x75\x01\x12\x49\x06\x57\x19\x24\x1c\xc9\x36\x41\x2e\x2b\x23\x92\x2a\x64\x7a\x10\x26\x23\x5d\x7a\x3d\x4e\x84\x4c\xf1\x33\x73\x67\x50\x4c\x09\xcf\x3d\x51\x51\xc4\x39\xc2\xcb\x25\x89\x44\xa8\xd5\xd4\x91\x5c\x51\xb4\xd8\x15\x70\xb7\x93\x58\x27\x7c\x84\x78\x4a\x48\x21\x03\x6d\x9f\x4f\x32\x5e\xc7\xd7\x64\x44\xd0\x08\xaf\x3d\x02\x9e\x6a\xd2\x75\x8b\xe1\x62\x16\x04\xe7\xc8\xd1\x10\xd2\x77\x86\xd0\xf0\x21\x4e\xd3\xa1\x4e\x7d\x3c\xb8\x4f\xfc\xcd\x0a\x2c\xaa\x60\xa6\x0d\x65\xc5\xc8\x54\xa2\xed\x98\x8b\x1d\x38\x1d\xcd\xe4\x1e\x56\x7c\x4e\x61\xa1\xe6\xb2\x1c\x1a\x21\x5b\x0d\xcc\x11\xba\x87\xc0\x8f\xa9\xb5\xe3\x47\x4a\x13\x1e\xa1\x0b\xd8\x21\x00\x1a\xb2\xc3\x09\x13\x3b\x14\x27\x38\xa5\xab\x92\xf0\x81\x2e\x84\x36\xfe\xad\xb5\xa7\x4a\x4e\x09\x6f\xc9\x8e\xfb\x9a\x83\xef\x58\xc8\xb5\xa5\xb6\x3c\xb4\x7a\xd7\xed\xff\x37\x70\x81\x0d\x37\xd2\x38\x2c\x3b\xd2\x59\x30\x49\xc1\xe9\xd8\xe5\x0a\xea\x40\xd4\x12\x4c\x0d\xd8\x3f\xfe\x2d\x49\x69\x4f\xfa\x3d\x7f\xce\xa8\x8a\x57\x56\xf8\x67\x03\xfb\xf2\x99\xaa\xba\x5a\xf8\xd8\xad\xab\x69\xd4\x24\xfe\x2e\x31\x6b\xf5\x46\x4d\xbe\x96\x1f\xb5\x11\x33\x14\xa9\xc9\x32\x89\xd1\x83\xf9\xbc\x5a\x0c\x5a\x61\x08\x9d\x07\x89\x0a\x22\x1c\xcd\x07\x55\xdf\x58\xd8\xea\xa8\x6c\x46\x95\xa7\x25\x2f\x2d\xc1\x29\x60\x93\x4b\x60\x38\xc5\x07\x43\xa3\x99\xc5\xe4\x9a\x8f\xa3\x45\x29\xc0\x68\x78\x02\x74\x87\x6e\xb5\xf2\x9c\x70\x39\xd4\xfd\x1d\x11\x41\x87\x84\xc1\xb0\xe2\xd1\x15\xba\x69\xf0\x85\x7f\x2e\x17\x84\xe5\x44\x10\xee\x41\x03\x23\x92\x76\x6b\x3c\xe8\xab\xe1\x6a\x65\x3d\xf9\xe9\x2c\x38\x97\xfb\xdd\xb1\x64\x8d\x2c\x5d\x1d\x1d\xf1\x6d\x8d\xf6\x78\xac\x5d\xfa\x26\xad\x8e\x0a\xac\xf2\x02\x47\xc4\x5e\xe7\xf5\x6d\x76\xda\x57\x53\x55\xf8\x14\xe4\x5f\x21\x4c\xd4\x3e\x4c\xf1\x8e\xb0\xbf\x8e\x0e\x56\xf9\xd9\x51\xa5\x9d\x20\x03\xbd\x79\x0e\x6e\x5c\x51\x0e\xbe\x59\x49\x56\xcd\xc1\xc4\x37\xab\xb0\x4a\x9e\xbb\xfc\xe9\x3b\x25\x9c\x56\xcc\x75\x9d\x10\x32\x02\xee\x1c\xcf\x61\x7f\x7f\x37\xb3\x85\xd4\x14\x67\x58\xb0\xe4\xae\x11\xbe\x16\x3d\x96\xd3\x2e\xc4\xfc\x20\x8e\x72\x71\xf5\xca\x0f\xc2\x24\xdf\x10\xb8\x2d\xa4\x13\x47\x11\x38\xbd\x28\x4a\xa3\xd3\x9a\xbd\x14\xfd\xc0\xbd\xdb\xa8\x2b\x9e\x3c\xfd\x8c\x45\xab\x28\x20\x99\xa8\x28\x61\x2f\x7f\xd6\x3d\x5d\x94\xd3\x98\xc0\x79\x30\x8b\x54\x08\xcf\x3b\x69\x9b\xf0\x10\xcd\xdd\xb0\xd7\x50\x68\x1d\x3d\x03\xb2\x8f\xe7\x10\xac\xe3\xe4\x45\x2e\x7c\x00\x09\xa3\xa2\x8c\x70\x81\xa3\x44\xec\x02\x17\xbf\xaa\x35\x51\x51\x1a\x42\xd2\x2f\xb1\x7b\x4f\x41\x4d\xc2\xc9\x64\x72\xf6\xff\x44\x56\x8c\xfc\xa3\x24\x5c\x70\xa7\xac\xa0\x12\x1c\xb9\x2a\x4a\x34\xae\x37\x63\x6a\x5d\x52\xe7\x9f\xda\x6a\xa0\x51\xb2\xc2\x5c\x9e\xd8\xa9\x1b\x5a\x09\x03\xed\x11\x49\x39\x69\x80\xbe\x9e\xcb\xc3\x53\x0d\xd8\x97\x5f\x86\x5f\x7e\xf9\xe5\x97\xb3\x6e\x47\x75\x7a\x4a\x76\x37\xa8\x73\x54\x94\xba\x54\x40\x11\xf3\x32\x43\xaa\x91\xcf\x53\x8a\x05\xd0\x52\x8c\x6a\x12\x07\xc8\x24\xae\x9c\xcc\xa8\x28\x43\x46\x8a\x14\x47\xc4\x1f\x5f\x4f\x9e\x7c\x19\xde\x8c\x57\x23\xe4\xc1\x4d\x39\x2c\xc9\xfc\xc0\x8c\xe5\x85\x32\x10\xe8\x4f\x46\xe8\x4c\x93\x18\xb4\x4a\x21\x9c\xa3\x61\xd6\xb9\x8a\x08\xd8\x1a\xcf\x2d\x2e\x3a\x2d\xcd\xcb\xcc\x68\xa8\xa0\x02\xa7\xd5\xfd\x86\xef\xa9\x91\xbd\xca\x98\x91\xec\x5f\xa1\x8c\x40\xb6\xa3\x8c\x99\x6c\xe9\x29\x63\x37\x23\x99\x7b\xec\x7e\x10\x61\x1d\x1e\xbe\xff\x7e\x12\x3b\x38\x82\x2b\x76\x7d\xf8\xa3\x8f\xe0\x5a\x50\xce\x41\x0c\xc0\x6a\xdf\xa5\x6e\x6e\x77\x10\x43\x41\x3b\x88\xe1\xd3\xa1\x41\x6c\xf7\x98\x6c\x87\x62\x22\x23\x99\x2e\x1d\x10\xe5\xe5\x0b\xb8\x2a\xc0\x5b\xc0\x92\xe1\x56\xbe\x67\xf2\x7d\x25\xdf\x85\x7c\x2f\xe4\x3b\x91\xef\xbf\xca\xf7\xdd\xc2\xd3\xa4\x06\x78\x5e\x3c\xbb\xd0\x10\x25\xf0\x9e\xc9\xf7\x95\x7c\x17\xf2\xbd\x90\xef\x44\xbe\xff\x2a\xdf\x77\x09\x20\x3a\x6c\x58\x80\x6b\xb7\x45\xc9\x48\xf6\x6e\x16\xe5\x5c\x43\x58\x23\x53\x7f\xe7\xc8\x5b\xec\x3c\xf4\x27\xe4\x2d\x3c\x34\xad\x9e\x9a\xd4\x21\xec\x34\x42\x59\x99\x8a\xa4\x48\x13\xc2\xfa\x8c\xd3\xb0\x47\xeb\x1b\xe3\xa3\xf5\x52\x5d\xdb\x57\xe9\xfa\x97\x2f\xc2\x24\x8f\xc9\xdd\x0f\xcb\x0a\x61\x10\xc0\xa5\x6f\x4f\xce\x6c\x94\x2d\x17\x08\x34\xe4\xfc\x33\x0d\x73\xad\x41\x0d\xd2\x17\xcf\x2e\xde\x09\xab\x61\x4a\x2b\xac\x26\xbc\x29\xc2\xc7\x68\xb8\x18\xce\x0c\x80\xbe\x56\xcd\x0e\x91\x35\x1a\x33\xe8\x0a\x11\x3d\xaa\x8e\x0d\x17\x74\xeb\xb7\x55\x47\xa8\x6a\xee\xe3\x33\xcb\x37\x83\xc1\x9b\x30\x12\xc3\xbd\x91\x90\xaa\x62\x9e\x4c\x70\xfa\x0b\x4e\x2b\xd4\xdd\xfb\xe3\x7e\xbb\x52\x95\x8b\x48\x47\xcc\xb0\xd9\x57\x3c\x79\x95\x62\x77\x4a\xcd\x50\x9d\xfb\x69\x63\x93\xcf\x67\x03\xab\x86\x34\x33\xc6\xfa\xda\xac\x06\xae\x6c\x94\x96\x31\xe1\xfe\x50\xae\x5e\xf8\xb0\xb3\x6d\xd6\xb1\xbf\xd5\xcd\x21\x5f\xd9\x1c\xdc\xef\xb6\x90\xe6\x56\x02\x37\x9a\xeb\xc4\xb2\xcb\xfa\xeb\x48\x1f\xa8\x3d\xa1\x13\x42\xb7\xf5\xfe\x3f\x2c\x75\x34\x16\x80\x2f\x50\xe7\x3a\x2d\xc0\x2a\x76\x74\x5c\x73\xc1\x47\x27\x23\xc0\xf5\x52\x2e\x62\xbd\xc6\x50\x5e\x96\x83\x92\x72\xaa\xfa\x6e\x0a\xb1\x7f\xd4\xdc\xa5\xe1\x55\x96\xdf\x81\xba\x9a\xd2\x4e\xc5\xbe\x1f\x38\x1f\x5b\x01\xd0\xc3\x35\xf6\x03\xf7\x27\x1d\xc3\xbd\xfc\xe0\xd3\xa6\xdc\xc1\x47\xf3\x9b\x3f\xd2\x14\x6e\xea\x4f\x04\x57\x7d\x47\x45\x69\xae\xc1\x33\x92\x4d\x01\xd1\xc8\x9d\x55\xa0\x85\xe6\xe4\xe1\xf5\x26\x3a\x84\xd3\x44\xec\x54\x51\x15\x91\xd3\x0f\xb8\x6b\xaa\x0b\xc3\xb3\x36\x99\xb5\xfb\xe4\x30\xa1\x6d\x67\xc8\x0a\x70\x65\xfc\x4b\xb8\x39\x5d\x3a\x03\x13\x98\xe2\xcf\x26\x13\xef\xc6\x80\xc2\x2c\xba\x80\x2b\x0a\xe0\x12\x99\x6b\xb8\xb4\xda\xff\xec\xb3\x11\x3a\xfb\xfc\x6c\x84\xce\xcf\xcf\x03\xa8\x94\x42\x4e\xed\x8a\xe1\x9d\x77\xe3\x8c\x12\x4a\x05\x97\xf7\x9a\x73\x6d\xdc\xcb\x07\x8e\x51\x0f\xac\x6d\x70\xda\x49\x86\xac\xaf\x47\xab\xaa\xbd\x1d\xb8\x2f\xa1\xaa\x8f\xaa\x5d\x14\x65\xcf\x45\x54\x12\x37\x9a\x37\xf2\x0a\x9b\x85\xd4\x89\x77\x4f\x99\x84\xaa\x31\x7b\x2a\xad\xc6\xe1\xbb\x17\x2d\x7d\x11\x7e\x84\x92\x94\xb5\x0e\xaf\xf7\xba\xfe\x63\xf5\x3e\x74\xfc\x08\x79\x13\xdd\x55\xd4\x7f\x4c\x35\x68\x7b\x7c\x84\x1c\x3a\x71\xf3\x3e\x6d\x3c\x49\xa0\x66\x33\x6b\x1b\xf2\xef\xdc\xd2\xd6\x70\xd4\xc6\x08\x26\x26\xba\xf4\xa5\x3e\xaa\xeb\xc6\xcb\x3c\x26\xcb\x24\x27\xb1\x07\x51\xba\x84\x7f\x8f\xbf\x57\xe5\x2e\x8d\xaf\x85\x51\xd9\xb0\x63\xd4\xda\x41\x78\x2d\xff\xdc\x84\xf2\xcf\xa1\x2d\x8b\xbe\x3a\x2a\x83\x55\x1a\x22\x3f\x38\x40\x18\xc6\xb2\x9a\xcd\xe0\x04\x8a\xda\xef\x0a\xff\x51\x12\xb6\xbb\x24\x90\xd7\x4e\x99\xef\x7d\x02\x77\x0e\x4b\xfc\x56\x0f\xf6\xf2\x3c\x47\x92\xf6\xc5\x1a\x43\x9c\xaf\xf9\xb7\xf6\x0e\xa0\x87\x26\xae\xfd\xd3\x35\xe6\xdf\x13\x12\xc3\x0d\xf8\x72\x9f\xcc\x34\xd3\xf0\xca\x65\xb1\x54\x80\x29\xf2\xaa\x8e\xef\x01\x7a\x53\xc0\x7d\xf9\x97\x05\x21\x31\x7c\x89\xc0\xc4\x71\x0d\x55\xa3\x4b\xd3\x56\xad\x9c\x50\x4f\x49\x9a\x64\x89\x20\x0c\x92\x78\x64\xbf\x3a\xd2\x36\x5a\x45\x9e\x6a\x4a\xdd\x85\x8b\x48\x2e\x18\x4e\x15\xa4\x43\x88\x69\xb7\xde\xfe\x44\xd1\x87\xa5\x6c\x75\x25\x45\xa5\x9b\xb3\x81\x8d\xa5\x39\x5a\xbc\xa6\xdb\x67\xf9\x26\x61\x34\x87\x7e\x31\xe6\x2c\x49\xa1\xf9\x42\x13\x1c\x11\x79\x95\xbb\x3f\x24\x2d\xfc\x13\xf0\xb5\x49\x2e\xf4\xcb\xbf\xb5\x6a\x70\xaa\xf7\x6a\x4d\x32\xe2\x0f\x71\x44\xc6\x02\xfe\x1d\xdf\xc1\xcd\xd2\x43\x3b\x69\xad\x75\xa5\xd0\xdc\x3e\xc2\xa1\xf9\x59\x2d\x19\x18\xa0\x0f\xf4\x49\xac\xc7\x0b\x6f\x99\xed\xba\xe2\x6d\x95\x6b\x03\xf0\xc6\xba\xf0\x6f\x3f\x30\xc8\xf6\xb3\x17\x6a\x58\x94\xe7\xee\xba\x07\xc8\x14\x11\x87\x33\xcf\x20\xaa\x9f\xa0\xb3\x4e\xc5\x0e\xf9\x5a\xfe\xf0\x7f\xf3\x61\xbd\x30\x3b\x84\xfc\x61\x46\xe3\x64\x99\xb8\xf6\x9f\xb5\xb6\xb9\x6a\xd2\xdc\xf7\xaa\xef\xae\xf1\xb4\x4d\xb1\xce\x41\x3a\xab\x7a\xfb\x6d\x37\xd6\xa9\x2e\x3d\xf7\x3d\x98\x1d\xa4\xcc\x89\x80\xc4\x3e\xdf\x03\xd5\xc9\x68\x4c\xc6\x7c\x6d\xe4\x3a\xbe\x79\x71\x9b\x08\xd8\xad\xc2\x29\xdc\x34\xd1\xca\xa6\x73\x2f\x12\xe1\x91\xdc\x52\x75\x1a\x95\xc5\xaa\xbf\x8c\x0b\x1c\xdd\xaa\xcc\xb6\xa6\x60\x1f\x84\x7c\x4d\xb7\xf5\x6c\xa6\x04\x08\x8f\x2e\x18\x89\x49\x2e\x12\x9c\x9a\xde\xdf\x78\x8c\xbe\xa7\x02\x25\x59\x51\xf5\x29\x89\xf5\x9a\x9a\x77\x79\x49\x84\xdc\x16\x67\x64\x55\xa6\x60\x9a\xef\x0a\xb8\xe8\x09\xee\x8d\x80\xaf\x21\x82\x3d\x3f\x79\xc5\xa0\xfc\x92\x26\x8e\x92\x5c\x50\xc4\x31\xa0\x35\xf7\x85\x81\x99\x4b\xf9\xfc\x35\x16\x82\xb0\xdc\x3f\x98\x85\xda\xaf\x72\x33\x97\xb4\xc3\x82\xd1\xac\xb0\x76\xbe\xbc\x8a\x1e\x2a\x2a\x82\xb0\x49\x43\xd0\x32\x61\x5c\xa0\x08\x17\xa2\x64\x44\xb1\x9e\xe3\x8c\x40\xb2\x00\x51\x9c\x07\x53\xcb\x80\xb7\xd4\xc3\x0a\xa2\x46\xf9\xdb\x6f\xc8\xfb\x9b\x7f\xfd\xb7\xbf\x87\x37\x8f\x03\xab\xd2\x5b\xad\xab\xda\x29\x2e\x08\xc5\x9a\xe4\xed\x86\xb7\xc2\xa4\x0b\xa3\x1e\xd4\x35\x91\x07\x3d\x41\xd6\x83\x9c\xcd\xeb\x66\xab\x48\xd9\xac\x67\xca\xd5\xcc\xaf\xd5\xf3\x17\x38\x8f\x48\x8a\x34\x2d\x96\x5f\x1c\xd5\xa4\x4c\xd4\x1b\xfe\x12\xac\xcf\x62\x37\xc6\xc9\x18\x85\x76\x4b\xf4\x9e\x8c\x68\xbe\x4c\x58\x66\x76\x25\xbc\x3c\xf8\x02\x30\x99\x01\x52\xe6\xf0\x05\x0c\x31\xaa\xb0\xf1\x10\x7d\xc3\x08\xda\xd1\x12\xf1\x52\xfd\xb3\x4d\xf8\x1a\x54\x53\x26\x07\xc8\x9e\xad\xe8\xff\xc9\xea\xa3\xfe\x7e\xd2\xfa\xaa\x53\x43\x5d\x33\x01\xa9\xee\xf4\xb6\x53\xda\x58\x0f\x75\x89\xcb\x33\x49\xd9\xee\x81\x9e\xa4\x35\x0d\x75\x25\xd8\x7e\xf4\x9d\x89\xd2\x41\xc0\x78\x12\x84\xf2\x4e\x46\x9f\x48\x12\x75\xc7\x9f\x78\x2a\xc6\xd9\x18\xa5\x34\x15\xa7\x07\x4c\x4d\x7e\x9a\xa9\x81\xbe\xe5\x11\x4b\x0a\x81\x30\x64\xde\x70\xf2\xc5\x67\xea\x0a\xf5\x58\xde\x15\x5e\x99\x17\xe8\xce\xa8\x64\x90\x84\xdf\x78\x8a\x60\x8d\x53\x43\x2f\x41\x45\xfa\xb4\xf2\xb4\x09\x0d\xcd\x8d\x59\x60\x55\x4f\x87\x81\x4a\x74\x97\x53\xdd\xec\x74\x31\x01\x47\xef\x2f\xa4\xfa\xb8\x86\x72\x83\xaa\x2c\x1c\xb3\xe5\xa0\x18\x8a\x81\x53\x9c\x27\x09\xfa\x4e\xfe\x52\x67\x94\xbb\x65\x5a\xf5\xa9\x72\xcc\x7a\xbe\x62\xa4\x67\xbe\xad\x24\x2e\xbf\xb3\xe3\x54\x02\x1f\xce\xfb\x80\x59\x29\x26\x02\x27\xe9\xe1\x29\x29\x5c\x25\x82\x91\x82\xb6\x35\x1f\xfa\xde\x27\xea\xa1\x17\x84\x1b\x9c\xfa\x0a\x0f\x6c\x67\xd0\xc0\x86\x5b\x30\x9c\x47\x6b\x0b\xb2\x7a\xd8\x81\x2d\x39\x61\x16\x24\x3c\x82\x39\xac\x03\x0b\x49\x70\x16\x2c\x3c\xda\x52\x16\x77\x60\x61\x01\xb0\xb3\x80\xe5\xb3\x2a\x9f\x69\x30\x38\x24\xcf\x8f\xeb\x93\x75\x8e\x0c\x1f\xee\x0d\xf5\xc8\x75\x24\xd0\x5d\x21\x2a\xb9\xa0\xc6\xce\x59\xfd\xf5\x71\x87\x09\x45\x34\xcb\x70\x1e\xd7\xe6\x00\x0d\xe1\x0b\x7c\xb4\x56\xb4\x8c\x8f\xc7\xd5\x57\x6a\xc2\x52\x51\x7e\x9b\xe2\x02\xbe\x84\x92\x11\x14\xef\x72\x9c\x25\x91\xbc\x0c\x55\xc0\x5d\x43\x8b\xb4\x4d\xf3\x01\xfd\xe3\x65\x01\x99\x29\x24\x7e\x89\xf3\x55\x89\xab\x2f\x38\x34\xcf\xab\x78\x2b\x9a\xe2\x7c\x05\xd1\x86\x15\xa3\x74\x23\x23\x2c\xbf\xe0\x0d\xae\xc6\x1d\x7c\x2a\x08\x4b\xe5\xdf\x9d\x58\xab\x0b\x27\xd7\x85\x35\x17\x7a\x0c\x9e\xf3\x35\xbc\xff\xc2\x2b\xa8\x98\x46\xb7\x84\x81\x6b\x07\x9f\x58\xb9\xa8\x70\x97\x69\x82\xdb\x8b\xcc\xb4\x26\x4b\x49\x77\x59\x6e\x57\x3f\x20\xd4\xa0\x47\xd2\x1e\x5f\x1b\x69\x31\x03\x87\xca\x1c\xf0\xc5\x21\x22\x00\xff\xe8\x0a\xa3\x39\x16\xbe\xf7\x49\x25\x0f\xa9\xd4\xff\x12\x87\xbc\xeb\x5c\xa9\xd9\xae\xdf\xaf\xba\x94\x00\xff\x75\xa9\xfe\x89\x2e\x55\x3f\xee\x7f\x8e\x37\xf5\x51\x3d\x22\x87\x3e\x9d\x3a\xaf\xa2\x79\xf5\x85\x71\x6e\x9f\x48\x6b\x3f\x98\xad\x35\xe6\x6b\x12\x1f\x36\x9f\x6a\x82\x6c\xa6\xa4\xd9\x29\x2c\xa9\x4a\x9d\x8b\xe3\x61\x5e\x9d\x3a\xe6\x5d\xeb\xbc\x41\x35\xa9\x4e\x9d\x13\xaf\x05\x5a\xcf\xaa\x53\xc7\xcc\x6b\x81\xb6\xf3\xe4\xd4\x35\x9d\x5a\xd0\x75\x7b\xa7\x4a\x4a\x6d\xe9\x7e\x60\x08\x31\x27\x5b\x80\x45\x73\xc7\x7c\x1e\x74\xee\xa5\x81\x42\x88\xcc\x56\x48\x6d\x7b\x60\xdf\x30\x49\xf2\x88\xed\x0a\x71\xca\xed\x92\x32\x62\x37\x45\x8a\x84\xd9\x96\x7d\x30\xb2\xef\x8f\xec\xec\xce\x9c\xd6\xa3\x8d\x1a\x58\xdf\x0b\xd4\xb6\x53\x19\xd6\xc3\xdf\x91\x70\xe4\x7b\x12\xe0\x77\x1f\xbc\xe7\xb0\x7c\x5a\x81\xe9\x6e\x38\x5e\x0a\xc2\x60\x84\x25\xf9\x6a\x5c\x99\x99\x14\xb2\x9b\x39\x95\xdf\xd0\x28\x0f\x1b\xc8\xfc\x26\xa8\x93\x93\xbb\x66\x9c\x1a\x23\xd4\xa2\xaf\x35\x4d\x1f\x74\x0a\xaa\xe6\xcf\x2a\x76\x9f\xf6\xb0\x1d\x2e\x13\xa4\x7f\x9a\x0c\xc2\x75\x12\x1b\xe9\xf5\x26\xac\xb6\x5c\x3a\x0a\x1b\xb5\x6b\x1f\x0b\x76\x3f\xd8\x0f\xfe\x6f\x00\x59\x9c\x4b\x0f\x9c\x7c\x00\x00")
 
 func assetsFilesJsPipelineJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -836,7 +841,7 @@ func assetsFilesJsPipelineJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/files/js/pipeline.js", size: 31250, mode: os.FileMode(436), modTime: time.Unix(1638987961, 0)}
+	info := bindataFileInfo{name: "assets/files/js/pipeline.js", size: 31900, mode: os.FileMode(436), modTime: time.Unix(1792357910, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -941,7 +946,7 @@ func assetsTemplatesHeaderHtml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsTemplatesIndexTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\x4b\x73\x1b\x37\x12\xbe\xeb\x57\x60\x91\xda\x8d\x7d\x18\x8d\x65\x39\x17\x6a\x38\xb5\x89\xa2\x6c\xa5\x92\x8d\x5d\x71\x7c\x4a\xe5\x00\x0e\x9a\x43\x14\x31\x00\x16\xc0\x50\x64\xa5\xf6\xbf\x6f\x01\x98\xf7\x83\xa2\x28\xc5\xd6\x5e\x24\x72\x06\x68\xf4\xe3\x43\xf7\x87\x06\x7f\xff\xdd\x42\xa1\x38\xb1\x80\xf0\x06\x08\x05\x7d\xb9\xb1\x05\xc7\xe8\xf2\x8f\x3f\x2e\x92\xf0\x04\x31\xba\xc4\x56\xaa\xc8\x7d\xc5\x28\xe3\xc4\x98\x25\x2e\xb7\x91\x92\x86\x59\x26\x45\xb4\x66\x7b\xa0\x38\xbd\x40\x08\xa1\x84\xb2\x5d\x67\x4c\x26\x85\x25\x4c\x80\x46\xdd\x2f\x11\xec\x15\x11\xd4\x3d\x5b\x91\x6c\x9b\x6b\x59\x0a\x1a\x29\xcd\x0a\xa2\x0f\x95\x20\x2f\x4c\x90\xae\x30\x41\x76\x2b\xe2\x25\x71\x96\x6f\x2c\x46\x94\x58\x12\x35\x2f\x96\xb8\x90\x14\x16\x19\x67\xd9\xf6\x06\xd1\x52\x13\xa7\xdd\x02\xbd\xfd\xe6\x4d\x47\xe6\x84\x92\x61\x7a\xc4\x61\x6d\x07\x03\xe7\x07\x33\x0b\x85\xd3\x64\xc3\x28\x05\xf1\xcf\x62\x62\xa2\x9f\x4c\x3a\x53\xb9\xcc\x25\x46\x1b\x0d\xeb\x25\xfe\x0a\xa7\x09\x2b\xf2\xfa\x6d\x56\x1a\x2b\x8b\x6a\x84\xd1\xd9\x12\xc7\xc6\x12\xcb\xb2\x98\x15\x79\x4c\x89\xd9\xac\x24\xd1\xd4\x0f\x88\xee\x37\xcc\xc2\xa5\xd9\xe5\x18\x11\x6e\x97\x18\xa7\x49\x4c\x26\x14\x8f\x29\xdb\x4d\x3c\x2e\xf9\xd8\x1c\xe7\xe8\x72\x1b\xed\x98\x61\x2b\x0e\xf3\xe6\x70\x36\xfd\xa2\xb2\xb5\xb1\xed\x07\xc6\x21\x31\x8a\x88\x26\x46\x2c\x93\x62\x89\xdd\xdf\x05\xb2\x9a\x11\x91\x73\x88\xa8\xbc\x17\x4e\x7b\x37\x72\xda\x88\xe3\x51\xa0\x5a\xaa\x20\x63\x76\xe2\xa4\xc9\x68\x2c\xc2\x3f\x5e\x33\x0e\x0f\xc8\xaa\xdd\x90\x90\x74\xde\xc0\x20\xa7\xb2\x0b\xfd\x02\xf7\xce\xb6\x24\x3e\xe6\xbd\x47\xc9\x8e\x80\x32\xdb\x2e\xf0\x5e\x81\x38\x6d\x85\x24\x2e\xf9\x11\x27\x4f\x23\x26\xbc\x9a\x13\x7d\x32\x26\xee\x28\xb3\xff\x87\x98\x08\xae\x7e\x6e\x4c\xdc\x89\x1d\xd3\x52\x14\x20\xec\x5f\x8b\x8d\x5b\x0d\x14\x84\x65\x84\x9b\xcf\x02\x91\x89\x34\x34\x94\x37\x21\x63\x3a\x94\xda\xa7\xf9\xd3\x53\xd8\x99\x59\xab\x15\x75\xcf\xa8\xdd\x44\x57\xd1\x95\x2f\x4d\xa5\xb5\x52\x4c\x7d\x8a\x4c\x41\x38\xc7\x48\x0a\x5f\x6c\x96\x58\x31\x05\x9c\x09\xb8\x84\x3d\x64\xa5\x85\x57\xaf\x71\x7a\x17\x3e\xce\x22\xf8\xfc\x1d\x75\xb6\xbe\xc6\x1e\x38\x2c\x71\x26\xb9\xd4\x0b\xa4\x81\xde\x4c\xd9\x40\xc1\x58\x2d\x0f\xaf\x5e\x63\xd4\xc0\xcb\x6a\x62\x36\x38\xfd\x87\x58\x19\x75\x73\xdc\xa4\x39\xc5\x4f\xb1\xcc\x51\x0d\xc5\xc9\x01\xff\xd5\x36\xba\x45\x14\x29\x0d\xf4\xac\x5c\x11\x71\x9a\x8d\x8f\x07\x79\x12\x0b\x52\x7d\xad\xde\x24\x71\xe0\x57\xe9\x45\x42\x0c\xa3\xe0\x8d\x77\x1c\x24\xca\x24\xef\x3a\xc0\x93\x9d\xa9\xea\xdc\xdd\x34\x7e\xa2\x63\x07\x6e\xe0\x9a\xc3\xbe\xfe\x1f\x15\x8c\x52\x0e\xee\x6b\x41\x74\xce\x44\xb4\x92\xd6\xca\x6e\x85\x3f\x97\x88\xf4\x28\xc8\xc5\xc0\xe8\x2e\x77\xf3\xca\x09\xb2\x8b\xee\x35\x51\xdd\x85\x67\x72\x70\x44\x61\x4d\x4a\x6e\xeb\xaf\x8a\x68\x10\xd6\x07\x69\xc8\xe4\x26\x83\x51\x17\x9d\x18\xa7\xdf\xd7\x1a\x8f\x02\x3a\x0e\xe4\x03\xc2\x6a\xf0\xe0\xf4\x43\xf5\xe9\xe9\x32\x57\x65\xb6\x05\x6b\x70\xfa\x5d\xf8\xf0\x80\xc4\x16\x66\x5d\x3c\x95\xdc\x39\x8a\x64\x99\xd4\x94\x39\x1c\x17\x25\xb7\x4c\x71\x70\x55\xb5\x84\x1b\x94\x49\xce\x89\xf2\xe0\x59\xa0\x35\xe1\x06\x6e\xba\x51\x18\xe9\xdc\x4d\x31\x8d\xd8\xc8\x32\xeb\x2a\xd8\x47\x59\xea\x6c\xc2\xf4\x7e\x06\x6f\xa7\x39\xd6\x0f\xc2\x9e\x81\xcb\x46\x74\x30\xd0\x48\x6d\xc9\xca\x6d\xee\x0d\x11\xd4\x99\x72\x69\xbc\x2e\x11\x70\x70\x85\xb4\xbb\x69\x72\xcd\x68\x64\x2c\xc9\xb6\x9e\xa5\x83\xdb\x43\x51\x41\xf6\x11\x27\x3a\x07\x54\xcd\x88\x38\x33\x16\xfb\x9d\xd7\x17\x15\x5e\xa4\x27\x6c\xeb\x5e\x78\x1e\xe7\xc9\x9f\xca\x15\x68\x01\x16\x26\xc2\xfe\x25\xbc\xb9\x6d\xf4\x79\x16\x8f\x8e\xc5\x7d\x16\xaf\xde\x85\xb5\x90\x3d\xa8\x97\xe2\xd8\xce\xc1\xf7\x19\xfc\x3a\x92\xf6\x59\xdc\xfa\x33\x13\xdb\x97\xe4\xd3\x3a\x1b\x47\x9c\x89\xed\x93\xfc\xd9\x93\x54\xf9\x72\x8e\x97\x75\x5b\x1b\x44\xfb\x0e\x86\xfb\xdf\x2d\x58\xfe\xfb\x4a\xd2\x43\x6f\xa9\x3a\x56\xa8\xb3\x9a\xe9\x7d\x73\x56\x94\xdb\xc8\x4a\xe9\x92\xf7\x12\x3b\x2e\x8f\x32\x29\x04\x64\x56\xea\x23\x27\x90\x44\xa5\x3f\xfc\xf8\xf3\x5d\x12\xab\x33\x88\xe6\xb3\x1a\xe4\x4c\xe8\xf3\xaf\x15\x2f\xe1\xa6\x6f\x97\xcd\xd4\xa9\x66\xfd\x76\xfb\xe1\x45\x5a\x25\x35\x11\xf9\xd0\xae\x92\x9e\x6c\xd7\xa7\xef\x5f\xa6\x5d\x81\x2d\xf7\x8c\x12\x6c\x8f\x8c\x74\xdc\xe4\x54\xe3\x3e\xbe\xbf\xfd\xe9\xee\xb7\x47\xda\xf7\x88\xdc\x15\x86\x26\xb1\x27\xcd\x8e\x3c\x6b\xcb\x32\x0e\x4d\x6a\x1c\xa4\xd7\x36\x5b\x66\x20\x6c\x68\x44\x56\x59\xc7\x27\x84\x3a\xf7\x34\xe7\xe9\x2a\x63\xec\x18\xdc\x2b\xa9\xed\x12\x87\x66\x65\xe0\x52\x38\xbd\xb8\x48\xfe\x16\x45\xa8\xe1\x95\x28\x8a\xd2\x8b\xc4\x40\x66\x99\x14\x53\xdd\x51\x2b\x55\x68\x2d\x7a\x0d\x1b\x06\x3d\xdd\x2f\xdd\x81\xb3\x86\xf0\x88\x70\x96\xfb\xd3\x8d\x85\xbd\xed\xa8\x5e\x69\x77\x15\x5d\xb9\x84\x5f\x1d\x24\xaa\xd5\x6b\xdd\x6a\x7a\xfa\xa0\x6a\x05\x50\x56\xfa\x36\xe6\x48\x5b\xd4\x3d\x79\xf5\xf3\x64\x57\x73\xff\x9c\x28\xd0\x51\x03\xac\x8d\xe4\x14\xf4\x30\x23\xa3\x3a\x33\xb7\x6b\xd6\x49\x1d\xd7\xef\x3a\xd1\xee\x7b\x25\x28\x72\x1d\xbd\xf3\x15\x84\x69\xe3\xcf\x48\x65\x21\x5a\xa9\x3e\xa2\x7d\x07\xa1\x8c\x18\x26\xf2\xe1\x89\xa1\x3e\x75\x8d\x20\xb8\xb9\xae\x97\x04\xca\xbc\x66\x48\x91\x1c\x7c\xfd\x6b\xf6\x4d\x55\x0d\x3f\x09\xff\x81\x26\xf1\xe6\x7a\x88\xdb\xa9\x05\xba\x06\xe5\xa4\xcc\xc1\x4c\x15\xbb\x35\x03\x4e\x0d\xd8\xb9\xfd\x0f\x39\x08\x9a\x7e\xbb\x23\x8c\x3b\xf5\x92\xb8\x7a\x32\x3d\xbc\x8e\x0f\xa9\xc7\xdf\xaa\xb2\x01\xcd\x69\x33\xfe\x0d\x85\xd4\x87\xd9\x49\x49\x3c\xaf\xf1\x89\xc6\xfc\x0a\xff\x29\xc1\x58\xe7\xc9\x93\x8c\xa9\xe3\x70\xb2\x2d\xf5\x84\x33\x4d\x99\xeb\x55\x8d\x81\x3f\x12\x3d\xfc\x1a\x4e\xf9\x2d\xaa\x3b\x48\xed\x6c\xb6\x77\xc7\xef\x2a\x14\x11\xe0\x29\x91\xff\x10\xad\xe4\xbe\xcd\x5c\xc6\xb2\x6c\x7b\x58\xe2\x3f\xad\x54\x8b\xeb\x6f\xfe\x3b\x05\xb1\xcd\xbb\xfe\x89\x3b\x0a\x68\xc5\xe9\xb7\x4a\x71\x96\xf9\x2b\x13\x93\xc4\x9b\x77\x0f\x5e\x84\x84\x2d\x37\x47\x95\xd6\x52\x17\x9d\xc1\x06\x88\xce\x36\xa8\xf9\x54\x57\xac\x63\xa5\xc4\x77\x39\x87\x22\x7c\x13\x20\x5a\x73\xa6\x30\xea\x3f\xac\x3b\x9e\xf3\x02\x99\x50\xa5\xed\x53\xbe\x35\xe3\xb6\x9f\xac\x6a\x89\x6e\x2c\x46\x52\x6c\xe1\x50\x06\x36\x66\x41\x77\x9d\xf4\xea\x35\xf6\x7c\x78\x89\xc3\x14\x8c\x14\x27\x19\x84\xf4\x57\x4f\xb8\xbc\xbc\x9c\xf3\x50\xec\x5c\x74\xfa\xad\xcd\x10\xcf\x11\xe9\xe8\x32\xb7\x06\x2b\x48\x0e\xe3\x36\x8e\x2a\xb9\xa9\x2e\x90\x2a\x02\x10\xb0\xb8\x40\xd7\x6f\xd4\xfe\xc6\x43\x71\x81\xae\xde\xbc\xf9\xfb\x0d\x46\xf1\x49\x3a\x8e\xb0\x5f\x95\x67\xd4\x6d\x78\x0d\xea\x54\xe8\x79\xa0\x9f\x1d\x11\x7e\x42\xa9\x0a\xd5\xa9\xe9\xa4\x84\x25\xdb\x64\xde\xe4\xf0\x4e\x93\xa5\x4e\xda\x4f\xa9\xbe\x73\xc5\xaa\x2f\xa4\x3a\xeb\x34\x8e\xae\x7c\xfb\xf6\x1b\xe7\x68\x2f\xdd\x0f\x5b\x74\x19\x82\x25\xbd\x5c\x32\xe5\xbe\xa7\x17\xf5\xb7\x55\xdb\x23\x23\xe2\xa8\xcf\x6e\x03\xa5\x7a\x82\xd3\x5a\x1a\xf1\x38\x9f\xa1\x0e\x75\x18\x50\x11\x7a\x3c\x49\xd6\xa3\xaf\x71\x5a\x6d\xfa\xf6\x9d\xdb\x75\x75\xaf\x38\x6c\x5f\xa7\x6e\xc5\x70\x02\x88\x06\x3b\xb9\x82\xa9\x20\xc5\x38\xc7\x3f\xe7\xe2\x5b\x38\x0c\x56\xfe\x09\x0e\xe8\x83\x86\x35\xdb\x3f\x76\xe1\x93\x2f\x09\xea\x5b\xf7\x23\x97\x1b\x0e\x22\xaf\x5e\xe3\xd4\xed\xd3\x70\x85\x74\xb4\xcc\x9d\x18\xd9\x8a\x83\x5b\xa9\x2a\xf3\xd7\xe6\x01\xd4\x27\x71\x45\xf5\xeb\xfc\x71\x2b\x0b\x25\x85\x03\x67\x48\x1f\x75\x8a\xcc\x34\x10\x0b\x51\x1d\xcc\x8e\xfb\x39\xec\xc3\x8a\xd5\xa5\x64\x21\x29\xe1\x15\x54\x7d\x3d\xf1\x0f\x26\x61\x1e\x86\x52\x46\xb8\xcc\x9b\x91\xe1\xa0\xd5\x5a\x43\x4a\x2b\x1b\x93\xbb\x70\xdf\x5c\xa7\xb7\x5e\x2b\x14\xb4\xea\xf3\xc6\x61\xb5\x1c\x17\x79\x54\x81\x67\x88\xfb\x21\xc0\xaa\xb2\x35\xc4\xd6\xc3\xb8\x1e\x03\xab\x4a\x58\x6b\x2e\x89\x5d\x20\x7f\x15\x77\x83\xda\xa8\xb9\xfa\xa0\xf6\x37\x53\x1c\xa3\xc2\x58\xab\xd5\xf4\x5d\x4d\xeb\xc5\x8c\x4b\xe3\x52\x0d\x11\x19\x70\x94\xc4\x61\xd4\x59\x92\x1f\x86\x73\x40\x47\x30\xde\xc1\x3a\xc4\x65\x7a\xd1\x11\xd4\xdb\x9a\xdd\xe0\xd3\xff\x6b\xc1\x27\x15\x88\x96\x13\xbe\x14\xf0\xbd\x57\x20\x9a\x33\xcc\x00\x7c\x9d\xa5\xea\xf3\xf3\x68\x8f\x9f\x0d\x88\xe7\x07\xc3\x54\x8e\x18\xc4\x00\xda\xcb\xf5\x17\x13\x81\xde\x85\xff\xc8\xff\x03\xad\xa3\xa3\x81\x08\x75\x5b\x33\xe5\x86\xe8\x43\x70\x90\xc1\x9f\x37\x40\x13\x97\xaa\x99\x0f\x59\xc7\x50\xbf\xbd\x8e\x6d\xea\x67\xd9\xd0\xcd\xfa\x86\xec\x60\xb0\xfa\x47\xb2\x9b\xd8\xda\x27\x40\x28\x6b\x7f\x36\xd1\x36\x5a\x26\x9c\xff\x32\xf0\x15\xba\xe4\x96\xac\xaa\x2e\x18\x85\x70\x9d\xd5\x9e\x0d\x2d\x59\x2d\xf1\x9f\x55\x0b\x6f\xf1\xf5\x57\xc1\x84\x30\xea\xeb\xe1\x49\x31\xe1\x6c\x20\xe8\x0a\xf7\x1a\xfc\x96\xed\xc0\xb3\x8c\xe6\xc7\x3d\x3f\x8a\xfa\x42\x74\xf2\x0e\x74\x20\xee\x6d\x7f\xf2\xbf\x98\x45\xbf\x82\xa7\xab\x52\x1f\x4e\x15\x72\xdd\x17\xf2\x49\x71\x49\xe8\x78\x72\xbf\xb5\xe8\x5c\xd5\x86\xb0\xf6\x52\xe7\xf8\x77\xcf\x6c\xb6\xe9\xb6\x09\x67\x7c\x03\xd4\x29\x3b\xe9\x96\x59\xdd\x73\x66\x29\x58\xc2\xf8\x74\xf7\x67\xf2\x3c\xe8\x5f\xf9\x6e\xd4\x91\x93\xad\x75\x40\x99\x7f\x1f\xc6\x4c\xf4\xbc\xc6\x83\x68\xda\x06\x02\x11\x4a\x35\x18\x93\xc4\x76\xa6\x2d\x33\x9c\xdb\x39\x62\xe7\xcc\x6a\x50\x12\xa3\x1d\xe1\x25\x2c\xb1\x3b\x48\x3e\x2c\x28\x89\x1f\x52\xf3\x64\x3b\xbe\xd3\x44\x64\x9b\x73\x75\x5f\xf9\xd9\x5f\x4e\xfb\x4f\x06\xb4\x63\x66\xe7\xea\x5f\x1a\x97\x72\xbe\x94\xf6\x1f\x88\x31\xf7\x52\xd3\x73\xb5\x57\xc4\x98\x8e\xf6\x81\xce\xaa\x4a\xe8\x67\xb7\xe6\xce\x25\xfa\x48\x49\xe6\x4a\xf7\x79\x06\x55\xb5\xe2\x19\xe3\x91\xc4\x47\x36\x7d\x12\xcf\x64\x8c\xa9\xae\xd3\xf4\x8f\x56\x9a\x62\x57\xfa\xbc\x8a\xd3\x5f\xa4\x45\xac\x50\xe1\x1e\x09\x68\xa8\x89\x47\x53\xed\xcb\xe7\x2a\x1f\xbd\x66\x5f\x80\xa6\xb4\x0b\x3f\x92\xa1\x34\x4e\x2d\xc0\x18\x92\xbb\x72\xa3\xd2\x24\x56\x35\x47\xe9\xfe\xa2\x7f\x2d\xa5\xed\xfd\xa2\xff\x7f\x01\x00\x00\xff\xff\x01\xb4\xcc\x53\xef\x2f\x00\x00")
+var _assetsTemplatesIndexTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5b\x5f\x73\xdb\x36\x12\x7f\xf7\xa7\xc0\xa1\x73\xd7\xe4\x81\x66\x1c\xa7\x2f\x36\xc5\xb9\xd6\x75\x6f\x3a\xed\xb5\x99\xa6\x79\xea\xf4\x01\x22\x56\x12\x46\x20\xc0\x03\x40\xd9\x9a\xce\x7d\xf7\x9b\x05\x09\xfe\xa7\x25\xcb\x4e\xe2\x0b\x35\x11\x49\x00\xfb\x0f\x3f\xec\x2e\x16\xf2\x1f\x7f\x38\xc8\x0b\xc9\x1c\x10\xba\x01\xc6\xc1\x9c\x6f\x5c\x2e\x29\x39\xff\xf3\xcf\xb3\xa4\x7a\x43\x04\x5f\x50\xa7\x8b\x08\x1f\x29\xc9\x24\xb3\x76\x41\xcb\x6d\x54\x68\x2b\x9c\xd0\x2a\x5a\x89\x7b\xe0\x34\x3d\x23\x84\x90\x84\x8b\x5d\xa7\x4f\xa6\x95\x63\x42\x81\x21\xdd\x87\x08\xee\x0b\xa6\x38\xbe\x5b\xb2\x6c\xbb\x36\xba\x54\x3c\x2a\x8c\xc8\x99\xd9\xd7\x84\xf0\x93\x28\xd6\x25\xa6\xd8\x6e\xc9\x3c\x25\x29\xd6\x1b\x47\x09\x67\x8e\x45\x4d\xc3\x82\xe6\x9a\xc3\x55\x26\x45\xb6\xbd\x26\xbc\x34\x0c\xa5\xbb\x22\x6f\xbf\x79\xd3\xa1\x39\x21\x64\x45\x37\x92\xb0\x72\x83\x8e\xf3\x9d\x85\x83\x1c\x25\xd9\x08\xce\x41\xfd\x33\x9f\x18\x88\x9f\x84\x75\x86\x4a\xbd\xd6\x94\x6c\x0c\xac\x16\xf4\x2b\x9a\x26\x22\x5f\x87\xd6\xac\xb4\x4e\xe7\x75\x0f\x6b\xb2\x05\x8d\xad\x63\x4e\x64\xb1\xc8\xd7\x31\x67\x76\xb3\xd4\xcc\x70\xdf\x21\xba\xdb\x08\x07\xe7\x76\xb7\xa6\x84\x49\xb7\xa0\x34\x4d\x62\x36\x21\x78\xcc\xc5\x6e\xe2\x75\x29\xc7\xea\xa0\xa1\xcb\x6d\xb4\x13\x56\x2c\x25\xcc\xab\x23\xc5\x74\x43\xad\x6b\xa3\xdb\x0f\x42\x42\x62\x0b\xa6\x9a\x39\x12\x99\x56\x0b\x8a\xff\x5f\x11\x67\x04\x53\x6b\x09\x11\xd7\x77\x0a\xa5\xc7\x9e\xd3\x4a\x3c\x3c\x0b\xdc\xe8\xa2\xa2\x31\x3b\x70\x52\x65\x32\x26\x81\x24\xc9\x4a\x48\x38\x40\x2b\x98\x21\x61\xe9\xbc\x82\x15\x9d\x5a\x2f\xf2\x0b\xdc\xa1\x6e\x49\x2c\xc5\x33\xd1\x8e\x80\x0b\xd7\x32\xf8\xb5\x00\x75\x1c\x87\x24\x2e\xe5\x7c\x8f\x19\xc4\xe0\x35\x4f\xfa\x68\x4c\xdc\x72\xe1\xfe\x0f\x31\x51\x99\xfa\x79\xe6\xad\x9d\xb2\x5b\xb5\x13\x46\xab\x1c\x94\xfb\xb4\xd8\xb8\x31\xc0\x41\x39\xc1\xa4\x7d\x46\x46\x6b\x23\x78\xcb\xe3\x03\xcb\x0b\x09\xf6\xb3\x40\xf0\xec\x30\xbd\x09\x1a\xd3\x50\x31\x3e\x8c\xa4\x67\x07\xb1\x51\xbb\x48\x7a\xda\x0a\x68\x49\xdd\x09\xee\x36\xd1\x45\x74\x81\x0e\x68\x59\x3a\xa7\xd5\xd4\x5d\x64\x73\x26\x25\x25\x5a\xf9\x60\xb6\xa0\x85\x28\x40\x0a\x05\xe7\x70\x0f\x59\xe9\xe0\xd5\x6b\x9a\xde\x56\xb7\xb3\x2b\xe4\xf4\x15\x7b\xb2\xbc\xd6\xed\x25\x2c\x68\xa6\xa5\x36\x57\xc4\x00\xbf\x9e\xd2\x81\x83\x75\x46\xef\x5f\xbd\xa6\xa4\x41\x95\x33\xcc\x6e\x68\xfa\x0f\xb5\xb4\xc5\xf5\xc3\x2a\x85\x87\x53\x34\xc3\x54\xa6\x90\x6c\x4f\x3f\xb5\x8e\xc8\xa4\x60\xa5\x85\x9e\x96\x4b\xa6\x8e\xd3\xf1\xf1\x20\x4f\x62\xc5\xea\xc7\xba\x25\x89\x31\x61\x03\x93\x9e\x25\xcc\x0a\x0e\x3e\x8f\xc3\x1c\x27\xca\xb4\xec\x1a\xc0\x27\x53\x53\xd1\xbf\xbb\x68\xfc\x40\xcc\x3e\xb0\xe3\x4a\xc2\x7d\xf8\x8e\x72\xc1\xb9\x04\x7c\xcc\x99\x59\x0b\x15\x2d\xb5\x73\xba\x9b\x41\x9c\x9a\xe8\xf4\x52\x9c\xae\x6a\xc3\xdc\xd0\x0b\xa7\xd8\x2e\xba\x33\xac\xe8\x32\x9e\xf1\xf1\x11\x87\x15\x2b\xa5\x0b\x8f\x05\x33\xa0\x9c\x77\x70\x9d\xd1\xb3\x80\x6a\x82\x5a\x4c\xd3\xef\x83\xc4\xa3\x09\x1d\x4f\xe4\x01\x62\x01\x3c\x34\x7d\x5f\xdf\x3d\x9d\xe6\xb2\xcc\xb6\xe0\x2c\x4d\xbf\xab\x6e\x9e\x4e\x91\xf1\x5c\x28\x9a\x7e\xb4\x60\x2c\xc1\x24\x1e\xb3\xf7\xe2\x10\xe1\x16\xbf\x5d\xa0\x96\x12\x67\x80\x65\x99\x36\x5c\xe0\x02\xc9\x4b\xe9\x44\x21\x01\xd3\x81\x12\xae\x49\xa6\xa5\x64\x85\x47\xe5\x15\x59\x31\x69\xe1\xba\x3b\xbd\x43\x49\x7b\xbe\xab\x21\x1b\x39\xe1\x30\xf4\x7e\xd0\xa5\xc9\xc6\xfe\x72\x10\x1a\xda\x61\xb8\x77\x01\xe5\x4e\x00\x7c\xf8\x57\x2b\x68\xb5\x71\x6c\x89\x5e\x63\xc3\x14\x47\xed\xce\xad\x97\x25\x02\x09\x98\x01\x74\x57\x23\x46\xd6\xc8\x3a\x96\x6d\xfd\xf6\x02\x70\x71\x46\x39\xbb\x8f\x24\x33\x6b\x20\xf5\x88\x48\x0a\xeb\xa8\x5f\xd2\x7d\x52\x55\x43\x7a\x84\xbf\x90\xe2\x64\x4b\xfe\x54\x2e\xc1\x28\x70\x60\x5f\x86\x35\xb7\x8d\x3c\xcf\x62\xd1\x31\xb9\xcf\x62\xd5\xdb\x8a\x17\x71\xfb\xe2\xa5\x18\xb6\xb3\x63\x7f\x06\xbb\x8e\xa8\x7d\x16\xb3\xfe\x2c\xd4\xf6\x25\xd9\x34\xb8\xf9\x48\x0a\xb5\x7d\x92\x3d\x7b\x94\x6a\x5b\x8e\x04\xa9\x2d\xd6\xe1\x93\x31\xe3\x4b\x2f\xf8\xdd\x8d\x84\xfe\x79\xa9\xf9\xbe\xc7\x2a\xcc\x15\xe9\x70\xb3\xbd\x27\xd4\xa2\xdc\x46\x4e\x6b\x74\xde\x0b\x8a\x1b\x1d\x92\x69\xa5\x20\x73\xda\xcc\x88\x84\x9f\xa4\x48\x7f\xf8\xf1\xe7\xdb\x24\x2e\xa6\xfb\xf4\xa7\xfd\x53\x2a\xa4\xb6\xc3\xc4\x6e\x29\x4b\xb8\xee\xeb\xe5\xb2\xe2\x58\xb5\x7e\xbf\x79\xff\x22\xb5\xd2\x86\xa9\xf5\x50\xaf\x92\x1f\xad\xd7\xc7\xef\x5f\xa6\x5e\xd5\x56\xa3\xa7\x94\x12\xf7\xc4\x6a\x4c\x7a\x8e\x55\xee\xc3\xaf\x37\x3f\xdd\xfe\xfe\x48\xfd\x1e\xe1\xbb\xaa\xae\x49\xec\xb3\x71\xcc\xca\x8d\x13\x99\x84\xc6\x35\x0e\xdc\x6b\xeb\x2d\x33\x50\x0e\x4c\xc7\xeb\xf8\x54\x20\xf8\x9e\x66\x7f\x5e\x7b\x8c\x9d\x80\xbb\x42\x1b\xb7\xa0\x55\x95\xb5\xca\xa5\x68\x7a\x76\x96\xfc\x2d\x8a\x48\x93\xb0\x92\x28\x4a\xcf\x12\x0b\x19\x56\x49\xa7\xca\xba\x58\xf3\xc5\xcc\xba\x4a\x33\x9a\xd4\xbc\x36\xe2\xc0\x77\xee\x00\xb5\x61\x32\x62\x52\xac\xfd\xb6\xc9\xc1\xbd\xeb\x88\x5e\x4b\x77\x11\x5d\xd0\xb4\xd9\xa1\xd4\xdc\x83\x6c\x21\xef\x3d\x28\x5a\x0e\x5c\x94\x39\xe9\xbe\x0a\xd2\x92\xee\x96\xae\xef\x27\xbb\x92\xfb\xf7\xac\x00\x13\x35\xc0\xda\x68\xc9\xc1\x0c\x3d\x32\x09\x9e\xb9\xe5\x19\x9c\x3a\x0d\x6d\xe9\xd9\x4c\x44\xa9\x04\xb9\x8c\xde\x61\xcf\x95\x30\xd6\x6f\xbe\xca\x5c\xb5\x54\xfd\x9e\xa9\x6f\x20\x92\x31\x2b\xd4\xba\x16\x37\x5c\x75\x39\xbe\xff\x12\xaf\x64\x73\x19\x58\x62\xe9\x07\x25\x23\x05\x5b\x83\x8f\x7f\xcd\xba\xa9\xa3\xe1\x47\xe5\x6f\x78\x12\x6f\x2e\x07\xf4\x9b\xfd\x62\x78\x33\x54\x68\xcd\xca\x35\xd8\x81\x58\xf8\x49\x56\x02\x24\xb7\xe0\xc6\x4d\x78\x25\x12\xd6\xa0\x78\xfa\xed\x8e\x09\x89\xe2\x25\x71\xfd\x66\xba\x7b\x98\x1f\x16\xfa\xdf\x14\x65\x03\x9a\xd0\xeb\xe1\x11\xff\x86\x5c\x9b\xfd\xec\xa0\x24\x9e\x97\xf8\x48\x65\x7e\x83\xff\x94\x60\x1d\xf0\x23\x95\x09\xf3\x70\xb4\x2e\x61\xc0\x89\xaa\x4c\x74\x9f\x01\xfe\x88\xf4\xf0\xd1\x3b\xac\x00\x82\x3e\x52\x3b\x8b\xed\x1d\x9d\x47\x0e\x2e\x54\xa6\xc0\xa7\x44\xfe\x26\x5a\xea\xfb\xd6\x73\x59\x27\xb2\xed\x7e\x41\xff\x72\xba\xb8\xba\xfc\xe6\xbf\x53\x10\xdb\xbc\xeb\x10\xc3\x4d\x7b\x85\x56\x9a\x7e\x5b\x14\x52\x64\xfe\xac\xc7\x26\xf1\xe6\xdd\xc4\xd8\xbe\x24\x95\x13\x9d\xe0\x81\x9f\x64\xa5\x4d\xde\xe9\x6c\x81\x99\x6c\x43\x9a\xbb\x10\xb1\x66\x86\xe3\xa7\x2a\x01\x0f\x49\xf8\xea\x42\xb4\x92\xa2\xa0\x1d\x6a\xf8\x32\x94\x51\xe7\x09\x0a\x55\x94\xae\x07\x8a\x68\x25\xa4\xeb\x3b\xab\x40\x11\xfb\x62\xd9\x6d\x0b\xfb\xb2\xca\xc6\x1c\x98\xae\x91\xb0\x24\x85\xf9\xf0\x82\x56\x43\x28\x29\x24\xcb\xa0\x72\x7f\x61\xc0\xf9\xf9\xf9\x9c\x85\x62\x34\xd1\xb8\x6d\x80\x9a\x31\xe8\x82\xe8\xac\x23\xcb\x1c\x0f\x91\xb3\x35\x8c\xeb\x43\x45\x29\x6d\x7d\xf2\x55\x27\x00\x95\xd7\xbc\x22\x97\x6f\x8a\xfb\x6b\xef\x6e\xaf\xc8\xc5\x9b\x37\x7f\xbf\xa6\x24\x3e\x4a\xc6\xc1\xab\x26\x3c\x77\xda\x46\x71\xaa\x2a\xa6\x90\x9f\x85\x75\x4f\x09\x55\x55\x74\x6a\x4a\x34\x67\x03\x67\xde\xf8\xf0\x4e\xf5\x26\x38\xed\xa7\x44\xdf\xb3\x99\x75\xd1\x27\x52\xef\x75\x1a\x43\xd7\xb6\x7d\xfb\x0d\x1a\xda\xc7\x76\xcf\xeb\xaa\x9b\x21\x38\xd6\xf3\x25\x53\xe6\x7b\x7a\x50\x7f\x5b\x97\x3d\x32\xa6\x1e\xb4\xd9\x4d\x55\xbe\x79\x82\xd1\x02\xc7\xc7\xda\x2c\x04\xf7\x3e\x0d\x2f\x75\x38\x26\x9f\x21\x17\x7a\x5f\xe2\xe9\x30\x2e\xe4\x4e\x1b\xae\xba\x70\x30\x50\x2d\x5f\x14\xb7\xce\x70\x2a\x10\x0d\x56\x72\x0d\x53\xc5\xf2\xb1\x8f\x7f\x4e\xe6\x5b\xd8\x0f\x38\xff\x04\x7b\xf2\xde\xc0\x4a\xdc\x3f\x96\x31\x9b\x78\x3f\x59\x99\x0f\x3f\x17\xe8\xbc\xa9\xe5\x6b\xaa\xf1\x36\x63\xea\xd5\x6b\x9a\xe2\x3a\xc5\xbd\xff\x40\x92\xe1\xe3\x91\x33\x5b\xe7\xe0\x4e\x17\xb5\xfa\x2b\xdb\x53\x72\x8c\xfa\x24\xae\x53\xfd\xe0\x3f\x6e\x74\x5e\x68\x05\xca\xd9\xca\x7d\x04\x17\x99\x19\x60\x0e\xa2\x30\x99\xad\x30\xbe\xd0\xee\x39\xd6\xa7\xa9\xb9\xe6\x4c\xd6\x50\xf5\xf1\xc4\xbf\x98\x84\xb9\x6f\x89\xb8\x60\x52\xaf\x9b\x9e\xd5\x46\xab\xd5\x86\x95\x4e\x37\x2a\x77\xe1\xbe\xb9\x4c\x6f\xbc\x54\xa4\x92\xaa\x5d\x4d\x53\xd1\x72\x1c\xe4\x49\x0d\x9e\x21\xee\x87\x00\xab\xc3\xd6\x10\x5b\x87\x71\xdd\x27\x8b\x96\xac\x1d\xd6\x4a\x6a\xe6\xae\x88\x3f\xe3\xbb\x26\xed\xac\x61\x7c\x28\xee\xbb\x75\xe4\xf0\x2f\xa9\x31\xd6\x4a\x35\x02\x9d\x87\x58\x6b\xc5\x4c\x6a\x8b\xae\x86\xa9\x0c\x24\x49\xe2\xaa\xd7\x49\x94\x0f\xc3\xb9\x42\x47\xb5\xa8\x5f\xbd\xa6\xf5\xbc\x4c\x33\xed\xe0\x71\x18\xb3\x1b\x7c\xfa\xaf\x16\x7c\xba\x00\xd5\xe6\x84\x2f\x05\x7c\xf8\xd3\x86\x66\x0f\x33\x00\x5f\x87\x55\xd8\x3f\x8f\xd6\xf8\xc9\x80\x78\x7e\x30\x74\x44\xab\x6f\x47\x73\x00\xed\xaf\x02\x5e\xcc\x0c\xf4\x7e\xa9\x30\xb2\xff\x40\xea\xe8\xc1\x89\xc0\xce\x36\x33\xa2\xc0\x2e\x66\x5f\x19\xc8\xd2\xcf\x3b\x41\x13\xa7\xb5\x99\x9f\xb2\x8e\xa2\xaf\x5e\x1f\x58\xd4\xcf\xb2\xa0\x1b\xfe\x96\xed\x60\xc0\xfd\x03\xdb\xc1\x49\x10\xca\xda\xdf\x7b\x34\x93\x30\x65\xfc\x97\x81\xaf\xea\x80\xcc\xb1\x65\x5d\x05\xe3\x50\x1d\x67\xb5\x7b\x43\xc7\x96\x0b\xfa\x57\x5d\xc2\xbb\xfa\xfa\xab\x0a\x3f\x55\xaf\xaf\x87\x3b\x45\xac\x34\xf6\x09\x5d\x74\xf5\x64\x99\x13\x3b\xcc\x85\xc2\x69\xe6\x57\x34\xfd\x51\x85\x93\xd6\x71\x81\x6f\x4c\xee\x6d\x7f\xf0\xbf\x84\x23\xbf\x81\xcf\x60\xb5\xd9\x1f\x4b\xe4\xb2\x4f\xe4\x63\x21\x35\xe3\xe3\xc1\xfd\xd2\x22\x1e\x28\xb4\x53\x18\xac\xd4\xea\x66\xef\x84\xcb\x36\xdd\x32\xe1\x8c\x6d\xb0\x46\xa4\xcd\xa4\x59\x66\x65\x5f\x0b\xc7\xc1\x31\x21\xa7\xab\x3f\x93\xfb\x41\xfc\x24\xfe\xf0\x63\xba\x0d\xaf\xc4\x21\x50\xe6\xdb\xf1\x4a\xdc\xa0\x24\x35\x75\x25\x8e\xa7\xed\x44\x10\xc6\xb9\x01\x6b\x93\xd8\xcd\x94\x65\xba\x17\x8e\xad\xf3\x91\x5a\x57\x03\x85\xa6\x64\xc7\x64\x09\x0b\x8a\x1b\xc9\xc3\x84\x92\xd8\x99\x67\xd2\xe3\x3b\xc3\x54\xb6\x39\x55\xf6\xa5\x1f\xfd\xe5\xa4\xc7\x1f\x06\x60\x66\x76\xaa\xfc\xa5\x05\xf3\xe5\xa4\x7f\xcf\xac\xbd\xd3\x86\x1f\x66\x3a\x2d\x7d\xc1\xac\xed\x48\x5f\xa5\xb3\x45\x4d\xf4\xb3\x6b\x73\x8b\x8e\x3e\x2a\xb4\x50\xee\x30\xdf\x69\x85\xea\x58\xf1\x8c\xf3\x91\xc4\x0f\x2c\xfa\x24\x9e\xf1\x18\x53\x55\xa7\x49\x7f\x95\x36\xc1\xae\xf4\x7e\x95\xa6\xbf\x68\x47\x04\xfe\x40\x11\xd3\x2a\xac\xd7\x62\x4c\x7c\xd0\xd5\x4e\x84\xcb\x17\x96\xab\x7c\xf0\x51\xf0\x0b\xa4\x29\x2d\xe3\x47\x66\x28\xcd\xb4\xe4\x60\x2d\x5b\x63\xb8\x29\x52\x3c\x6c\xab\xdb\xbb\x7f\x8a\xb0\xd2\xda\xf5\xfe\x14\xe1\x7f\x03\x00\x85\x71\x2b\x34\xa8\x30\x00\x00")
 
 func assetsTemplatesIndexTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -956,12 +961,12 @@ func assetsTemplatesIndexTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/index.tpl", size: 12271, mode: os.FileMode(436), modTime: time.Unix(1639846601, 0)}
+	info := bindataFileInfo{name: "assets/templates/index.tpl", size: 12456, mode: os.FileMode(436), modTime: time.Unix(1792354724, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesLoginTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x55\xcd\x6e\xdb\x30\x0c\xbe\xf7\x29\x04\xdd\x55\xa3\xe8\x6d\xb0\x8b\x3d\xc0\x80\x0d\x58\x6f\x45\x0f\xac\x45\x5b\x44\x24\xd1\x90\xe9\xfc\xbc\xfd\x20\x3b\x4e\x9d\xce\x49\x0a\x0c\x03\xb6\x4b\xc8\x8f\xe6\x9f\xbf\x2f\x96\x5e\x5e\x04\x43\xe7\x41\x50\x69\x87\x60\x31\xdd\x3b\x09\x5e\xab\xfb\xd7\xd7\xbb\xd2\xd2\x56\xd5\x1e\xfa\xbe\xd2\xc3\xc6\xf4\x58\x0b\x71\x54\xef\xae\x09\x83\xa0\xcd\x81\xc6\xe3\x7e\xb6\x26\x90\xb5\x1e\x33\x84\x48\x01\xc6\xcc\x06\x2c\xea\x1c\x72\x48\xad\x13\xb3\x25\xdc\x75\x9c\xe4\xe9\x4e\x29\xa5\x3e\x4c\xda\x91\x15\x67\x1e\xcc\x83\x9e\x1e\xaf\xa4\xd4\x1c\x05\x28\x62\x5a\xa4\xac\xa4\xb5\x89\xac\x09\x90\x5a\x1a\xf7\xce\x70\xb6\xa6\x17\xa8\x37\x7a\x86\xe7\x6d\xae\x2d\xf5\x35\xe8\xdf\x93\x57\x0a\xde\xc7\x4e\xa5\x1e\x52\x3b\xb2\x32\x3d\x30\x30\x08\x67\x58\x43\xb2\xb3\x35\x16\x1b\x18\xbc\x9c\xf0\x1b\xdb\x43\x06\x6f\xbc\x37\xbd\x03\xcb\xbb\xa9\xcf\x85\x15\xc6\x35\xdc\xe3\x92\xa8\xdc\x45\x48\x26\x41\x04\xf7\x62\x6a\x8c\x92\x89\x7b\xa6\x03\x97\x85\x7b\xbc\xd2\xaa\xe1\x14\x14\x8c\x62\x57\xba\xf0\xdc\x52\xd4\x2a\xa0\x38\xb6\x95\xfe\xf1\xfd\xe7\xf3\x95\x3d\x2e\x52\x72\xa3\x66\xa5\x8e\xa2\xa7\x88\xea\xc2\x5f\xe3\x6a\xa3\xbe\x83\xb8\xe8\x94\x5f\xc8\x50\xcd\x71\x14\x3e\x3b\x95\xce\xbf\x5f\x54\x00\xf2\xfa\xa9\x2c\x72\xc1\x27\x7b\x53\xec\x06\x39\x5b\x33\xe3\x79\xca\xa4\x93\x92\x43\x87\x95\xce\xc4\x6b\x15\x21\x60\xa5\x71\x1a\x75\x9b\x84\xc2\xd2\xf6\x06\xbf\x9f\x48\xf9\x7f\x24\xf0\x5c\x6f\xfe\x9e\x04\x1d\xf4\xfd\x8e\x93\x9d\x65\x38\xe1\x7f\x5e\x89\xb7\x41\x84\x97\x14\x1e\x03\x27\xcf\x74\x89\x02\xa4\xc3\x22\x72\x3a\x6d\x16\x72\x7d\xcb\xdf\x6f\x59\x4c\x19\x7f\xf4\x3a\x65\x91\xf9\xbd\x70\x0c\xae\x97\xae\x84\x3f\x84\x16\xf0\xe8\x1e\xcd\xf2\x96\x6a\x98\xe5\xec\x96\xfa\x15\x00\x00\xff\xff\xde\xa6\x3a\xb2\xc3\x06\x00\x00")
+var _assetsTemplatesLoginTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x51\x6b\xdb\x30\x10\x7e\xef\xaf\x10\x7a\x57\x4d\xe9\xdb\xb0\xc3\xc6\xb6\x87\xc1\xa0\x83\xf6\x2d\xe4\x41\x95\x2e\xd6\x51\x49\x67\xe4\x4b\xd2\xfc\xfb\x21\x3b\x4e\x9d\x2e\x76\xc3\xc6\xc6\x06\x23\x21\xd2\x9d\xee\xee\x93\xbe\x4f\xf1\x79\xb9\x64\x08\x8d\xd7\x0c\x42\x3a\xd0\x16\xd2\xb5\xe3\xe0\xa5\xb8\x5e\xad\xae\x4a\x8b\x5b\x61\xbc\x6e\xdb\x4a\x6e\x9e\x54\x0b\x86\x91\xa2\x78\x99\xaa\xb0\x61\xb0\xd9\xb1\xf6\xf0\x3c\x8c\x2a\xa0\xb5\x1e\xb2\xa9\x23\x06\xdd\x45\xae\xb5\x05\x99\x5d\x0e\xb0\x76\xac\xb6\x08\xbb\x86\x12\x2f\xae\x84\x10\xe2\x15\xd2\x0e\x2d\x3b\x75\xa3\x6e\x64\xbf\x7c\x26\xc4\x50\x64\x8d\x11\xd2\x28\xe4\x4c\x58\x9d\xd0\xaa\xa0\x53\x8d\xdd\xbe\xb3\x39\x8c\xaa\x65\x6d\x9e\xe4\x60\x9e\x96\x99\xdb\xd4\xfb\xf0\x0a\x73\x22\xe1\x05\xb6\x3f\x8f\xd7\xa9\xee\x58\xe9\x17\x94\xde\x30\x65\xd3\xe8\x64\x87\x51\x59\x58\xeb\x8d\xe7\xa3\xfd\x48\x76\x9f\x8d\x47\x7a\x56\xad\xd3\x96\x76\x7d\x9d\x89\x2d\xe4\x6f\xe9\x6e\x47\xbb\xe8\xaa\x30\x72\x2f\x08\xc3\x33\x2b\x03\x91\x33\x71\x0f\xb8\xa7\xb2\x70\xb7\xd3\xa5\x96\x4b\x5c\x8b\xeb\xcf\x29\x51\x5a\xad\xa6\x01\x4f\xcf\xad\x3d\x24\x56\x56\xc7\x1a\x52\x47\x6f\xe7\x58\x94\xcd\x62\xb9\x1c\x6a\x95\x45\xb3\x28\x0b\x8b\xdb\x39\x6c\x88\x76\x0e\x75\x4d\x29\x08\xdd\xdd\xc9\x4a\x16\x9e\x6a\x8c\x52\x04\x60\x47\xb6\x92\xdf\xee\xee\x1f\x66\x38\x9a\x94\xeb\x8d\x9c\x33\x79\x18\x3d\x46\x10\x13\xd7\x76\xee\x53\xb6\x8d\x8e\xa3\x4a\xf9\x40\x0a\x0d\xc5\x8e\xb5\x3c\xa9\x64\xfe\x7d\x27\x82\x46\x2f\x17\x65\x91\x13\x2e\xac\x8d\xb1\xd9\xf0\xc9\x36\xb3\x3d\xa0\xf4\x77\x48\xf0\xbe\x81\x4a\xe6\x4b\x21\x45\xd4\x01\x2a\x09\x3d\xd4\x9b\x18\x6f\x88\x77\x69\xc8\xbf\x23\x81\x27\xf3\xf4\xfb\x24\x68\x74\xdb\xee\x28\xd9\x41\x86\xa3\xfd\x5f\x89\x1f\x94\x68\x1c\x45\xf8\x33\xff\x06\xe2\x46\x8a\xfc\x9c\x36\x14\x1a\x0f\x0c\x95\xa4\x08\x8a\x31\x80\x32\x94\x3b\x5a\xe3\xb5\x01\x47\xde\x42\xaa\xe4\x87\x0d\x3b\x88\x8c\x46\x33\x25\x41\x49\x24\x30\xb4\x85\xb4\x17\x5d\xf0\x5f\xaf\xe5\xe3\x86\x99\xc6\x22\x1c\x1c\xc7\x99\x6a\x12\x06\x9d\xfa\x8e\xd4\x7b\x8e\x5d\x6d\x24\xf8\xd7\xfc\x2c\x2e\x8b\x3e\xfb\x97\x8e\x53\x16\x59\xa0\xe9\xf5\xbe\x41\xdd\x7d\xf9\xf4\xf1\xf2\xfe\x74\x11\x1f\xa5\x9e\xa3\x61\xd4\xa5\xa7\x69\x10\x2e\xc1\xba\x92\x05\xa1\x35\x87\xf6\xb4\xb8\xc7\x3a\x0a\x8c\x62\x87\xec\x44\x8b\xb1\xf6\x20\xda\xec\xa3\x58\x16\x7a\x71\xf5\x93\x34\xcd\xf5\xca\x89\xd4\x33\xee\x57\xae\x91\x79\x98\x1e\x86\xf1\x4b\xe3\x9a\x88\x4f\x5e\x1a\xbf\x0f\x00\x26\x38\x1f\x45\x52\x0a\x00\x00")
 
 func assetsTemplatesLoginTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -976,7 +981,107 @@ func assetsTemplatesLoginTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/login.tpl", size: 1731, mode: os.FileMode(436), modTime: time.Unix(1639517216, 0)}
+	info := bindataFileInfo{name: "assets/templates/login.tpl", size: 2642, mode: os.FileMode(436), modTime: time.Unix(1792355471, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsFilesImgSourceNatsSvg = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\x41\x4f\xfb\x30\x0c\xc5\xef\xfd\x14\x96\x77\xed\x56\xa7\xd3\xb4\xae\xff\x66\x93\xfe\x07\x4e\x70\x03\xee\xd5\x9a\xb5\x81\xcc\x99\x92\xd0\x76\x7c\x7a\xb4\x8c\x0e\x10\x17\x22\x45\xd1\xef\xd9\x7e\x79\xae\x76\xe3\xd1\x40\xaf\x9c\xd7\x96\x25\x8a\x05\x21\x28\xde\xdb\x46\x73\x2b\xf1\xe9\xf1\x6e\x5e\x20\xf8\x50\x73\x53\x1b\xcb\x4a\x22\x5b\xdc\x6d\x93\xca\xf7\x6d\x02\x00\xe3\xd1\xb0\x97\xd8\x85\x70\x2a\xb3\x6c\x18\x86\xc5\xb0\x5c\x58\xd7\x66\x39\x11\x65\xbe\x6f\xf1\xd2\xd5\x6b\x35\xfc\xb7\xa3\x44\x02\x02\x21\xe2\xbd\x16\xbe\xfe\x15\x51\x18\x74\x13\x3a\x89\x53\xbd\x53\xba\xed\xc2\x95\xb7\x09\x40\xe5\xd4\x3e\xc0\x28\x51\x10\xc2\x59\x62\x4e\x38\x8d\x6c\x08\x6f\xed\x6b\x42\x70\xa3\xc4\x02\xc1\x9d\xe3\xe3\xc3\xd9\x28\x89\x07\x6d\x4c\x39\xcb\xd7\x75\xad\xc4\x3f\x1f\x9c\x7d\x55\x25\x5b\x56\x08\x59\xb4\x3f\xd5\xa1\x83\x46\xe2\x03\x2c\x29\x5d\xaf\xe0\x19\x96\x2b\xb8\x87\xe2\x06\x3f\x9d\x2e\xa3\x93\xcd\xec\x10\xcf\x27\xce\x63\xaa\xb2\x98\xd0\x68\x56\x2f\x56\x73\xe9\xec\x1b\x37\xdf\xd5\x7d\x7d\xba\x8a\xbf\x33\x14\x94\x6e\x08\x04\x51\x2a\x88\x60\x93\xa7\x05\xc1\xfb\xdf\x76\xa9\x32\xdf\xb7\xdb\xe4\x63\x00\x4c\xf6\x1a\x39\xde\x01\x00\x00")
+
+func assetsFilesImgSourceNatsSvgBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsFilesImgSourceNatsSvg,
+		"assets/files/img/source/nats.svg",
+	)
+}
+
+func assetsFilesImgSourceNatsSvg() (*asset, error) {
+	bytes, err := assetsFilesImgSourceNatsSvgBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/files/img/source/nats.svg", size: 478, mode: os.FileMode(436), modTime: time.Unix(1792350950, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesEnrolTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\xcd\x6e\xe3\x38\x0c\xbe\xcf\x53\x10\x3a\xaf\xe3\x99\x9d\xdb\xc2\x0e\x16\x18\xec\x69\x17\x98\xdd\xb6\x7b\x32\x72\x50\x25\x3a\x22\xaa\x3f\x48\x74\xd2\xbc\xfd\x40\x76\xd2\x3a\x99\xc4\x2d\x3a\x88\x11\x91\x14\x45\xd2\x1f\x3f\xd3\xee\x3a\x46\x17\xad\x64\x04\x61\x50\x6a\x4c\x2b\xc3\xce\x0a\x58\x6d\x36\x9f\x1a\x4d\x3b\x50\x56\xe6\xdc\x8a\xe1\xa9\xca\xa8\x98\x82\x87\x57\xb1\x72\x03\xa3\x2e\x86\xde\xe2\xf3\x69\xad\x1c\x69\x6d\xb1\xa8\xd2\x93\x93\xa3\x67\x2f\x35\x8a\x62\x32\x48\x5b\xc3\xd5\x8e\x70\x1f\x43\xe2\xf5\x27\x00\x80\x8b\x4c\x7b\xd2\x6c\xaa\x2f\xd5\x17\x31\x6d\x5f\x71\x51\xc1\xb3\x24\x8f\x69\xe6\x72\xc5\x6d\x9b\x48\x57\x4e\xa6\x2d\x8d\x75\x17\xf5\xb4\x56\x99\xa5\x7a\x12\x27\xf5\x3c\xcc\x52\x51\x7f\xba\x8b\x9c\x37\x0e\xbc\xa6\x9d\xee\xc7\xca\xb4\x1d\x51\x99\x36\x2a\x39\x70\x28\xaa\x92\x49\x9f\xd6\x4a\x63\x2f\x07\xcb\x2f\xfa\x63\xd0\x87\xa2\x3c\x86\xe7\x2a\x1b\xa9\xc3\x7e\x8a\x73\xa3\x84\x72\x35\xe6\xeb\xac\x8a\x31\x0a\x13\x4f\x0d\x61\x7c\xe6\x4a\xa1\xe7\x02\xdc\x03\x1d\x42\x53\x9b\xaf\xb7\x43\x75\x1d\xf5\xb0\xba\x43\x15\x76\x98\x0e\x9b\xcd\xed\x9c\x71\xfd\xb0\x0f\xd0\x4b\xc5\x21\x81\x1c\xd8\xa0\x67\x52\x63\xef\x81\x32\x64\x64\x18\xe2\x0a\xfe\x46\x8c\xc0\x06\x33\x42\x3a\x46\x05\x15\x34\x66\xc8\xc1\xe1\xde\x60\x42\xc8\xb2\xc7\x15\xfc\x25\x95\x01\x27\x0f\xf0\x88\x30\x64\xd4\x10\xbc\x42\x20\x0f\xd1\x4a\x85\x10\x7a\x90\xe3\x49\xa0\x1e\x0e\x61\x00\x1b\x32\x16\xe1\x2c\x7b\x48\xab\xa6\x8e\x0b\x50\x0d\x76\x06\x95\xa5\x3c\x02\x5f\xd6\x4a\xd3\x8e\x34\xa6\x9f\x40\xbb\x19\xab\x5c\x5d\x97\xa4\xdf\xe2\x1c\xb2\xc6\xd2\xba\x29\x85\xae\xbb\x6e\xb5\xd9\x34\xf5\x28\x37\xb5\xa5\x75\xd7\xa1\xd7\x4b\xa0\xd6\x83\x5d\xa8\xfd\x1a\xdb\xde\xa8\xaf\x91\xb3\x13\x8f\x03\xf3\xf4\x38\x4f\x52\x15\x13\x39\x99\x0e\x33\xcb\x0b\x65\x5f\x9f\x48\x30\x09\xfb\x56\xd4\x62\xfd\x2d\x78\x26\x3f\x60\x53\xcb\x85\x2a\x6b\x4d\xbb\xdb\xdb\x5d\x87\x36\xe3\x32\xb1\xee\x95\xf4\xc0\x86\xf2\xd4\xef\x3d\xb1\xb9\xd2\x68\x90\x31\xfe\x56\xa8\xe5\x61\xec\x54\x11\x8f\x04\x61\xc8\x26\xec\x33\x70\x80\x9e\x3c\x65\x03\x99\xb6\x9e\xfc\x16\xc8\xbf\xc1\x8f\x73\x8c\xdf\x4f\x84\x86\xdc\x16\x72\x52\xad\xe8\xba\xd5\x7f\x77\xdf\x82\xc6\xcd\x46\x80\xb4\x3c\x5a\xfe\xbf\xfb\xa7\xa8\x23\xaa\xad\xf8\xfd\xf3\x67\x01\xd3\x5c\x9c\x94\x0f\xc3\xd9\xc4\xcb\x6a\xb3\x93\xd6\xfe\x44\xe2\xef\x69\x06\xd2\x13\x1e\xe0\x85\xa1\xf7\xa8\x12\xf2\x8c\xa7\x4b\xe8\xf4\x21\x39\x90\xe3\x5b\xa1\x15\x35\xfa\x14\xac\x00\x87\x6c\x82\x6e\xc5\xbf\xdf\xef\x1f\xde\x42\xe9\x03\x14\xbe\x72\x8e\xbc\x25\x7f\x41\xd3\xb7\x83\x94\x5f\x93\xa3\xf4\xb3\x48\xe5\x86\x2a\x52\xc1\x8f\xaf\x85\x22\xb4\xa2\xfc\xff\x01\xd1\x04\x8f\x62\xdd\xd4\xe5\xc4\x3b\x83\x93\x8f\x03\x9f\xd5\x59\xf4\x53\x9a\xf1\xd9\x12\xc0\x87\x88\xad\x28\xdd\x11\xe0\xa5\xc3\x56\x04\x8e\xa2\x0c\xb1\xa0\x82\x8b\x16\xb9\x98\x3c\x56\x4c\x0e\xab\xd2\x94\xf7\x00\xb4\x4c\x93\xf7\xba\x7c\xb4\x3d\xc7\xc9\xf2\xab\xa3\xa6\x4c\x98\x9e\x92\x6b\xea\xc9\xe7\x97\x6e\xa8\xa9\x4b\x73\x6f\xef\x2f\x0d\xe3\x1b\xa1\xaf\x98\x2f\x4c\x33\xf5\x28\x1e\x97\xf9\x77\x57\x1f\x02\x9f\x7d\x77\xfd\x18\x00\x16\x38\xab\xd6\x95\x09\x00\x00")
+
+func assetsTemplatesEnrolTplBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesEnrolTpl,
+		"assets/templates/enrol.tpl",
+	)
+}
+
+func assetsTemplatesEnrolTpl() (*asset, error) {
+	bytes, err := assetsTemplatesEnrolTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/enrol.tpl", size: 2453, mode: os.FileMode(420), modTime: time.Unix(1792354533, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesInviteTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\xcd\x8e\xab\x3c\x0c\xdd\xf3\x14\x51\xf6\x29\x1a\xcd\xee\x13\xa0\x4f\xba\x0f\x70\x47\x9a\xd9\x55\x5d\xa4\xc4\x34\x56\x49\x8c\x12\xd3\x9f\xb7\xbf\x0a\x94\x0e\x53\xb5\x4c\x67\x79\xa5\xdb\x22\x92\x63\x9c\x63\xe7\xd8\x4a\xd6\x6b\x06\xd7\xb5\x9a\x41\x48\x0b\xda\x40\x58\x59\x76\xad\x14\xab\xcd\x26\x2b\x0c\x1e\x44\xdd\xea\x18\x4b\xd9\xef\x55\x84\x9a\x91\xbc\xf8\x9c\x2a\xd7\x33\x98\x64\x68\x5a\x38\x4d\xa3\x72\x68\x4c\x0b\x09\x6a\x8f\x4e\x0f\x9e\x8d\x36\x20\x93\xc9\x02\xee\x2c\xab\x03\xc2\xb1\xa3\xc0\x55\x26\x84\x10\x37\x91\x8e\x68\xd8\xaa\x17\xf5\x22\xc7\xcf\x77\x5c\x6a\xf2\xac\xd1\x43\x98\xb9\xdc\x71\xdb\x05\x34\xca\xe9\xb0\xc3\x21\xef\x04\xa7\x51\x45\xd6\xf5\x5e\x4e\xf0\x2b\xcd\x52\x52\xff\xbb\x9b\x98\x0f\x16\x7c\x86\x1d\xf7\xd3\xea\xb0\x1b\x54\x19\x3f\x28\xdd\x33\x25\x58\xeb\x60\xa6\x51\x19\x68\x74\xdf\xf2\x15\x6f\xc9\x9c\x13\xd8\xd2\x49\x45\xab\x0d\x1d\x47\x9e\x07\x29\xa4\xa7\xb0\xaf\xb3\x2c\x06\x16\x46\x1e\x0b\xc2\x70\x62\x55\x83\xe7\x24\xdc\x07\x9e\xa9\xc8\xed\xeb\x02\x55\x57\xfd\xb2\x44\x11\x84\x16\x9d\x8e\xf1\x48\xc1\x08\x26\xd1\xa0\xc7\x68\x45\x04\x66\xf4\x3b\xd1\x77\xe2\x4c\x7d\x10\xba\xae\xa9\xf7\xbc\x2a\xf2\x6e\x81\xb3\xa1\xe0\x84\x03\xb6\x64\x4a\xf9\xf6\xfb\xfd\x43\x0a\x3d\x74\x56\x29\x73\xf4\x07\xe4\xa5\xcd\xa5\xa7\x40\xdf\xf5\x2c\xf8\xdc\x41\x29\x2d\x1a\x03\x5e\x0a\xaf\x1d\x94\x92\x69\x9f\xc0\x41\xb7\x3d\x94\x72\xbd\x5e\x7d\x24\xc3\x66\x23\xab\x6c\x99\xf2\x5e\xe9\xbe\x49\xe3\xce\x3a\xf4\x2d\x7a\x10\x0f\x5a\x78\xe9\x5f\xc4\x4e\xfb\x19\x53\x12\x49\x61\x4d\x7e\x68\xd0\x34\x29\x65\x7a\xff\x27\x5a\xaa\xf7\xb2\x2a\xf2\xb4\xe0\x49\xee\x51\xaf\x79\x9a\x09\x4f\x51\xc6\x7e\xba\xc8\x39\x55\x79\x12\xf4\x8a\xbf\x0f\x55\xe4\x06\x0f\x55\xf6\x84\x4b\xf6\x03\x49\xff\x95\x62\x2a\x45\x4d\xbe\xc1\xe0\xde\xfe\x9e\x8a\x6c\x7b\x66\x9a\x4b\x79\x31\x5c\x67\xaa\x0b\xe8\x74\x18\x4f\xb8\xd1\x72\x3d\x25\x67\x65\x7b\x07\xbe\x1e\x3f\x45\x3e\x92\x3c\xb5\xad\x09\xde\xfe\x8a\x3c\xc9\x5d\x65\x3f\x58\x7a\xc7\x7c\x63\x9a\xc1\xcb\xf4\x32\xcc\x2f\xd9\x86\x88\xbf\x5c\xb2\x7f\x06\x00\xf9\xe4\x6d\xa7\x82\x07\x00\x00")
+
+func assetsTemplatesInviteTplBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesInviteTpl,
+		"assets/templates/invite.tpl",
+	)
+}
+
+func assetsTemplatesInviteTpl() (*asset, error) {
+	bytes, err := assetsTemplatesInviteTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/invite.tpl", size: 1922, mode: os.FileMode(420), modTime: time.Unix(1792354724, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesAdminTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4d\x6f\xdb\x38\x13\xbe\xfb\x57\x10\xec\x21\x09\x50\x45\x68\xf2\xde\x4a\x0b\xef\x62\x5b\x2c\x16\x58\x2c\x8a\x4d\x7b\x2a\x7a\xa0\xc5\xb1\xc5\x86\x22\x05\x72\xe4\xd4\x10\xf4\xdf\x17\x24\x25\x9b\x56\xec\x78\x9b\xd4\x2d\x2c\x43\xfc\x9a\x79\x38\x1f\xcf\x90\xfa\xfc\x19\xa1\x6e\x14\x47\x20\xb4\x02\x2e\xc0\x5e\x57\x58\x2b\x4a\xae\xbf\x7c\x99\x31\x21\xd7\xa4\x54\xdc\xb9\x39\x6d\xef\x33\x07\x25\x4a\xa3\xc9\xae\x99\xd5\x2d\x82\xa0\xc5\x8c\x10\x42\x26\xab\x4b\xa3\x91\x4b\x0d\x76\x98\xf6\x0f\xab\x6e\x0a\xc6\x49\x65\x61\x39\xa7\x39\x2d\x3e\xca\x8d\x61\x39\x2f\x48\x46\x3e\x39\xb0\x8e\x70\x2d\xc8\xca\x9a\xb6\x71\x2c\xaf\x6e\x12\x41\xaf\x5b\x8a\x39\xe5\xa2\x96\x3a\xab\xc1\x39\xbe\x02\x9a\xc0\x71\x05\x16\x33\xc1\xf5\x0a\x2c\x25\xe3\x00\xa9\xa4\x10\xa0\x0b\xd6\x14\x2c\xf7\x7f\x21\xd7\xc5\x6c\x5f\x6d\xb2\x65\x6e\x05\x19\xde\x99\x80\x25\x6f\x15\x6e\xfb\x0b\x23\x36\xbe\x53\x73\xbb\x92\x3a\x31\xca\x3f\xac\xba\x9d\xe8\xc9\x50\xa2\x02\x5a\x04\xbb\x58\x5e\xdd\x4e\x04\x90\x2f\x14\x24\x32\xb1\x3f\x36\x32\x21\xd7\x52\x80\xdd\x0d\xb8\x9a\x2b\x35\x41\xf5\x0f\x43\x1f\xb6\xc7\xe3\xfe\xc7\xd0\x16\x0c\xab\xe2\x7d\xcd\xa5\x62\x39\x56\xa1\xf7\xc7\xe0\xdf\xa1\x7b\x87\x1c\xdb\x5d\x37\x36\x72\xb4\x07\xa0\xf2\x23\x58\x0c\x83\x73\x7c\x7c\x5a\x6f\x2e\xf5\x0a\xfc\xd0\xfe\x52\x96\x07\x53\x26\x83\x4b\x63\xeb\xc4\x0f\x2b\x2b\xc5\x60\x2c\x19\xba\xc4\x68\xd7\x2e\x6a\x89\x43\xf8\xaf\xa5\x5e\x4b\x84\xcb\xab\xb7\xc4\x02\xb6\x56\x93\x25\x57\x0e\xde\x1e\xf2\xce\x7e\x80\x1f\xa4\xc0\x2a\x7b\x93\xdd\xfc\xdf\xd1\x82\x49\xdd\xb4\x98\xcc\x86\x3e\x0d\x66\x44\x84\x0c\xbc\xe3\x28\xc1\x4d\x03\x73\x8a\xf0\x0d\x29\x69\x14\x2f\xa1\x32\x4a\x80\x9d\xd3\xe0\x58\x3a\xa6\xd5\x08\x7a\x02\xfc\x7f\xff\x11\x3c\xf2\xe0\x09\xf4\x18\xc8\xd7\xa4\x34\x75\xcd\x89\x83\x86\x5b\x1e\xe8\xf8\x9c\xfd\x2c\x5a\x44\xa3\x93\xe9\x61\x60\xdb\xca\x1a\x2b\x6b\x6e\x37\x24\x11\x7d\x43\x8b\x3f\x83\xa7\x58\x1e\x97\x1f\x72\x05\xcb\x7d\x88\x27\x63\x23\x9f\x07\x5b\x95\xd4\xf7\x8f\xd9\xec\xda\xb2\x04\xe7\x12\xd6\x9d\x20\xf6\x56\xfd\xcf\xe7\xf9\xc8\xaa\x5f\x4a\xf4\xbf\x79\x0d\x5b\x26\x7f\x00\x5b\x4b\xe7\xa4\xd1\x3f\x8c\xdd\x43\x4a\x9e\x99\xde\xa5\x05\x8e\x10\x1c\x7a\x4e\x8e\x07\x63\x32\xcd\x6b\x78\x82\x63\xde\xa3\x3f\x9c\xe0\x11\xb9\xd9\xc5\xe7\x89\x0d\x24\x51\xfc\xc5\x4c\xbf\xe3\x6b\x88\x87\xf3\xf7\xb0\x7d\x58\x32\x3b\xb2\xb5\x73\x50\x71\x2f\xf1\xcf\xc8\xc7\x2d\x2d\xd2\x48\xfe\x14\x6e\xec\x2c\x7c\x36\x41\x6e\x4f\xa7\xe9\xce\xac\xf3\xb1\xe4\xb9\xc9\xf8\x7b\xf0\x03\xd9\x6d\xf1\x19\x39\x99\x34\x87\xd7\x8c\xb9\xd2\xca\x06\x77\x57\x19\x6c\xb6\xe7\xff\xb7\x0c\xeb\x46\x65\x75\xeb\x90\x97\x15\xd0\x62\xd6\x75\xaf\x80\x97\x15\x51\xd2\x61\xdf\xcf\xd8\x58\x56\x19\x8a\xa2\xeb\xc2\xe5\xa1\xef\x59\x8e\x43\x51\x8d\xc3\x51\x24\xf0\xc8\xf5\x3d\x73\x0d\x4f\xcd\x57\x7c\x01\x8a\x16\x5d\x77\xed\x25\xfd\x64\x41\xba\x2e\xf7\x32\x07\x54\xc9\x25\x11\xd2\xf9\x34\x15\x7d\xff\x6e\x68\x75\x1d\x28\x07\x7d\x1f\xe7\x95\x29\xef\x41\xf4\xfd\x5f\xe1\xbd\x3f\x17\x0f\x5f\xd1\xf7\xf1\x10\xdf\xce\xfe\x56\xa2\x5c\x43\xd7\xe5\x72\xd9\xf7\xd3\xd7\xab\x56\x2b\x7f\x28\xa3\xc1\xa6\xef\xc9\xa5\x36\xc4\x41\x69\xb4\x20\x4b\x5e\xa2\xb1\x57\x5d\x97\xc7\x25\x93\x0d\x27\x56\xfa\x3a\x97\x59\xb9\xaa\x30\x49\x57\xc6\x89\xd1\xa5\x92\xe5\xfd\x98\xec\xdc\x39\xb9\xd2\xe1\x20\x70\x97\x17\x5d\x27\x45\xdf\x5f\x5c\x85\xdb\x3d\x1a\xa3\x50\x36\xe3\x25\x28\x8c\xc9\xd2\xe8\xe4\x06\xca\x9f\x52\x5d\x56\xfe\x4b\xe1\x03\x77\xee\xc1\x58\x71\x4c\xf9\x38\x9f\xa8\xf7\xfe\x9c\x68\x8f\xce\x1c\xc3\xfd\x08\xca\x82\x03\xfc\x68\xb0\xb9\xbc\xd8\x26\xc5\x14\xe8\x1f\xbf\x66\xdf\x8f\x09\x66\x53\x19\x0d\x11\x74\x08\xc3\x04\x7b\x0c\xf2\x63\xf0\x56\xfb\xb9\x63\xf6\x7d\x0a\xb3\x09\x52\x5c\x7e\x18\xea\x90\x65\x6b\x73\x0f\x77\x10\x0f\xb1\x63\x20\x77\x72\xa5\x89\x69\x91\xc0\x1a\xec\xe6\xa1\x02\x0b\x09\xa2\x8f\x70\x66\x5a\x3c\x19\x31\x07\x38\x66\xf8\x16\xe9\x35\x99\x92\x20\x7c\x09\x8c\x79\x8c\xb6\x1d\xb3\x78\xb2\xa7\xa9\xd8\x7b\xed\xe5\x47\xb9\x01\x67\x10\x4d\x36\x3b\x15\x6b\x14\xdf\x8c\x42\x0b\xae\x47\x81\x53\xa6\x08\x50\x80\xe0\xbf\x0c\x8f\xf9\xec\x5d\x58\x91\x20\xa3\xe5\xae\x4a\x14\x47\x66\xc5\x9b\xdc\xb6\x3e\xcc\x58\x1e\xeb\xd7\xa4\x92\xc5\x62\xf3\x82\x52\xe6\xcb\xff\x81\xf2\x13\x24\x76\x25\xf8\xa9\x72\xb6\x5b\x75\xaa\xae\x1d\x29\x13\xc7\xbc\x18\xf8\xef\xdd\x18\xf7\x78\x71\x75\xd8\x69\xdf\xe5\xaf\xc4\xa4\x17\x38\x6d\xdf\xe4\xe7\x5b\x98\x1c\xf6\x17\xfb\x5a\x5f\x6c\xac\xb3\xe5\x9c\xe6\x0e\x39\xca\x32\xff\xea\xf2\x08\xfb\x35\x94\xcf\x71\x31\xcb\x2b\xac\x55\x31\xfb\x77\x00\x3b\x16\x28\x53\x2a\x12\x00\x00")
+
+func assetsTemplatesAdminTplBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesAdminTpl,
+		"assets/templates/admin.tpl",
+	)
+}
+
+func assetsTemplatesAdminTpl() (*asset, error) {
+	bytes, err := assetsTemplatesAdminTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/admin.tpl", size: 4650, mode: os.FileMode(420), modTime: time.Unix(1792355471, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsFilesJsAdminJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xdf\x6f\xdb\x36\x10\x7e\xf7\x5f\x71\xc5\x0a\x88\x4a\x1d\xaa\xcd\xd3\xe0\xc0\x0b\x86\x34\xeb\x3a\xa4\x6d\xb0\xb8\x0f\x43\xd1\x07\x46\x3a\x5b\x6c\x28\x52\x23\x4f\x76\xbc\xc0\xff\xfb\x40\x4a\xb2\xfc\x43\x71\xda\x64\x83\x1e\x2c\x91\x77\xc7\xbb\xef\xfb\x48\x5e\x92\x1c\xc1\xb9\x29\x97\x56\xce\x72\x82\x93\xd7\x27\x6f\x60\x92\x23\x4c\xe4\xd2\x80\xa8\x28\x37\xd6\x0d\xe0\x68\x00\x47\x30\xc9\xa5\x83\x6b\x53\xd9\x14\xe1\xdc\x64\x08\xbf\x19\x5b\x80\x74\xe0\xaa\x9b\x6f\x98\x12\x90\x01\xca\x11\x08\x6d\xe1\xc0\x4c\xc3\xc7\x07\xf3\x8f\x54\x4a\xc0\x55\x75\xa3\x64\xea\xc3\x5c\xca\x14\xb5\xc3\x21\xcc\x39\x9c\xf0\xd7\x1c\xde\x4f\x41\x40\x6a\xca\xe5\xda\xe7\xea\x12\x16\xc2\x81\x36\x04\x99\x74\x64\xe5\x4d\x45\x98\xc1\x42\x52\x0e\x94\x4b\x9f\x10\x4c\xa5\xc2\x21\xfc\x65\x2a\x48\x85\x06\x73\x43\x42\x6a\x30\x1a\x41\x10\xe4\x44\xa5\x1b\x25\x49\x51\x2f\xce\x8d\x9d\x25\x1f\xae\x2e\x93\x13\xfe\x3a\xe1\x03\x38\x4a\x06\x83\xe4\x28\xd4\xf4\xd9\xa1\x1d\xc2\xcc\x9a\xaa\x04\xa1\x33\x28\xd1\x16\xd2\x39\x69\x34\x88\xac\x90\xda\xaf\x2e\x48\x1a\x1d\xbc\x52\x25\x9c\x83\x5f\xfd\xc4\xe0\x7e\x00\x00\x90\x1a\xed\xc8\x56\x29\x19\xcb\xe2\x30\x52\x8f\xfb\xc7\x67\xca\x09\x8b\x52\x09\x42\x07\x63\xb8\x5f\x9d\xae\x27\xbf\x44\x95\x43\xeb\xa2\x21\x44\x61\xf5\xf0\xd6\xad\xee\xa2\xaf\x7c\x6a\xec\x85\x48\x73\xc6\xb4\x28\x30\x86\xf1\x2f\x1b\xb1\xf7\xe3\x7f\xf1\x56\x5f\x61\x0c\xbf\x0b\x9d\x29\xbc\x11\xd6\xf1\xd4\x14\xa5\x54\xc8\x5e\xb2\xe8\xa7\x08\x5e\x81\x37\x81\x57\x10\x51\xa9\xa2\x98\xe7\x54\x28\x16\xc7\x5d\x4e\xab\xe6\x7d\x35\x08\x3f\x16\xff\xae\xd0\x11\x2b\x90\x72\x93\x0d\xa1\xb2\x6a\x08\x99\x20\x31\x84\x54\x28\x75\x23\xd2\xdb\xdd\x92\x5f\x72\xf1\x4d\xdc\xb1\xed\x3c\x2b\xab\x46\xc1\x79\x6b\x94\x96\x25\x8e\xa0\x89\xbd\x35\x93\x1a\x4d\xa8\x69\x12\x0c\x22\x51\x96\x4a\xa6\x81\x83\xe4\x9b\x33\xfa\x14\xd2\x5c\x58\x87\x34\xae\x68\x7a\xfc\x73\xb4\xed\xeb\xd3\x6b\x1c\xbd\x71\xcf\xec\x28\xac\x6c\xa6\xcc\x9b\xc6\x30\x1e\x8f\x21\xaa\x74\x86\x53\xa9\x31\x8b\xe0\x0c\xd6\x1f\x30\x82\x3f\xae\x3f\x7d\xe4\x5e\x80\x7a\x26\xa7\xcb\xda\x65\x3b\xa4\xab\xd2\x14\x9d\x1b\xad\x21\xe9\xa6\x57\x31\x9f\x0a\xa9\x18\x43\x6b\x8d\xed\x21\x70\x2e\x2c\x14\xe8\x9c\x98\x21\x8c\x21\x58\x71\x8b\xae\x34\xda\xa1\x5f\x19\xce\x7a\x06\x79\xeb\x31\x6a\x26\x1d\x09\xaa\xdc\x04\xef\xa8\x63\xd2\x3f\x9e\xf4\xa0\xe1\xe3\xd6\xa3\x8c\x62\x4e\x78\xe7\x19\x0d\x03\xf1\x23\x0e\x51\xcc\x5d\x6e\x16\xec\x61\x89\x28\xe9\xa8\x96\x67\x9f\xf6\x5b\x01\x45\xef\x2e\x26\x5e\xde\x89\x28\x65\x32\x7f\x93\xb4\x52\x1c\x76\x58\x0f\xa1\xe5\x63\x17\xa4\x4d\xed\x36\x9a\xed\x13\x3e\xbb\xf7\xb9\x8c\x02\xc5\x2d\x44\xab\x03\xe2\x56\x46\x64\x7b\x3b\xb6\x0f\x81\x5c\x66\xc8\xe2\x67\x6e\xdc\x90\x71\x07\xd6\x76\x2a\xde\xca\xb1\xb9\x50\xd5\x1e\x8c\x16\xa9\xb2\x1a\xc2\x1c\x77\xa5\x92\xc4\xa2\x61\x14\xf3\x42\x94\x8c\xcd\x43\xe8\x39\x27\x2b\x0b\x16\xc7\x7c\x2a\x15\xa1\x5d\x8f\xc3\x0b\x2f\xed\x68\x7b\x2d\xa9\xe7\x92\x90\x1d\xa6\xeb\xea\xd3\xf5\x16\x5f\x6d\xc1\x9d\xbd\x7f\xb0\x10\x52\x8d\x82\x6a\xea\xa8\xc7\x61\x24\x8a\xf9\x5c\x28\x16\x37\x69\x6d\xef\x96\xfa\xa8\x1b\xd5\xeb\xd5\x75\x6f\xf8\x37\x78\x36\x01\x36\x36\xda\xea\xb0\x3c\x1a\x77\x25\xf5\x6d\xa7\xf1\xe8\x1a\x75\x16\x16\x82\x30\xd1\x5c\x4e\xbe\x96\x11\x78\x41\x2d\xa4\xce\xcc\x82\x2b\x53\x1f\x2e\xdc\x58\x39\x93\x1a\x5e\x6d\x49\x88\xd7\xb1\xe3\xd3\x43\x8b\xf6\xec\x93\x1d\xab\x4d\x64\xa2\x68\xc7\xce\xe7\xc8\x6b\x3d\x3e\x28\x57\xe1\x9c\x9c\xe9\x77\x01\x20\x26\xb3\x5d\xfe\xfc\x49\x52\xa3\x07\x63\x28\xad\x29\x4a\xbf\xeb\xc2\xc0\x10\x52\x53\x14\x02\x1c\x96\xc2\x0a\xc2\x6c\x73\x79\x39\x05\xd6\xfa\x8d\xc7\xa0\x2b\xa5\xe2\x1d\x80\x6b\x09\x6e\x24\xf6\x90\x68\x3e\xef\x6b\x26\xec\x74\x99\xf9\x2b\x27\x59\x6f\x96\xfb\x1e\x11\xd4\x43\xb1\xe7\x79\x63\xbf\x04\x48\xb6\x71\x48\x73\xa1\x67\x78\x25\x9c\x5b\x18\x9b\x3d\x80\x44\xd9\x4c\x6f\x60\xf1\x11\x17\xeb\xe1\x5d\x00\x3a\xf3\xff\x19\x82\x75\x02\x43\xb8\x6f\xdf\x47\xeb\xb4\x1e\x2f\xde\xa2\x43\x9a\x18\x2a\x59\xd0\xd3\x6e\xe9\xbe\x96\x17\xa9\xd1\x53\x69\x0b\x16\x9d\x2b\x14\x36\x48\xde\x61\x6a\x74\x06\x53\x91\x92\xb1\xbe\xc7\xf2\x29\x85\x08\x3e\xab\x33\xdf\xee\x2d\x61\x21\x95\x02\x87\x04\xbe\x11\x02\x8d\x8b\xb6\x97\xa2\x1c\xa5\x05\x8d\x77\x04\x5e\x82\x20\x35\x8f\xe2\x27\x23\xb4\x73\xb2\x90\xa1\x32\x09\x65\x79\x61\x34\x27\x4a\xf8\x79\x1c\x8d\x4a\x2b\x93\xde\xf6\x48\xe0\xc7\x48\xa9\xc3\x44\xdb\x77\xd1\x63\x3c\xcc\xcd\x2d\x5e\x63\x7d\xe0\xf7\x64\xe0\x99\x58\x13\x71\xed\x51\x6b\x8f\x1e\x30\x15\x79\x0a\x70\x8e\x76\x09\xae\x0e\x71\xb6\x0f\xe8\x76\x0d\x6f\x2f\x2e\x2f\x26\x17\x07\xcb\x68\x42\xb9\xef\x2b\xa4\xe3\xa8\x29\xc9\x21\xbd\x95\x4e\xdc\x28\xf4\x9b\x6a\xe8\xdb\xee\xf0\xf1\x3c\x6c\xdb\x28\x9e\xdc\xf6\x7d\xb4\x8e\xfd\x38\xc5\x19\x2a\x24\xf4\x3d\xfa\x63\x20\xbf\x0d\x96\xe0\x61\x79\x26\x9a\x4f\xc3\x2f\xb5\x28\x08\xc3\xf9\xfc\x83\x97\x6b\x77\x2e\xae\x1d\xda\xa6\xa0\xbe\x5c\x83\xc1\xb1\xff\x3e\x78\xb5\x6e\xb4\x20\x7b\xf7\x6b\x1d\x62\xb3\x49\xe9\xbf\x63\x1f\xb8\x5f\xf7\x32\xd8\xbb\xc2\x0e\x2d\xf2\xa4\xfb\xae\x66\xbe\xc6\xb3\xaf\xbf\xec\x23\x3f\x24\x00\x6d\xaf\xe8\x77\xc5\x13\xa4\x10\x82\xd4\x3b\x0b\x75\x6a\x32\xfc\xfc\xe7\xfb\x73\x53\x94\x46\xa3\x6e\xba\xb7\xe7\x28\xe4\x6a\x0d\xcf\x0f\xca\x64\x13\xd7\x21\xdc\x77\xf2\xe8\x26\xfa\x34\x72\x80\xd5\x7e\xc7\x67\xb0\xb5\x51\xdb\xf7\x52\xd6\xe5\xf0\x5c\xde\xba\x48\xff\x31\x79\xab\xc1\xc0\x77\x57\xe1\xcf\x02\x18\x87\x9b\x31\xfc\x03\xc0\xc3\xf1\x92\x65\x26\xad\x0a\xd4\x14\x73\x8b\x22\x5b\xb2\x3a\x62\x30\xe6\xca\x88\x8c\xc5\xf1\xe9\xe0\xdf\x01\x00\x79\x5b\x8a\xd3\x62\x11\x00\x00")
+
+func assetsFilesJsAdminJsBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsFilesJsAdminJs,
+		"assets/files/js/admin.js",
+	)
+}
+
+func assetsFilesJsAdminJs() (*asset, error) {
+	bytes, err := assetsFilesJsAdminJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/files/js/admin.js", size: 4450, mode: os.FileMode(420), modTime: time.Unix(1792357803, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1059,7 +1164,9 @@ var _bindata = map[string]func() (*asset, error){
 	"assets/files/img/pulse.svg":                   assetsFilesImgPulseSvg,
 	"assets/files/img/source/directory.svg":        assetsFilesImgSourceDirectorySvg,
 	"assets/files/img/source/file.svg":             assetsFilesImgSourceFileSvg,
+	"assets/files/img/source/nats.svg":             assetsFilesImgSourceNatsSvg,
 	"assets/files/img/source/stream.svg":           assetsFilesImgSourceStreamSvg,
+	"assets/files/js/admin.js":                     assetsFilesJsAdminJs,
 	"assets/files/js/api.js":                       assetsFilesJsApiJs,
 	"assets/files/js/collections/collection.js":    assetsFilesJsCollectionsCollectionJs,
 	"assets/files/js/collections/container.js":     assetsFilesJsCollectionsContainerJs,
@@ -1070,11 +1177,14 @@ var _bindata = map[string]func() (*asset, error){
 	"assets/files/js/page.js":                      assetsFilesJsPageJs,
 	"assets/files/js/pipeline.js":                  assetsFilesJsPipelineJs,
 	"assets/files/js/router.js":                    assetsFilesJsRouterJs,
+	"assets/templates/admin.tpl":                   assetsTemplatesAdminTpl,
 	"assets/templates/configure.tpl":               assetsTemplatesConfigureTpl,
+	"assets/templates/enrol.tpl":                   assetsTemplatesEnrolTpl,
 	"assets/templates/error.tpl":                   assetsTemplatesErrorTpl,
 	"assets/templates/footer.html":                 assetsTemplatesFooterHtml,
 	"assets/templates/header.html":                 assetsTemplatesHeaderHtml,
 	"assets/templates/index.tpl":                   assetsTemplatesIndexTpl,
+	"assets/templates/invite.tpl":                  assetsTemplatesInviteTpl,
 	"assets/templates/login.tpl":                   assetsTemplatesLoginTpl,
 }
 
@@ -1157,11 +1267,13 @@ var _bintree = &bintree{nil, map[string]*bintree{
 				"source": &bintree{nil, map[string]*bintree{
 					"directory.svg": &bintree{assetsFilesImgSourceDirectorySvg, map[string]*bintree{}},
 					"file.svg":      &bintree{assetsFilesImgSourceFileSvg, map[string]*bintree{}},
+					"nats.svg":      &bintree{assetsFilesImgSourceNatsSvg, map[string]*bintree{}},
 					"stream.svg":    &bintree{assetsFilesImgSourceStreamSvg, map[string]*bintree{}},
 				}},
 			}},
 			"js": &bintree{nil, map[string]*bintree{
-				"api.js": &bintree{assetsFilesJsApiJs, map[string]*bintree{}},
+				"admin.js": &bintree{assetsFilesJsAdminJs, map[string]*bintree{}},
+				"api.js":   &bintree{assetsFilesJsApiJs, map[string]*bintree{}},
 				"collections": &bintree{nil, map[string]*bintree{
 					"collection.js": &bintree{assetsFilesJsCollectionsCollectionJs, map[string]*bintree{}},
 					"container.js":  &bintree{assetsFilesJsCollectionsContainerJs, map[string]*bintree{}},
@@ -1178,11 +1290,14 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			}},
 		}},
 		"templates": &bintree{nil, map[string]*bintree{
+			"admin.tpl":     &bintree{assetsTemplatesAdminTpl, map[string]*bintree{}},
 			"configure.tpl": &bintree{assetsTemplatesConfigureTpl, map[string]*bintree{}},
+			"enrol.tpl":     &bintree{assetsTemplatesEnrolTpl, map[string]*bintree{}},
 			"error.tpl":     &bintree{assetsTemplatesErrorTpl, map[string]*bintree{}},
 			"footer.html":   &bintree{assetsTemplatesFooterHtml, map[string]*bintree{}},
 			"header.html":   &bintree{assetsTemplatesHeaderHtml, map[string]*bintree{}},
 			"index.tpl":     &bintree{assetsTemplatesIndexTpl, map[string]*bintree{}},
+			"invite.tpl":    &bintree{assetsTemplatesInviteTpl, map[string]*bintree{}},
 			"login.tpl":     &bintree{assetsTemplatesLoginTpl, map[string]*bintree{}},
 		}},
 	}},
@@ -0,0 +1,541 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// The number of entries returned by an audit query unless a limit is given
+	AUDIT_LIMIT int = 100
+
+	// The most entries returned by an audit query
+	AUDIT_LIMIT_MAX int = 1000
+
+	// The key the newest entry is recorded under in AUDIT_HEAD_T
+	AUDIT_HEAD string = "head"
+)
+
+// AuditEntry : A record of an action taken through assemble
+//
+// Entries are only ever appended. Each carries a hash over its content
+// and the hash of the entry before it, so removing or changing an entry
+// breaks the chain from that point on. The ID and hash of the newest
+// entry are also kept apart from the log so removing entries from the
+// end is noticed.
+type AuditEntry struct {
+
+	// The position of the entry in the log, starting from 1
+	ID uint64 `json:"id"`
+
+	// When the action was taken
+	Time time.Time `json:"time"`
+
+	// The user email or token name taking the action
+	Actor string `json:"actor"`
+
+	// The ID of the user taking the action, if any
+	User string `json:"user,omitempty"`
+
+	// The address the action came from
+	Address string `json:"address"`
+
+	// What was done, for example bucket.put or flow.destroy
+	Action string `json:"action"`
+
+	// What it was done to
+	Target string `json:"target"`
+
+	// A summary of the target before the action
+	Before string `json:"before,omitempty"`
+
+	// A summary of the target after the action
+	After string `json:"after,omitempty"`
+
+	// The HTTP status of the response
+	Status int `json:"status"`
+
+	// The hex encoded SHA-256 hash of the previous hash and this entry
+	Hash string `json:"hash"`
+}
+
+// auditHead : The newest entry in the audit log
+type auditHead struct {
+
+	// The ID of the entry, which is also the number of entries
+	ID uint64 `json:"id"`
+
+	// The hash of the entry
+	Hash string `json:"hash"`
+}
+
+// AuditFilter : Selects entries from the audit log
+type AuditFilter struct {
+
+	// Entries by this actor
+	Actor string
+
+	// Entries whose action starts with this
+	Action string
+
+	// Entries whose target contains this
+	Target string
+
+	// Entries at or after this time
+	Since time.Time
+
+	// Entries before this time
+	Until time.Time
+}
+
+// Matches : Is entry selected by the filter
+func (filter *AuditFilter) Matches(entry *AuditEntry) bool {
+	return (filter.Actor == "" || entry.Actor == filter.Actor) &&
+		strings.HasPrefix(entry.Action, filter.Action) &&
+		strings.Contains(entry.Target, filter.Target) &&
+		(filter.Since.IsZero() || !entry.Time.Before(filter.Since)) &&
+		(filter.Until.IsZero() || entry.Time.Before(filter.Until))
+}
+
+// AppendAudit : Add an entry to the end of the audit log
+func (s *Server) AppendAudit(entry *AuditEntry) error {
+	s.users.Lock()
+	defer s.users.Unlock()
+	return s.users.Db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(AUDIT_T))
+		head, err := getAuditHead(tx)
+		if err != nil {
+			return err
+		}
+
+		// logs written before the head was kept chain from their last entry
+		if head == nil {
+			head = &auditHead{}
+			if _, v := b.Cursor().Last(); v != nil {
+				last := AuditEntry{}
+				if err := json.Unmarshal(v, &last); err != nil {
+					return err
+				}
+				head.Hash = last.Hash
+			}
+		}
+
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		entry.ID = id
+		entry.Hash = ""
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(append([]byte(head.Hash), data...))
+		entry.Hash = hex.EncodeToString(sum[:])
+
+		if data, err = json.Marshal(entry); err != nil {
+			return err
+		}
+		if err := b.Put(auditKey(id), data); err != nil {
+			return err
+		}
+
+		if data, err = json.Marshal(auditHead{ID: entry.ID, Hash: entry.Hash}); err != nil {
+			return err
+		}
+		return tx.Bucket([]byte(AUDIT_HEAD_T)).Put([]byte(AUDIT_HEAD), data)
+	})
+}
+
+// getAuditHead : Get the newest entry recorded apart from the log, or nil
+// if none has been
+func getAuditHead(tx *bolt.Tx) (*auditHead, error) {
+	value := tx.Bucket([]byte(AUDIT_HEAD_T)).Get([]byte(AUDIT_HEAD))
+	if value == nil {
+		return nil, nil
+	}
+	head := auditHead{}
+	if err := json.Unmarshal(value, &head); err != nil {
+		return nil, err
+	}
+	return &head, nil
+}
+
+// QueryAudit : Call fn with each entry selected by filter until it returns false
+//
+// Entries are given oldest first, or newest first if reverse is set.
+func (s *Server) QueryAudit(filter AuditFilter, reverse bool, fn func(*AuditEntry) bool) error {
+	return s.users.Db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(AUDIT_T)).Cursor()
+		k, v := c.First()
+		if reverse {
+			k, v = c.Last()
+		}
+		for k != nil {
+			entry := AuditEntry{}
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return err
+			}
+			if filter.Matches(&entry) && !fn(&entry) {
+				return nil
+			}
+			if reverse {
+				k, v = c.Prev()
+			} else {
+				k, v = c.Next()
+			}
+		}
+		return nil
+	})
+}
+
+// auditKey : Get the key of an entry, ordering entries by ID
+func auditKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+// Audit : Record each request to an endpoint in the audit log
+//
+// The target is taken from the path and JSON body of the request. If
+// state is given, it summarises the target before and after the request.
+//
+// Place before the scope and role checks so refused requests are also recorded.
+func (server *Server) Audit(action string, state func(*gin.Context) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !audited(c) {
+			return
+		}
+
+		var before string
+		if state != nil {
+			before = state(c)
+		}
+
+		c.Next()
+
+		entry := AuditEntry{
+			Time:    time.Now(),
+			Actor:   c.GetString(AUTH_PRINCIPAL),
+			User:    c.GetString(AUTH_USER),
+			Address: remoteAddress(c),
+			Action:  action,
+			Target:  auditTarget(c),
+			Before:  before,
+			Status:  c.Writer.Status(),
+		}
+		if state != nil {
+			entry.After = state(c)
+		}
+		if err := server.AppendAudit(&entry); err != nil {
+			log.Error("Failed to record ", action, " by ", entry.Actor, " in the audit log ", err)
+		}
+	}
+}
+
+// audited : Should a request be recorded in the audit log
+//
+// Changes within COMPONENT_BUCKETS are routine traffic from the components
+// and would bury the actions of users. Every other request is recorded
+// whatever the method of authentication, as is deleting a component
+// bucket outright. The queue, event and log endpoints used by the
+// components are not audited at all.
+func audited(c *gin.Context) bool {
+	request := requestFields(c)
+	if !contains(COMPONENT_BUCKETS, request.Bucket) {
+		return true
+	}
+	return c.Request.Method == http.MethodDelete && strings.Trim(request.Child, "/") == ""
+}
+
+// auditTarget : Describe what a request acts on
+func auditTarget(c *gin.Context) string {
+	request := requestFields(c)
+	if request.Pipeline != "" {
+		return "pipeline/" + request.Pipeline
+	}
+
+	parts := make([]string, 0)
+	for _, part := range []string{request.Bucket, request.Child, request.Key} {
+		if part = strings.Trim(part, "/"); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) > 0 {
+		return strings.Join(parts, "/")
+	}
+
+	for _, value := range []string{c.Param("pipeline"), c.Param("id"), c.Param("name"), c.Param("address"), request.Email, request.Name, request.Address} {
+		if value != "" {
+			return value
+		}
+	}
+	return c.Request.URL.Path
+}
+
+// bucketState : Summarise the bucket or key a bucket request acts on
+//
+// Values are summarised by size and hash rather than recorded. Changes to
+// the credentials of a pipeline are noted.
+func (server *Server) bucketState(c *gin.Context) string {
+	request := requestFields(c)
+	request.Child = strings.Trim(request.Child, "/")
+	request.Key = strings.Trim(request.Key, "/")
+	if c.Request.Method != http.MethodPost && request.Key == "" {
+		request.Key = request.Child
+		request.Child = ""
+	}
+
+	var (
+		state string = "absent"
+		value []byte
+	)
+	server.api.Db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(request.Bucket))
+		if b != nil && request.Child != "" {
+			b = b.Bucket([]byte(request.Child))
+		}
+		switch {
+		case b == nil:
+		case request.Key == "":
+			state = fmt.Sprintf("bucket of %d keys", b.Stats().KeyN)
+		case b.Bucket([]byte(request.Key)) != nil:
+			state = "bucket"
+		default:
+			if v := b.Get([]byte(request.Key)); v != nil {
+				value = append([]byte(nil), v...)
+				sum := sha256.Sum256(value)
+				state = fmt.Sprintf("%d bytes sha256:%s", len(value), hex.EncodeToString(sum[:8]))
+			}
+		}
+		return nil
+	})
+
+	if request.Bucket == "pipeline" && request.Key != "" {
+		if creds := credentials(string(value)); creds != nil {
+			sum := sha256.Sum256([]byte(fmt.Sprintf("%v", creds)))
+			state += " credentials:" + hex.EncodeToString(sum[:8])
+		}
+	}
+	return state
+}
+
+// userState : Summarise the user a request acts on
+func (server *Server) userState(c *gin.Context) string {
+	var user *User
+	if id := c.Param("id"); id != "" {
+		user, _ = server.FindUserByID(id)
+	} else if email := requestFields(c).Email; email != "" {
+		user, _ = server.FindUser(email)
+	}
+	if user == nil {
+		return "absent"
+	}
+	account := server.account(user)
+	return fmt.Sprintf("groups=%s disabled=%t locked=%t totp=%t invited=%t",
+		strings.Join(account.Groups, ","), account.Disabled, account.Locked, account.Totp, account.Invited)
+}
+
+// groupState : Summarise the group a request acts on
+func (server *Server) groupState(c *gin.Context) string {
+	var name string = c.Param("name")
+	if name == "" {
+		name = requestFields(c).Name
+	}
+	group, _ := server.FindGroup(name)
+	if group == nil {
+		return "absent"
+	}
+	permissions := make([]string, 0)
+	for _, permission := range group.Permissions {
+		permissions = append(permissions, permission.Permission)
+	}
+	return "permissions=" + strings.Join(permissions, ",")
+}
+
+// aclState : Summarise the ACL of the pipeline a request acts on
+func (server *Server) aclState(c *gin.Context) string {
+	acl := server.FindACL(c.Param("pipeline"))
+	if acl == nil {
+		return "absent"
+	}
+	data, _ := json.Marshal(acl.Groups)
+	return fmt.Sprintf("owner=%s groups=%s", acl.Owner, data)
+}
+
+// auditFilter : Get the filter given in the query of a request
+func auditFilter(c *gin.Context) (AuditFilter, error) {
+	filter := AuditFilter{
+		Actor:  c.Query("actor"),
+		Action: c.Query("action"),
+		Target: c.Query("target"),
+	}
+	for name, value := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+		if c.Query(name) == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, c.Query(name))
+		if err != nil {
+			return filter, fmt.Errorf("%s must be an RFC3339 time", name)
+		}
+		*value = t
+	}
+	return filter, nil
+}
+
+// AuditLog : Query the audit log, newest first
+//
+// GET /api/v1/audit
+//
+// Query parameters, all optional:
+// - actor  - entries by this user email or token name
+// - action - entries whose action starts with this
+// - target - entries whose target contains this
+// - since  - RFC3339 time of the earliest entry
+// - until  - RFC3339 time entries must be before
+// - limit  - the most entries to return, default 100 and at most 1000
+//
+// Response codes:
+// - 200 OK
+// - 400 Bad request
+// - 500 Internal server error
+func (server *Server) AuditLog(c *gin.Context) {
+	filter, err := auditFilter(c)
+	if err != nil {
+		reply(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	var limit int = AUDIT_LIMIT
+	if c.Query("limit") != "" {
+		if limit, err = strconv.Atoi(c.Query("limit")); err != nil || limit < 1 {
+			reply(c, http.StatusBadRequest, "limit must be a positive number")
+			return
+		}
+	}
+	if limit > AUDIT_LIMIT_MAX {
+		limit = AUDIT_LIMIT_MAX
+	}
+
+	entries := make([]AuditEntry, 0)
+	if err := server.QueryAudit(filter, true, func(entry *AuditEntry) bool {
+		entries = append(entries, *entry)
+		return len(entries) < limit
+	}); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	reply(c, http.StatusOK, entries)
+}
+
+// ExportAudit : Download the audit log as newline delimited JSON, oldest first
+//
+// GET /api/v1/audit/export
+//
+// Takes the same query parameters as AuditLog other than limit.
+//
+// Response codes:
+// - 200 OK
+// - 400 Bad request
+func (server *Server) ExportAudit(c *gin.Context) {
+	filter, err := auditFilter(c)
+	if err != nil {
+		reply(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Header("Content-Disposition", `attachment; filename="audit.ndjson"`)
+	c.Status(http.StatusOK)
+	encoder := json.NewEncoder(c.Writer)
+	if err := server.QueryAudit(filter, false, func(entry *AuditEntry) bool {
+		return encoder.Encode(entry) == nil
+	}); err != nil {
+		log.Error("Failed to export audit log ", err)
+	}
+}
+
+// VerifyAudit : Check the hash chain of the audit log
+//
+// GET /api/v1/audit/verify
+//
+// The message holds the ID of the first entry which does not match the
+// chain, or 0 if every entry matches.
+//
+// Response codes:
+// - 200 OK
+// - 500 Internal server error
+func (server *Server) VerifyAudit(c *gin.Context) {
+	broken, err := server.verifyAudit()
+	if err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if broken != 0 {
+		log.Error("Audit log does not match its hash chain from entry ", broken)
+	}
+	reply(c, http.StatusOK, broken)
+}
+
+// verifyAudit : Get the ID of the first entry not matching the hash chain or 0
+//
+// If the log ends before the newest entry recorded apart from it, the ID
+// of the first missing entry is given.
+func (s *Server) verifyAudit() (uint64, error) {
+	var (
+		previous string
+		last     uint64
+		broken   uint64
+		head     *auditHead
+	)
+	if err := s.users.Db.View(func(tx *bolt.Tx) (err error) {
+		head, err = getAuditHead(tx)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+
+	err := s.QueryAudit(AuditFilter{}, false, func(entry *AuditEntry) bool {
+		var hash string = entry.Hash
+		entry.Hash = ""
+		data, _ := json.Marshal(entry)
+		sum := sha256.Sum256(append([]byte(previous), data...))
+		if hex.EncodeToString(sum[:]) != hash {
+			broken = entry.ID
+			return false
+		}
+		previous = hash
+		last = entry.ID
+		return true
+	})
+	if err != nil || broken != 0 || head == nil {
+		return broken, err
+	}
+
+	switch {
+	case last < head.ID:
+		broken = last + 1
+	case last > head.ID:
+		broken = head.ID + 1
+	case previous != head.Hash:
+		broken = last
+	}
+	return broken, nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+)
+
+func TestVerifyAudit(t *testing.T) {
+	tests := []struct {
+		name string
+
+		// Changes the log of five entries
+		tamper func(tx *bolt.Tx) error
+
+		broken uint64
+	}{
+		{
+			name:   "untouched",
+			tamper: func(tx *bolt.Tx) error { return nil },
+		},
+		{
+			name: "entry changed",
+			tamper: func(tx *bolt.Tx) error {
+				b := tx.Bucket([]byte(AUDIT_T))
+				entry := AuditEntry{}
+				if err := json.Unmarshal(b.Get(auditKey(3)), &entry); err != nil {
+					return err
+				}
+				entry.Actor = "someone else"
+				data, _ := json.Marshal(entry)
+				return b.Put(auditKey(3), data)
+			},
+			broken: 3,
+		},
+		{
+			name: "entry removed",
+			tamper: func(tx *bolt.Tx) error {
+				return tx.Bucket([]byte(AUDIT_T)).Delete(auditKey(3))
+			},
+			broken: 4,
+		},
+		{
+			name: "end removed",
+			tamper: func(tx *bolt.Tx) error {
+				b := tx.Bucket([]byte(AUDIT_T))
+				if err := b.Delete(auditKey(5)); err != nil {
+					return err
+				}
+				return b.Delete(auditKey(4))
+			},
+			broken: 4,
+		},
+		{
+			name: "entry added after the head",
+			tamper: func(tx *bolt.Tx) error {
+				b := tx.Bucket([]byte(AUDIT_T))
+				last := AuditEntry{}
+				if err := json.Unmarshal(b.Get(auditKey(5)), &last); err != nil {
+					return err
+				}
+				forged := AuditEntry{ID: 6, Actor: "someone else", Action: "flow.destroy"}
+				data, _ := json.Marshal(forged)
+				sum := sha256.Sum256(append([]byte(last.Hash), data...))
+				forged.Hash = hex.EncodeToString(sum[:])
+				data, _ = json.Marshal(forged)
+				return b.Put(auditKey(6), data)
+			},
+			broken: 6,
+		},
+		{
+			name: "head changed",
+			tamper: func(tx *bolt.Tx) error {
+				data, _ := json.Marshal(auditHead{ID: 5, Hash: "forged"})
+				return tx.Bucket([]byte(AUDIT_HEAD_T)).Put([]byte(AUDIT_HEAD), data)
+			},
+			broken: 5,
+		},
+		{
+			name: "log from before the head was kept",
+			tamper: func(tx *bolt.Tx) error {
+				return tx.Bucket([]byte(AUDIT_HEAD_T)).Delete([]byte(AUDIT_HEAD))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t)
+			for _, action := range []string{"bucket.put", "flow.execute", "acl.set", "bucket.delete", "flow.destroy"} {
+				if err := server.AppendAudit(&AuditEntry{Actor: "user@example.com", Action: action, Target: "pipeline/a", Status: http.StatusOK}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := server.users.Db.Update(test.tamper); err != nil {
+				t.Fatal(err)
+			}
+
+			broken, err := server.verifyAudit()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if broken != test.broken {
+				t.Errorf("expected broken at %d, got %d", test.broken, broken)
+			}
+		})
+	}
+}
+
+func TestAudited(t *testing.T) {
+	tests := []struct {
+		name string
+
+		// How the request was authenticated and by whom
+		auth      string
+		principal string
+		user      string
+
+		method string
+		path   string
+		body   string
+
+		audited bool
+	}{
+		{name: "session changes pipeline", auth: AUTH_SESSION, principal: "user@example.com", user: "user", method: http.MethodPut, path: "/bucket", body: `{"bucket": "pipeline", "key": "a"}`, audited: true},
+		{name: "config token changes pipeline", auth: AUTH_TOKEN, principal: "script", method: http.MethodPut, path: "/bucket", body: `{"bucket": "pipeline", "key": "a"}`, audited: true},
+		{name: "config token destroys flow", auth: AUTH_TOKEN, principal: "script", method: http.MethodPost, path: "/destroyflow", body: `{"pipeline": "a"}`, audited: true},
+		{name: "machine destroys flow", auth: AUTH_MACHINE, principal: "machine 192.0.2.1", method: http.MethodPost, path: "/destroyflow", body: `{"pipeline": "a"}`, audited: true},
+		{name: "machine changes queue", auth: AUTH_MACHINE, principal: "machine 192.0.2.1", method: http.MethodPut, path: "/bucket", body: `{"bucket": "queue", "child": "a", "key": "1"}`},
+		{name: "config token changes queue", auth: AUTH_TOKEN, principal: "flow", method: http.MethodPut, path: "/bucket", body: `{"bucket": "queue", "child": "a", "key": "1"}`},
+		{name: "session deletes queue key", auth: AUTH_SESSION, principal: "user@example.com", user: "user", method: http.MethodDelete, path: "/bucket/queue/a"},
+		{name: "config token deletes queue", auth: AUTH_TOKEN, principal: "script", method: http.MethodDelete, path: "/bucket/queue", audited: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t)
+			gin.SetMode(gin.TestMode)
+			engine := gin.New()
+			group := engine.Group(API_PREFIX, func(c *gin.Context) {
+				c.Set(AUTH_METHOD, test.auth)
+				c.Set(AUTH_PRINCIPAL, test.principal)
+				c.Set(AUTH_USER, test.user)
+			})
+			ok := func(c *gin.Context) { reply(c, http.StatusOK, "OK") }
+			group.PUT("/bucket", server.Audit("bucket.put", nil), ok)
+			group.DELETE("/bucket/:bucket", server.Audit("bucket.delete", nil), ok)
+			group.DELETE("/bucket/:bucket/:child", server.Audit("bucket.delete", nil), ok)
+			group.POST("/destroyflow", server.Audit("flow.destroy", nil), ok)
+
+			request := httptest.NewRequest(test.method, API_PREFIX+test.path, strings.NewReader(test.body))
+			engine.ServeHTTP(httptest.NewRecorder(), request)
+
+			entries := make([]AuditEntry, 0)
+			if err := server.QueryAudit(AuditFilter{}, false, func(entry *AuditEntry) bool {
+				entries = append(entries, *entry)
+				return true
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if !test.audited {
+				if len(entries) != 0 {
+					t.Errorf("expected nothing recorded, got %v", entries)
+				}
+				return
+			}
+			if len(entries) != 1 || entries[0].Actor != test.principal {
+				t.Errorf("expected one entry by %q, got %v", test.principal, entries)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/client"
+	"github.com/notapipeline/tiyo/pkg/config"
+	"github.com/notapipeline/tiyo/pkg/logging"
+	"github.com/notapipeline/tiyo/pkg/server/api"
+)
+
+// Keys set on the gin context of an authenticated request
+const (
+	// The user email or token name making the request
+	AUTH_PRINCIPAL string = "principal"
+
+	// The scopes granted to the request as []string
+	AUTH_SCOPES string = "scopes"
+
+	// The ID of the user making the request. Empty for tokens from the config
+	AUTH_USER string = "user"
+
+	// How the request was authenticated. One of AUTH_SESSION, AUTH_TOKEN or AUTH_MACHINE
+	AUTH_METHOD string = "method"
+
+	// If set, the pipelines the request is restricted to as []string
+	AUTH_PIPELINES string = "pipelines"
+
+	// The address of the machine signing the request
+	AUTH_MACHINE_ADDRESS string = "machineAddress"
+)
+
+// Values of AUTH_METHOD
+const (
+	AUTH_SESSION string = "session"
+	AUTH_TOKEN   string = "token"
+	AUTH_MACHINE string = "machine"
+)
+
+// API_PREFIX : The path all API endpoints sit beneath
+const API_PREFIX string = "/api/v1"
+
+// SESSION_SCOPES : Scopes granted to users signed in through the browser
+//
+// Members of the admin group are granted every scope.
+var SESSION_SCOPES []string = []string{config.SCOPE_READ, config.SCOPE_WRITE}
+
+// MACHINE_SCOPES : Scopes granted to requests signed with a machine token
+//
+// Writes are limited to COMPONENT_BUCKETS by RequireRole.
+var MACHINE_SCOPES []string = []string{config.SCOPE_READ, config.SCOPE_WRITE, config.SCOPE_QUEUE}
+
+// ALL_SCOPES : Every scope understood by the API
+var ALL_SCOPES []string = []string{config.SCOPE_READ, config.SCOPE_WRITE, config.SCOPE_QUEUE, config.SCOPE_ADMIN}
+
+// API : Get the router group for API endpoints
+//
+// Every route added to the group requires authentication. Assemble accepts
+// the browser session cookie or a bearer token whilst flow, which has no
+// sessions, only accepts bearer tokens. Each route must then state the
+// scope it requires with RequireScope.
+func (server *Server) API(c *config.Config) *gin.RouterGroup {
+	if server.config == nil {
+		server.config = c
+	}
+	group := server.engine.Group(API_PREFIX)
+	if server.securetoken != nil {
+		group.Use(sessions.Sessions(config.SESSION_COOKIE_NAME, server.securetoken))
+	}
+	group.Use(server.Authenticate)
+	return group
+}
+
+// Authenticate : Identify the caller of an API endpoint
+//
+// A bearer token in the Authorization header is checked first, followed by
+// a machine token signature and then the session cookie. Requests carrying
+// none of these are refused with 401.
+//
+// Bearer tokens are looked for in the config then, where there is a users
+// database, amongst the personal API tokens.
+func (server *Server) Authenticate(c *gin.Context) {
+	if header := c.GetHeader("Authorization"); header != "" {
+		var secret string = strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+		if !strings.HasPrefix(header, "Bearer ") {
+			secret = ""
+		}
+		c.Set(AUTH_METHOD, AUTH_TOKEN)
+		if name, token := server.config.FindToken(secret); token != nil {
+			c.Set(AUTH_PRINCIPAL, name)
+			c.Set(AUTH_SCOPES, token.Scopes)
+			return
+		}
+		if server.users != nil {
+			// The owner is loaded on every use so a token never grants more
+			// than its owner currently holds.
+			if token := server.FindAPIToken(secret); token != nil && !server.IsDisabled(token.Owner) {
+				owner, err := server.FindUserByID(token.Owner)
+				if err != nil {
+					logging.Entry(c.Request.Context()).Warn("Token ", token.ID, " presented for missing user ", token.Owner)
+					unauthorized(c)
+					return
+				}
+				c.Set(AUTH_PRINCIPAL, "token "+token.ID)
+				c.Set(AUTH_USER, token.Owner)
+				c.Set(AUTH_SCOPES, intersect(token.Scopes, sessionScopes(owner)))
+				c.Set(AUTH_GROUPS, groupNames(owner))
+				if len(token.Pipelines) > 0 {
+					c.Set(AUTH_PIPELINES, token.Pipelines)
+				}
+				return
+			}
+		}
+		logging.Entry(c.Request.Context()).Warn("Invalid token presented by ", remoteAddress(c), " for ", c.Request.URL.Path)
+		unauthorized(c)
+		return
+	}
+
+	if c.GetHeader(client.HEADER_SIGNATURE) != "" && server.users != nil {
+		c.Set(AUTH_METHOD, AUTH_MACHINE)
+		machine, err := server.VerifyMachine(c)
+		if err != nil {
+			logging.Entry(c.Request.Context()).Warn("Invalid signature presented by ", remoteAddress(c), " for ", c.Request.URL.Path, " - ", err)
+			unauthorized(c)
+			return
+		}
+		c.Set(AUTH_PRINCIPAL, "machine "+machine.Address)
+		c.Set(AUTH_MACHINE_ADDRESS, machine.Address)
+		c.Set(AUTH_SCOPES, MACHINE_SCOPES)
+		return
+	}
+
+	if _, ok := c.Get(sessions.DefaultKey); ok {
+		session := sessions.Default(c)
+		if err := server.ValidateSession(session); err == nil {
+			if user, ok := session.Get("User").(User); ok && !server.IsDisabled(user.ID) {
+				c.Set(AUTH_METHOD, AUTH_SESSION)
+				c.Set(AUTH_PRINCIPAL, user.Email)
+				c.Set(AUTH_USER, user.ID)
+				c.Set(AUTH_GROUPS, groupNames(&user))
+				c.Set(AUTH_SCOPES, sessionScopes(&user))
+				return
+			}
+		}
+	}
+	unauthorized(c)
+}
+
+// RequireScope : Refuse requests not granted scope with 403
+//
+// Requests restricted to pipelines are also refused unless the path names
+// one of them, either as the pipeline or as the child bucket.
+//
+// Must follow Authenticate
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if HasScope(c, scope) && pipelineAllowed(c) {
+			return
+		}
+		logging.Entry(c.Request.Context()).Warn(c.GetString(AUTH_PRINCIPAL), " denied ", scope, " access to ", c.Request.URL.Path)
+		denied(c)
+	}
+}
+
+// RequireSession : Refuse requests not made from a browser session with 403
+//
+// Must follow Authenticate
+func RequireSession(c *gin.Context) {
+	if c.GetString(AUTH_METHOD) == AUTH_SESSION {
+		return
+	}
+	logging.Entry(c.Request.Context()).Warn(c.GetString(AUTH_PRINCIPAL), " denied token access to ", c.Request.URL.Path)
+	denied(c)
+}
+
+// RequireMachine : Refuse requests not signed with a machine token with 403
+//
+// Must follow Authenticate
+func RequireMachine(c *gin.Context) {
+	if c.GetString(AUTH_METHOD) == AUTH_MACHINE {
+		return
+	}
+	logging.Entry(c.Request.Context()).Warn(c.GetString(AUTH_PRINCIPAL), " denied machine access to ", c.Request.URL.Path)
+	denied(c)
+}
+
+// HasScope : Has the request been granted scope
+func HasScope(c *gin.Context, scope string) bool {
+	for _, s := range c.GetStringSlice(AUTH_SCOPES) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// pipelineAllowed : Is the request free to use the pipeline named in its path
+func pipelineAllowed(c *gin.Context) bool {
+	pipelines := c.GetStringSlice(AUTH_PIPELINES)
+	if len(pipelines) == 0 {
+		return true
+	}
+	var name string = c.Param("pipeline")
+	if name == "" {
+		name = c.Param("child")
+	}
+	for _, pipeline := range pipelines {
+		if name != "" && name == pipeline {
+			return true
+		}
+	}
+	return false
+}
+
+// sessionScopes : Get the scopes granted to a user signed in through the browser
+func sessionScopes(user *User) []string {
+	if isAdmin(user) {
+		return ALL_SCOPES
+	}
+	return SESSION_SCOPES
+}
+
+// intersect : Get the values held in both a and b
+func intersect(a []string, b []string) []string {
+	values := make([]string, 0)
+	for _, value := range a {
+		if contains(b, value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// remoteAddress : Get the address of the peer a request was received from
+//
+// Unlike ClientIP this ignores forwarding headers, which any client can
+// set, so it is safe to count, lock and audit requests against.
+func remoteAddress(c *gin.Context) string {
+	ip, _ := c.RemoteIP()
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
+
+// denied : Refuse a request not granted access to an endpoint
+func denied(c *gin.Context) {
+	result := api.NewResult()
+	result.Code = http.StatusForbidden
+	result.Result = "Error"
+	result.Message = "Permission denied"
+	c.AbortWithStatusJSON(result.Code, result)
+}
+
+// unauthorized : Refuse a request which could not be authenticated
+func unauthorized(c *gin.Context) {
+	result := api.NewResult()
+	result.Code = http.StatusUnauthorized
+	result.Result = "Error"
+	result.Message = "Authentication required"
+	c.Header("WWW-Authenticate", `Bearer realm="tiyo"`)
+	c.AbortWithStatusJSON(result.Code, result)
+}
@@ -0,0 +1,189 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/config"
+)
+
+// newTestServer : Create an assemble server with an empty users database
+func newTestServer(t *testing.T) *Server {
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "users.db"), 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	server := &Server{
+		config: &config.Config{
+			Assemble: config.Host{Passphrase: "test passphrase"},
+			Tokens: map[string]config.Token{
+				"flow": {Token: "flow secret", Scopes: []string{config.SCOPE_READ, config.SCOPE_QUEUE}},
+			},
+		},
+		users: &Lockable{Db: db},
+	}
+	if err := server.CreateTables(); err != nil {
+		t.Fatal(err)
+	}
+	return server
+}
+
+// addTestUser : Add a user, a member of the admin group if admin is set
+func addTestUser(t *testing.T, server *Server, email string, admin bool) *User {
+	user := &User{Email: email, Password: "password", Groups: make([]Group, 0)}
+	if admin {
+		group, err := server.FindGroup(ADMIN_GROUP)
+		if err != nil || group == nil {
+			group = &Group{Name: ADMIN_GROUP}
+			if err := server.AddGroup(group); err != nil {
+				t.Fatal(err)
+			}
+		}
+		user.Groups = append(user.Groups, *group)
+	}
+	if err := server.AddUser(user); err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+// testContext : Create a context for request, returning the recorder holding the response
+func testContext(request *http.Request) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = request
+	return c, recorder
+}
+
+func TestAuthenticateTokenScopes(t *testing.T) {
+	server := newTestServer(t)
+	admin := addTestUser(t, server, "admin@example.com", true)
+	editor := addTestUser(t, server, "editor@example.com", false)
+	demoted := addTestUser(t, server, "demoted@example.com", true)
+	disabled := addTestUser(t, server, "disabled@example.com", false)
+
+	tests := []struct {
+		name string
+
+		// The token presented. Created for owner if secret is empty.
+		secret  string
+		owner   *User
+		scopes  []string
+		expires time.Time
+
+		// Run after the token is created
+		after func(t *testing.T)
+
+		code     int
+		expected []string
+	}{
+		{
+			name:     "config token",
+			secret:   "flow secret",
+			expected: []string{config.SCOPE_READ, config.SCOPE_QUEUE},
+		},
+		{
+			name:   "unknown token",
+			secret: "tiyo_unknown.secret",
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:     "admin token held by admin",
+			owner:    admin,
+			scopes:   []string{config.SCOPE_READ, config.SCOPE_ADMIN},
+			expected: []string{config.SCOPE_READ, config.SCOPE_ADMIN},
+		},
+		{
+			name:     "admin token held by editor",
+			owner:    editor,
+			scopes:   []string{config.SCOPE_READ, config.SCOPE_WRITE, config.SCOPE_QUEUE, config.SCOPE_ADMIN},
+			expected: []string{config.SCOPE_READ, config.SCOPE_WRITE},
+		},
+		{
+			name:   "admin token held by demoted admin",
+			owner:  demoted,
+			scopes: []string{config.SCOPE_WRITE, config.SCOPE_ADMIN},
+			after: func(t *testing.T) {
+				if err := server.SetGroups(demoted, make([]Group, 0)); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expected: []string{config.SCOPE_WRITE},
+		},
+		{
+			name:   "token held by disabled user",
+			owner:  disabled,
+			scopes: []string{config.SCOPE_READ},
+			after: func(t *testing.T) {
+				if err := server.DisableUser(disabled, true); err != nil {
+					t.Fatal(err)
+				}
+			},
+			code: http.StatusUnauthorized,
+		},
+		{
+			name:    "expired token",
+			owner:   editor,
+			scopes:  []string{config.SCOPE_READ},
+			expires: time.Now().Add(-time.Minute),
+			code:    http.StatusUnauthorized,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var secret string = test.secret
+			if secret == "" {
+				var err error
+				secret, err = server.AddAPIToken(&APIToken{
+					Name:    test.name,
+					Owner:   test.owner.ID,
+					Scopes:  test.scopes,
+					Expires: test.expires,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if test.after != nil {
+				test.after(t)
+			}
+
+			request := httptest.NewRequest(http.MethodGet, API_PREFIX+"/bucket", nil)
+			request.Header.Set("Authorization", "Bearer "+secret)
+			c, recorder := testContext(request)
+			server.Authenticate(c)
+
+			if test.code != 0 {
+				if !c.IsAborted() || recorder.Code != test.code {
+					t.Fatalf("expected %d, got %d", test.code, recorder.Code)
+				}
+				return
+			}
+			if c.IsAborted() {
+				t.Fatalf("token was refused with %d", recorder.Code)
+			}
+			if scopes := c.GetStringSlice(AUTH_SCOPES); !reflect.DeepEqual(scopes, test.expected) {
+				t.Errorf("expected scopes %v, got %v", test.expected, scopes)
+			}
+			if test.owner != nil && c.GetString(AUTH_USER) != test.owner.ID {
+				t.Errorf("expected user %s, got %s", test.owner.ID, c.GetString(AUTH_USER))
+			}
+		})
+	}
+}
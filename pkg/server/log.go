@@ -10,27 +10,23 @@ import (
 	"fmt"
 	"math"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/notapipeline/tiyo/pkg/config"
+	"github.com/notapipeline/tiyo/pkg/logging"
 	log "github.com/sirupsen/logrus"
 )
 
-func Logger(logfile string, notLogged ...string) gin.HandlerFunc {
+// Logger : Log each request to a rotated log file in /var/log/tiyo named after the component
+func Logger(name string, notLogged ...string) gin.HandlerFunc {
 	var skip map[string]struct{}
 
-	src, err := os.OpenFile(logfile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
-	if err != nil {
-		fmt.Println("err", err)
-	}
-
 	logger := log.New()
-	logger.Out = src
+	logger.Out = logging.Rotate(name)
 
 	logger.SetLevel(log.GetLevel())
-	logger.SetFormatter(&log.TextFormatter{})
+	logger.SetFormatter(logging.Formatter())
 
 	if length := len(notLogged); length > 0 {
 		skip = make(map[string]struct{}, length)
@@ -66,7 +62,7 @@ func Logger(logfile string, notLogged ...string) gin.HandlerFunc {
 			"path":       path,
 			"referer":    referer,
 			"dataLength": dataLength,
-		})
+		}).WithFields(logging.Entry(c.Request.Context()).Data)
 
 		if len(c.Errors) > 0 {
 			entry.Error(c.Errors.ByType(gin.ErrorTypePrivate).String())
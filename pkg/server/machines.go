@@ -0,0 +1,528 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/notapipeline/tiyo/pkg/client"
+	"github.com/notapipeline/tiyo/pkg/server/api"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// How long a machine token works for
+	MACHINE_EXPIRY time.Duration = 365 * 24 * time.Hour
+
+	// How long the previous token of a machine keeps working after rotation
+	MACHINE_OVERLAP time.Duration = 24 * time.Hour
+
+	// How far the timestamp of a signed request may be from the time it is received
+	MACHINE_SKEW time.Duration = 5 * time.Minute
+
+	// How often the last use of a machine token is written back to the database
+	MACHINE_LAST_USED time.Duration = time.Minute
+
+	// The number of random bytes in a machine token
+	MACHINE_SECRET_LENGTH int = 32
+)
+
+// machineReplays : Signatures accepted from machines which are still in date
+var machineReplays = &replayCache{seen: make(map[string]time.Time)}
+
+// legacyExpiry : Finds the expiry in tokens issued before machine records were kept
+var legacyExpiry = regexp.MustCompile(`"expires": "([^"]+)"`)
+
+// Machine : A flow host enrolled with a machine token
+//
+// Machines sign each request with their token and are identified by the
+// address the token is valid for, which they send with each request. The
+// database holds the token encrypted with the assemble passphrase as it
+// is needed to check signatures.
+type Machine struct {
+
+	// The ID of the current token
+	ID string `json:"id"`
+
+	// The address the token is valid for
+	Address string `json:"address"`
+
+	// When the current token was issued
+	Created time.Time `json:"created"`
+
+	// When the current token stops working
+	Expires time.Time `json:"expires"`
+
+	// When the token replaced by the last rotation stops working
+	PreviousExpires time.Time `json:"previousExpires,omitempty"`
+
+	// When the machine last made a signed request, to the nearest MACHINE_LAST_USED
+	LastUsed time.Time `json:"lastUsed,omitempty"`
+
+	// The encrypted current token
+	Token string `json:"token,omitempty"`
+
+	// The encrypted token replaced by the last rotation
+	Previous string `json:"previous,omitempty"`
+}
+
+// machineToken : The contents of the token handed to a machine
+//
+// Secret holds random bytes so no two tokens are alike. ValidFor is read
+// by the client to name the machine in signed requests.
+type machineToken struct {
+	ID       string    `json:"id"`
+	Secret   string    `json:"secret"`
+	ValidFor string    `json:"validFor"`
+	Expires  time.Time `json:"expires"`
+}
+
+// replayCache : Signatures already accepted, kept until their timestamp
+// falls out of MACHINE_SKEW
+type replayCache struct {
+	sync.Mutex
+	seen map[string]time.Time
+}
+
+// first : Record a signature, reporting if it has not been seen before
+//
+// expires is when the signature stops being accepted. Signatures past
+// their expiry are forgotten.
+func (cache *replayCache) first(signature string, expires time.Time) bool {
+	cache.Lock()
+	defer cache.Unlock()
+	for key, when := range cache.seen {
+		if time.Now().After(when) {
+			delete(cache.seen, key)
+		}
+	}
+	if _, ok := cache.seen[signature]; ok {
+		return false
+	}
+	cache.seen[signature] = expires
+	return true
+}
+
+// machineReply : The response to enrolling or rotating a machine
+type machineReply struct {
+	Address string    `json:"address"`
+	Token   string    `json:"token"`
+	Expires time.Time `json:"expires"`
+}
+
+// Expired : Has the current token passed its expiry
+func (machine *Machine) Expired() bool {
+	return time.Now().After(machine.Expires)
+}
+
+// tokens : Get the encrypted tokens of the machine which still work
+func (machine *Machine) tokens() []string {
+	tokens := make([]string, 0)
+	if !machine.Expired() {
+		tokens = append(tokens, machine.Token)
+	}
+	if machine.Previous != "" && time.Now().Before(machine.PreviousExpires) {
+		tokens = append(tokens, machine.Previous)
+	}
+	return tokens
+}
+
+// FindMachine : Get the machine enrolled at address
+//
+// Returns nil if no machine is enrolled there
+func (s *Server) FindMachine(address string) *Machine {
+	var value string = s.get(address, MACHINE_TOKENS)
+	if value == "" {
+		return nil
+	}
+	machine, err := s.decodeMachine(address, []byte(value))
+	if err != nil {
+		log.Error("Invalid machine ", address, " ", err)
+		return nil
+	}
+	return machine
+}
+
+// AddMachine : Enrol a machine at address and get its token
+//
+// Machines already holding a token which has not expired are given that token.
+func (s *Server) AddMachine(address string) (string, error) {
+	if machine := s.FindMachine(address); machine != nil && !machine.Expired() {
+		return s.unsealToken(machine.Token)
+	}
+
+	log.Infof("Generating key for %s", address)
+	machine := &Machine{Address: address}
+	token, err := s.issueMachineToken(machine)
+	if err != nil {
+		return "", err
+	}
+	if err := s.saveMachine(machine); err != nil {
+		return "", fmt.Errorf("Failed to add or update machine instance %s, %s", address, err)
+	}
+	return token, nil
+}
+
+// RotateMachineToken : Issue a new token to the machine at address
+//
+// The token being replaced keeps working for MACHINE_OVERLAP, or until it
+// expires if that is sooner, so requests signed with it are not refused
+// whilst the machine picks up the new token.
+func (s *Server) RotateMachineToken(address string) (*Machine, string, error) {
+	machine := s.FindMachine(address)
+	if machine == nil || machine.Expired() {
+		return nil, "", fmt.Errorf("No machine enrolled at %s", address)
+	}
+
+	machine.Previous = machine.Token
+	machine.PreviousExpires = time.Now().Add(MACHINE_OVERLAP)
+	if machine.Expires.Before(machine.PreviousExpires) {
+		machine.PreviousExpires = machine.Expires
+	}
+	token, err := s.issueMachineToken(machine)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.saveMachine(machine); err != nil {
+		return nil, "", err
+	}
+	return machine, token, nil
+}
+
+// RevokeMachineToken : Stop the tokens of the machine at address from working
+//
+// The HMAC kept for machines enrolled before tokens were signed with is
+// removed too.
+func (s *Server) RevokeMachineToken(address string) error {
+	if err := s.delete(address, MACHINE_HMAC); err != nil {
+		return err
+	}
+	return s.delete(address, MACHINE_TOKENS)
+}
+
+// ListMachines : Get every enrolled machine without its tokens
+func (s *Server) ListMachines() ([]Machine, error) {
+	machines := make([]Machine, 0)
+	err := s.users.Db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(MACHINE_TOKENS)).ForEach(func(k, v []byte) error {
+			machine, err := s.decodeMachine(string(k), v)
+			if err != nil {
+				return err
+			}
+			machine.Token = ""
+			machine.Previous = ""
+			machines = append(machines, *machine)
+			return nil
+		})
+	})
+	return machines, err
+}
+
+// VerifyMachine : Check the signature of a request from a machine
+//
+// The request must name the machine signing it, be signed by a token of
+// that machine which still works, and be signed within MACHINE_SKEW of
+// now. Each signature is only accepted once. The body is read to check
+// its digest and replaced for later handlers.
+//
+// The address the request comes from is not checked against the address
+// the machine was enrolled at. Machines behind NAT, load balancers or
+// Kubernetes networking rarely present it and forwarding headers cannot
+// be trusted, so the address only names the machine and the secret in
+// its token authenticates it.
+func (s *Server) VerifyMachine(c *gin.Context) (*Machine, error) {
+	timestamp, err := strconv.ParseInt(c.GetHeader(client.HEADER_TIMESTAMP), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid timestamp")
+	}
+	if skew := time.Since(time.Unix(timestamp, 0)); skew > MACHINE_SKEW || skew < -MACHINE_SKEW {
+		return nil, fmt.Errorf("Timestamp is %s from now", skew.Round(time.Second))
+	}
+	var nonce string = c.GetHeader(client.HEADER_NONCE)
+	if nonce == "" {
+		return nil, fmt.Errorf("Missing nonce")
+	}
+	signature, err := hex.DecodeString(c.GetHeader(client.HEADER_SIGNATURE))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid signature")
+	}
+
+	var address string = c.GetHeader(client.HEADER_MACHINE)
+	machine := s.FindMachine(address)
+	if machine == nil {
+		return nil, fmt.Errorf("No machine enrolled at %q", address)
+	}
+
+	var body []byte
+	if c.Request.Body != nil {
+		if body, err = ioutil.ReadAll(c.Request.Body); err != nil {
+			return nil, err
+		}
+		c.Request.Body.Close()
+		c.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	for _, sealed := range machine.tokens() {
+		token, err := s.unsealToken(sealed)
+		if err != nil {
+			log.Error("Cannot decrypt token of machine ", machine.Address, " ", err)
+			continue
+		}
+		expected, _ := hex.DecodeString(client.Sign(token, c.Request.Method, c.Request.URL.RequestURI(), timestamp, nonce, body))
+		if !hmac.Equal(expected, signature) {
+			continue
+		}
+		if !machineReplays.first(machine.Address+" "+hex.EncodeToString(signature), time.Unix(timestamp, 0).Add(MACHINE_SKEW)) {
+			return nil, fmt.Errorf("Signature has already been used")
+		}
+
+		if time.Since(machine.LastUsed) > MACHINE_LAST_USED {
+			machine.LastUsed = time.Now()
+			if err := s.saveMachine(machine); err != nil {
+				log.Warn("Failed to record use of machine ", machine.Address, " ", err)
+			}
+		}
+		return machine, nil
+	}
+	return nil, fmt.Errorf("Signature does not match a current token")
+}
+
+// issueMachineToken : Create a new token for machine, replacing its current token
+func (s *Server) issueMachineToken(machine *Machine) (string, error) {
+	secret := make([]byte, MACHINE_SECRET_LENGTH)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	machine.ID = uuid.New().String()
+	machine.Created = time.Now()
+	machine.Expires = machine.Created.Add(MACHINE_EXPIRY)
+	data, err := json.Marshal(machineToken{
+		ID:       machine.ID,
+		Secret:   base64.StdEncoding.EncodeToString(secret),
+		ValidFor: machine.Address,
+		Expires:  machine.Expires,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var token string = base64.StdEncoding.EncodeToString(data)
+	if machine.Token, err = s.sealToken(token); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// decodeMachine : Read the machine record held for address
+//
+// Tokens issued before records were kept are stored as the bare token and
+// are converted to a record. Their expiry is read from the token and, if
+// that cannot be read, the token is treated as expired.
+func (s *Server) decodeMachine(address string, value []byte) (*Machine, error) {
+	machine := Machine{}
+	if err := json.Unmarshal(value, &machine); err == nil {
+		return &machine, nil
+	}
+
+	machine.Address = address
+	if data, err := base64.StdEncoding.DecodeString(string(value)); err == nil {
+		if match := legacyExpiry.FindSubmatch(data); match != nil {
+			// written with the default format of time.Time, possibly with a monotonic clock reading
+			var expires string = strings.SplitN(string(match[1]), " m=", 2)[0]
+			machine.Expires, _ = time.Parse("2006-01-02 15:04:05.999999999 -0700 MST", expires)
+		}
+	}
+	if machine.Expires.IsZero() {
+		log.Warn("Cannot read the expiry of the token for ", address, ". It must be enrolled again")
+	}
+
+	var err error
+	if machine.Token, err = s.sealToken(string(value)); err != nil {
+		return nil, err
+	}
+	return &machine, nil
+}
+
+func (s *Server) saveMachine(machine *Machine) error {
+	data, err := json.Marshal(machine)
+	if err != nil {
+		return err
+	}
+	return s.replace(machine.Address, MACHINE_TOKENS, string(data))
+}
+
+// sealToken : Encrypt a machine token for storage
+func (s *Server) sealToken(token string) (string, error) {
+	data, err := api.EncryptData([]byte(token), s.config.GetPassphrase("assemble"))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// unsealToken : Decrypt a stored machine token
+func (s *Server) unsealToken(sealed string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", err
+	}
+	token, err := api.DecryptData(data, s.config.GetPassphrase("assemble"))
+	return string(token), err
+}
+
+// Machines : List the enrolled machines
+//
+// GET /api/v1/machines
+//
+// Response codes:
+// - 200 OK
+// - 500 Internal server error
+func (server *Server) Machines(c *gin.Context) {
+	machines, err := server.ListMachines()
+	if err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	reply(c, http.StatusOK, machines)
+}
+
+// EnrolMachine : Issue a token to a machine
+//
+// POST /api/v1/machines
+//
+// Machines which are already enrolled have their token rotated.
+//
+// Request parameters:
+// - address - [optional] the address of the machine. Defaults to the address of the caller
+//
+// Response codes:
+// - 201 Created - message holds the token which is not shown again
+// - 400 Bad request
+// - 500 Internal server error
+func (server *Server) EnrolMachine(c *gin.Context) {
+	var request struct {
+		Address string `json:"address"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil && err != io.EOF {
+		reply(c, http.StatusBadRequest, fmt.Sprintf("Invalid request - %s", err))
+		return
+	}
+	if request.Address == "" {
+		request.Address = remoteAddress(c)
+	}
+	if net.ParseIP(request.Address) == nil {
+		reply(c, http.StatusBadRequest, "address must be an IP address")
+		return
+	}
+
+	if machine := server.FindMachine(request.Address); machine != nil && !machine.Expired() {
+		server.rotate(c, request.Address)
+		return
+	}
+	token, err := server.AddMachine(request.Address)
+	if err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	machine := server.FindMachine(request.Address)
+	log.Info("Machine ", request.Address, " enrolled by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusCreated, machineReply{Address: machine.Address, Token: token, Expires: machine.Expires})
+}
+
+// MachineStatus : Get the machine signing the request
+//
+// GET /api/v1/machine
+//
+// Response codes:
+// - 200 OK
+// - 404 Not found
+func (server *Server) MachineStatus(c *gin.Context) {
+	machine := server.FindMachine(c.GetString(AUTH_MACHINE_ADDRESS))
+	if machine == nil {
+		reply(c, http.StatusNotFound, "No such machine")
+		return
+	}
+	machine.Token = ""
+	machine.Previous = ""
+	reply(c, http.StatusOK, machine)
+}
+
+// RotateMachine : Issue a new token to a machine
+//
+// POST /api/v1/machines/:address/rotate
+// POST /api/v1/machine/rotate
+//
+// Admins rotate the token of any machine. Machines rotate their own token
+// with a request signed by their current token. The replaced token keeps
+// working for MACHINE_OVERLAP.
+//
+// Response codes:
+// - 201 Created - message holds the token which is not shown again
+// - 404 Not found
+// - 500 Internal server error
+func (server *Server) RotateMachine(c *gin.Context) {
+	var address string = c.Params.ByName("address")
+	if address == "" {
+		address = c.GetString(AUTH_MACHINE_ADDRESS)
+	}
+	server.rotate(c, address)
+}
+
+func (server *Server) rotate(c *gin.Context, address string) {
+	if machine := server.FindMachine(address); machine == nil || machine.Expired() {
+		reply(c, http.StatusNotFound, "No such machine")
+		return
+	}
+	machine, token, err := server.RotateMachineToken(address)
+	if err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("Token of machine ", address, " rotated by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusCreated, machineReply{Address: machine.Address, Token: token, Expires: machine.Expires})
+}
+
+// RevokeMachine : Stop the tokens of a machine from working
+//
+// DELETE /api/v1/machines/:address
+//
+// Response codes:
+// - 202 Accepted
+// - 404 Not found
+// - 500 Internal server error
+func (server *Server) RevokeMachine(c *gin.Context) {
+	var address string = c.Params.ByName("address")
+	if server.FindMachine(address) == nil {
+		reply(c, http.StatusNotFound, "No such machine")
+		return
+	}
+	if err := server.RevokeMachineToken(address); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("Machine ", address, " revoked by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusAccepted, "Machine revoked")
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/notapipeline/tiyo/pkg/client"
+)
+
+func TestVerifyMachine(t *testing.T) {
+	server := newTestServer(t)
+
+	const (
+		enrolled string = "10.1.2.3"
+		rotated  string = "10.1.2.4"
+		revoked  string = "10.1.2.5"
+	)
+	token, err := server.AddMachine(enrolled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous, err := server.AddMachine(rotated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := server.RotateMachineToken(rotated); err != nil {
+		t.Fatal(err)
+	}
+	withdrawn, err := server.AddMachine(revoked)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := server.RevokeMachineToken(revoked); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+
+		// The token signing the request and the machine it names
+		token   string
+		machine string
+
+		// How long ago the request was signed
+		age time.Duration
+
+		// Changes the request after it is signed
+		tamper func(*http.Request)
+
+		// Verify the request a second time, expecting it to be refused
+		replay bool
+
+		valid bool
+	}{
+		{name: "signed", token: token, machine: enrolled, valid: true},
+		{name: "from another address", token: token, machine: enrolled, tamper: func(r *http.Request) { r.RemoteAddr = "203.0.113.9:4000" }, valid: true},
+		{name: "within skew", token: token, machine: enrolled, age: MACHINE_SKEW - time.Minute, valid: true},
+		{name: "too old", token: token, machine: enrolled, age: MACHINE_SKEW + time.Minute},
+		{name: "from the future", token: token, machine: enrolled, age: -MACHINE_SKEW - time.Minute},
+		{name: "replayed", token: token, machine: enrolled, replay: true, valid: true},
+		{name: "wrong token", token: previous, machine: enrolled},
+		{name: "unknown machine", token: token, machine: "10.9.9.9"},
+		{name: "body changed", token: token, machine: enrolled, tamper: func(r *http.Request) {
+			r.Body = ioutil.NopCloser(strings.NewReader(`{"bucket": "pipeline"}`))
+		}},
+		{name: "path changed", token: token, machine: enrolled, tamper: func(r *http.Request) { r.URL.Path = API_PREFIX + "/decrypt" }},
+		{name: "nonce missing", token: token, machine: enrolled, tamper: func(r *http.Request) { r.Header.Del(client.HEADER_NONCE) }},
+		{name: "token replaced by rotation", token: previous, machine: rotated, valid: true},
+		{name: "token revoked", token: withdrawn, machine: revoked},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				body      string = `{"bucket": "queue"}`
+				uri       string = API_PREFIX + "/bucket"
+				timestamp int64  = time.Now().Add(-test.age).Unix()
+				random    []byte = make([]byte, 16)
+			)
+			rand.Read(random)
+			var nonce string = hex.EncodeToString(random)
+
+			request := httptest.NewRequest(http.MethodPut, uri, strings.NewReader(body))
+			request.Header.Set(client.HEADER_MACHINE, test.machine)
+			request.Header.Set(client.HEADER_TIMESTAMP, fmt.Sprintf("%d", timestamp))
+			request.Header.Set(client.HEADER_NONCE, nonce)
+			request.Header.Set(client.HEADER_SIGNATURE, client.Sign(test.token, http.MethodPut, uri, timestamp, nonce, []byte(body)))
+			if test.tamper != nil {
+				test.tamper(request)
+			}
+
+			c, _ := testContext(request)
+			machine, err := server.VerifyMachine(c)
+			if test.valid && err != nil {
+				t.Fatalf("expected signature to be accepted, got %s", err)
+			}
+			if !test.valid {
+				if err == nil {
+					t.Fatal("expected signature to be refused")
+				}
+				return
+			}
+			if machine.Address != test.machine {
+				t.Errorf("expected machine %s, got %s", test.machine, machine.Address)
+			}
+
+			if test.replay {
+				request.Body = ioutil.NopCloser(strings.NewReader(body))
+				c, _ := testContext(request)
+				if _, err := server.VerifyMachine(c); err == nil {
+					t.Error("expected replayed signature to be refused")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// httpDuration : Latency of every request handled by the server
+var httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "tiyo",
+	Subsystem: "http",
+	Name:      "request_duration_seconds",
+	Help:      "Time taken to handle HTTP requests",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"method", "route", "code"})
+
+// Metrics : Record the latency of each request against the matched route
+//
+// The route template is used rather than the path so that pipeline names
+// and keys do not create a new series per request.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		var route string = c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		httpDuration.WithLabelValues(
+			c.Request.Method, route, strconv.Itoa(c.Writer.Status())).Observe(time.Since(start).Seconds())
+	}
+}
+
+// MetricsHandler : Serve prometheus metrics
+//
+// GET /metrics
+func MetricsHandler() gin.HandlerFunc {
+	return gin.WrapH(promhttp.Handler())
+}
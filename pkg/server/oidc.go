@@ -0,0 +1,174 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+// Keys of the session holding an OIDC sign in whilst the user is at the issuer
+const (
+	OIDC_STATE    string = "OidcState"
+	OIDC_NONCE    string = "OidcNonce"
+	OIDC_VERIFIER string = "OidcVerifier"
+)
+
+// OIDCLogin : Send the user to the OIDC issuer to sign in
+//
+// GET /oidc/login
+//
+// The state, nonce and PKCE verifier are kept in the session until the
+// issuer returns the user to OIDCCallback.
+func (server *Server) OIDCLogin(c *gin.Context) {
+	if server.config.OIDC.Provider == nil {
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	var values []string = make([]string, 3)
+	for i := range values {
+		value, err := randomString()
+		if err != nil {
+			server.Error(c, http.StatusInternalServerError, err)
+			return
+		}
+		values[i] = value
+	}
+	state, nonce, verifier := values[0], values[1], values[2]
+
+	session := sessions.Default(c)
+	session.Set(OIDC_STATE, state)
+	session.Set(OIDC_NONCE, nonce)
+	session.Set(OIDC_VERIFIER, verifier)
+	if err := session.Save(); err != nil {
+		server.Error(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	challenge := sha256.Sum256([]byte(verifier))
+	c.Redirect(http.StatusFound, server.config.OIDC.OAuth2.AuthCodeURL(state,
+		oidc.Nonce(nonce),
+		oauth2.SetAuthURLParam("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:])),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	))
+}
+
+// OIDCCallback : Complete an OIDC sign in
+//
+// GET /oidc/callback
+//
+// The code is exchanged for an ID token which must be signed by the
+// issuer for this client and carry the nonce sent with the user. The
+// user is then signed in under the email claim, which the issuer must
+// mark as verified, with the groups in the groups claim.
+func (server *Server) OIDCCallback(c *gin.Context) {
+	if server.config.OIDC.Provider == nil {
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	session := sessions.Default(c)
+	state, _ := session.Get(OIDC_STATE).(string)
+	nonce, _ := session.Get(OIDC_NONCE).(string)
+	verifier, _ := session.Get(OIDC_VERIFIER).(string)
+	session.Delete(OIDC_STATE)
+	session.Delete(OIDC_NONCE)
+	session.Delete(OIDC_VERIFIER)
+	if err := session.Save(); err != nil {
+		server.Error(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if message := c.Query("error"); message != "" {
+		log.Warnf("OIDC: Issuer refused sign in: %s %s", message, c.Query("error_description"))
+		c.Redirect(http.StatusFound, "/login?error=sso")
+		return
+	}
+	if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(c.Query("state"))) != 1 {
+		server.Error(c, http.StatusBadRequest, fmt.Errorf("OIDC: Invalid state"))
+		return
+	}
+
+	ctx := server.config.OIDC.Context(c.Request.Context())
+	token, err := server.config.OIDC.OAuth2.Exchange(ctx, c.Query("code"),
+		oauth2.SetAuthURLParam("code_verifier", verifier))
+	if err != nil {
+		server.Error(c, http.StatusUnauthorized, fmt.Errorf("OIDC: Failed to exchange code: %s", err))
+		return
+	}
+
+	raw, ok := token.Extra("id_token").(string)
+	if !ok {
+		server.Error(c, http.StatusUnauthorized, fmt.Errorf("OIDC: Missing token: id_token"))
+		return
+	}
+	idToken, err := server.config.OIDC.Verifier.Verify(ctx, raw)
+	if err != nil {
+		server.Error(c, http.StatusUnauthorized, fmt.Errorf("OIDC: Invalid ID token: %s", err))
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(idToken.Nonce), []byte(nonce)) != 1 {
+		server.Error(c, http.StatusUnauthorized, fmt.Errorf("OIDC: Invalid nonce"))
+		return
+	}
+
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		server.Error(c, http.StatusUnauthorized, fmt.Errorf("OIDC: Invalid claims: %s", err))
+		return
+	}
+
+	email, _ := claims[server.config.OIDC.EmailClaim].(string)
+	if email == "" {
+		server.Error(c, http.StatusUnauthorized, fmt.Errorf("OIDC: Missing token: %s", server.config.OIDC.EmailClaim))
+		return
+	}
+	// the email is only trusted to identify an account once the issuer
+	// has verified it, so a missing claim is refused as well
+	if verified, _ := claims["email_verified"].(bool); !verified {
+		server.Error(c, http.StatusUnauthorized, fmt.Errorf("OIDC: Email %s has not been verified", email))
+		return
+	}
+
+	server.ssoSignin(c, SSO_OIDC, email, claimStrings(claims[server.config.OIDC.GroupsClaim]), "/")
+}
+
+// claimStrings : Get a claim holding a string or list of strings as a list
+func claimStrings(claim interface{}) []string {
+	values := make([]string, 0)
+	switch claim := claim.(type) {
+	case string:
+		values = append(values, claim)
+	case []interface{}:
+		for _, value := range claim {
+			if value, ok := value.(string); ok {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// randomString : Get 32 random bytes encoded for use in a URL
+func randomString() (string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
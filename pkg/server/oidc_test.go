@@ -0,0 +1,357 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/json"
+	"html/template"
+	"math/big"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/config"
+)
+
+const testClientID string = "tiyo"
+
+// grant : An authorization code issued by the mock issuer
+type grant struct {
+
+	// The PKCE challenge the code was issued against
+	challenge string
+
+	// The claims of the ID token returned for the code
+	claims map[string]interface{}
+}
+
+// mockIssuer : An OpenID Connect issuer serving discovery, keys and tokens
+type mockIssuer struct {
+	sync.Mutex
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	grants map[string]grant
+}
+
+// newMockIssuer : Start a mock issuer
+func newMockIssuer(t *testing.T) *mockIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := &mockIssuer{
+		key:    key,
+		grants: make(map[string]grant),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                issuer.server.URL,
+			"authorization_endpoint":                issuer.server.URL + "/authorize",
+			"token_endpoint":                        issuer.server.URL + "/token",
+			"jwks_uri":                              issuer.server.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"alg": "RS256",
+				"use": "sig",
+				"kid": "test",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", issuer.token)
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+// token : Exchange a code for an ID token, checking the PKCE verifier
+func (issuer *mockIssuer) token(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	issuer.Lock()
+	grant, ok := issuer.grants[r.PostForm.Get("code")]
+	delete(issuer.grants, r.PostForm.Get("code"))
+	issuer.Unlock()
+
+	digest := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(digest[:]) != grant.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+
+	claims := map[string]interface{}{
+		"iss": issuer.server.URL,
+		"aud": testClientID,
+		"sub": "subject",
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for key, value := range grant.claims {
+		claims[key] = value
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     issuer.sign(claims),
+	})
+}
+
+// sign : Sign claims as an RS256 JWT
+func (issuer *mockIssuer) sign(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "test", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	var signed string = base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, _ := rsa.SignPKCS1v15(rand.Reader, issuer.key, crypto.SHA256, digest[:])
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// issue : Issue a code for a challenge which returns an ID token holding claims
+func (issuer *mockIssuer) issue(challenge string, claims map[string]interface{}) string {
+	issuer.Lock()
+	defer issuer.Unlock()
+	var code string = "code-" + challenge[:8]
+	issuer.grants[code] = grant{
+		challenge: challenge,
+		claims:    claims,
+	}
+	return code
+}
+
+// newOIDCServer : Create an assemble server signing in through issuer
+//
+// Users are provisioned on first sign in so a successful callback
+// redirects to the index.
+func newOIDCServer(t *testing.T, issuer *mockIssuer) *httptest.Server {
+	gob.Register(time.Time{})
+	gob.Register(User{})
+
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "users.db"), 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	server := &Server{
+		config: &config.Config{
+			Assemble: config.Host{Passphrase: "test passphrase"},
+			OIDC: &config.OIDC{
+				Issuer:      issuer.server.URL,
+				ClientID:    testClientID,
+				RedirectURL: "http://assemble.test/oidc/callback",
+			},
+			SSO: config.SSO{Provision: true},
+		},
+		users: &Lockable{Db: db},
+	}
+	if err := server.CreateTables(); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.config.ConfigureOIDC(); err != nil {
+		t.Fatal(err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.SetHTMLTemplate(template.Must(template.New("error").Parse("{{.}}")))
+	engine.Use(sessions.Sessions(config.SESSION_COOKIE_NAME, cookie.NewStore([]byte("test secret"))))
+	engine.GET("/oidc/login", server.OIDCLogin)
+	engine.GET("/oidc/callback", server.OIDCCallback)
+
+	assemble := httptest.NewServer(engine)
+	t.Cleanup(assemble.Close)
+	return assemble
+}
+
+// oidcLogin : Start a sign in, returning the client holding the session and
+// the state, nonce and PKCE challenge sent to the issuer
+func oidcLogin(t *testing.T, assemble *httptest.Server) (*http.Client, url.Values) {
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	response, err := client.Get(assemble.URL + "/oidc/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusFound {
+		t.Fatalf("login returned %d, expected %d", response.StatusCode, http.StatusFound)
+	}
+
+	location, err := url.Parse(response.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := location.Query()
+	if query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+		t.Fatalf("login did not send a PKCE challenge: %s", location)
+	}
+	if query.Get("state") == "" || query.Get("nonce") == "" {
+		t.Fatalf("login did not send a state and nonce: %s", location)
+	}
+	return client, query
+}
+
+// callback : Return from the issuer with state and code
+func callback(t *testing.T, client *http.Client, assemble *httptest.Server, state string, code string) *http.Response {
+	response, err := client.Get(assemble.URL + "/oidc/callback?" + url.Values{
+		"state": {state},
+		"code":  {code},
+	}.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	return response
+}
+
+func TestOIDCCallback(t *testing.T) {
+	issuer := newMockIssuer(t)
+	assemble := newOIDCServer(t, issuer)
+
+	tests := []struct {
+		name string
+
+		// Changes the state returned to the callback
+		state func(string) string
+
+		// Changes the challenge the code is issued against
+		challenge func(string) string
+
+		// Claims of the ID token, given the nonce sent by assemble
+		claims func(string) map[string]interface{}
+
+		code     int
+		location string
+	}{
+		{
+			name: "signed in",
+			claims: func(nonce string) map[string]interface{} {
+				return map[string]interface{}{"nonce": nonce, "email": "user@example.com", "email_verified": true}
+			},
+			code:     http.StatusFound,
+			location: "/",
+		},
+		{
+			name:  "state mismatch",
+			state: func(string) string { return "forged" },
+			claims: func(nonce string) map[string]interface{} {
+				return map[string]interface{}{"nonce": nonce, "email": "user@example.com", "email_verified": true}
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name:      "PKCE mismatch",
+			challenge: func(string) string { return base64.RawURLEncoding.EncodeToString(make([]byte, 32)) },
+			claims: func(nonce string) map[string]interface{} {
+				return map[string]interface{}{"nonce": nonce, "email": "user@example.com", "email_verified": true}
+			},
+			code: http.StatusUnauthorized,
+		},
+		{
+			name: "nonce mismatch",
+			claims: func(string) map[string]interface{} {
+				return map[string]interface{}{"nonce": "forged", "email": "user@example.com", "email_verified": true}
+			},
+			code: http.StatusUnauthorized,
+		},
+		{
+			name: "email not verified",
+			claims: func(nonce string) map[string]interface{} {
+				return map[string]interface{}{"nonce": nonce, "email": "user@example.com", "email_verified": false}
+			},
+			code: http.StatusUnauthorized,
+		},
+		{
+			name: "email verification missing",
+			claims: func(nonce string) map[string]interface{} {
+				return map[string]interface{}{"nonce": nonce, "email": "user@example.com"}
+			},
+			code: http.StatusUnauthorized,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, sent := oidcLogin(t, assemble)
+
+			var (
+				state     string = sent.Get("state")
+				challenge string = sent.Get("code_challenge")
+			)
+			if test.state != nil {
+				state = test.state(state)
+			}
+			if test.challenge != nil {
+				challenge = test.challenge(challenge)
+			}
+
+			response := callback(t, client, assemble, state, issuer.issue(challenge, test.claims(sent.Get("nonce"))))
+			if response.StatusCode != test.code {
+				t.Fatalf("callback returned %d, expected %d", response.StatusCode, test.code)
+			}
+			if test.location != "" && response.Header.Get("Location") != test.location {
+				t.Errorf("callback redirected to %q, expected %q", response.Header.Get("Location"), test.location)
+			}
+		})
+	}
+}
+
+func TestOIDCCallbackReplay(t *testing.T) {
+	issuer := newMockIssuer(t)
+	assemble := newOIDCServer(t, issuer)
+
+	client, sent := oidcLogin(t, assemble)
+	var claims map[string]interface{} = map[string]interface{}{
+		"nonce":          sent.Get("nonce"),
+		"email":          "user@example.com",
+		"email_verified": true,
+	}
+
+	response := callback(t, client, assemble, sent.Get("state"), issuer.issue(sent.Get("code_challenge"), claims))
+	if response.StatusCode != http.StatusFound {
+		t.Fatalf("callback returned %d, expected %d", response.StatusCode, http.StatusFound)
+	}
+
+	// the state is removed from the session once used
+	response = callback(t, client, assemble, sent.Get("state"), issuer.issue(sent.Get("code_challenge"), claims))
+	if response.StatusCode != http.StatusBadRequest {
+		t.Fatalf("replayed callback returned %d, expected %d", response.StatusCode, http.StatusBadRequest)
+	}
+}
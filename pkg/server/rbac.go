@@ -0,0 +1,462 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/config"
+	"github.com/notapipeline/tiyo/pkg/logging"
+	"github.com/notapipeline/tiyo/pkg/pipeline"
+	"github.com/notapipeline/tiyo/pkg/server/api"
+	log "github.com/sirupsen/logrus"
+)
+
+// Roles a group may hold on a pipeline, each including those before it
+const (
+	// View the pipeline, its queue, logs and statistics
+	ROLE_VIEWER string = "viewer"
+
+	// Change the pipeline, its schedules and submit or cancel work
+	ROLE_EDITOR string = "editor"
+
+	// Execute, start and stop the pipeline
+	ROLE_OPERATOR string = "operator"
+
+	// Destroy the pipeline, change its credentials and who may use it
+	ROLE_ADMIN string = "admin"
+)
+
+// AUTH_GROUPS : Key on the gin context holding the group names of the user as []string
+const AUTH_GROUPS string = "groups"
+
+// roles : The rank of each role
+var roles map[string]int = map[string]int{
+	ROLE_VIEWER:   1,
+	ROLE_EDITOR:   2,
+	ROLE_OPERATOR: 3,
+	ROLE_ADMIN:    4,
+}
+
+// COMPONENT_BUCKETS : Buckets flow, fill and syphon keep their state in
+//
+// Machines may only change these, and changes to them are routine traffic
+// which is not audited.
+var COMPONENT_BUCKETS []string = []string{"events", "files", "pods", "queue", api.RUNNING_BUCKET, api.QUEUED_BUCKET}
+
+// PipelineACL : Who may use a pipeline
+//
+// Stored in the acl table keyed by the bucket name of the pipeline. The
+// owner and members of the admin group always hold the admin role.
+type PipelineACL struct {
+
+	// The bucket name of the pipeline
+	Pipeline string `json:"pipeline"`
+
+	// The ID of the user who created the pipeline
+	Owner string `json:"owner"`
+
+	// The role held by each group, by group name
+	Groups map[string]string `json:"groups"`
+}
+
+// pipelineRequest : The fields of a request naming what it acts on
+type pipelineRequest struct {
+	Pipeline string `json:"pipeline"`
+	Bucket   string `json:"bucket"`
+	Child    string `json:"child"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Address  string `json:"address"`
+}
+
+// FindACL : Get the ACL of a pipeline or nil if it has none
+func (s *Server) FindACL(name string) *PipelineACL {
+	var value string = s.get(pipeline.Sanitize(name, "_"), ACL_T)
+	if value == "" {
+		return nil
+	}
+	acl := PipelineACL{}
+	if err := json.Unmarshal([]byte(value), &acl); err != nil {
+		log.Error("Invalid ACL for ", name, " ", err)
+		return nil
+	}
+	return &acl
+}
+
+// SaveACL : Store the ACL of a pipeline
+func (s *Server) SaveACL(acl *PipelineACL) error {
+	acl.Pipeline = pipeline.Sanitize(acl.Pipeline, "_")
+	data, err := json.Marshal(acl)
+	if err != nil {
+		return err
+	}
+	return s.replace(acl.Pipeline, ACL_T, string(data))
+}
+
+// Role : Get the role a user with groups holds on a pipeline
+//
+// Returns an empty string if the user holds no role
+func (acl *PipelineACL) Role(user string, groups []string) string {
+	if user != "" && user == acl.Owner {
+		return ROLE_ADMIN
+	}
+	var role string
+	for _, group := range groups {
+		if held, ok := acl.Groups[group]; ok && roles[held] > roles[role] {
+			role = held
+		}
+	}
+	return role
+}
+
+// RequireRole : Refuse requests by users not holding role on the pipeline they name
+//
+// The pipeline is taken from the path, the JSON body or, for pages, the
+// pipeline cookie. Members of the admin group are not checked, nor are
+// tokens from the config other than needing the admin scope to act as an
+// operator or admin. Machines may view anything but only change
+// COMPONENT_BUCKETS.
+//
+// Pipelines without an ACL are either new, in which case anyone storing
+// the pipeline definition becomes its owner, or were created before ACLs
+// existed and are left to the admin group until it assigns them. Any other
+// request for a pipeline without an ACL is refused, as are requests
+// changing data outside any pipeline. Scans of the pipeline bucket are
+// filtered by visible.
+//
+// Must follow Authenticate or RequireAccount
+func (server *Server) RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, groups := server.member(c)
+		if contains(groups, ADMIN_GROUP) {
+			return
+		}
+		if c.GetString(AUTH_METHOD) == AUTH_MACHINE {
+			if role == ROLE_VIEWER || (role == ROLE_EDITOR && contains(COMPONENT_BUCKETS, requestFields(c).Bucket)) {
+				return
+			}
+			server.refuse(c, role, "")
+			return
+		}
+		if user == "" && c.GetString(AUTH_METHOD) == AUTH_TOKEN {
+			if roles[role] < roles[ROLE_OPERATOR] || HasScope(c, config.SCOPE_ADMIN) {
+				return
+			}
+			server.refuse(c, role, "")
+			return
+		}
+		if user == "" {
+			server.refuse(c, role, "")
+			return
+		}
+
+		name, request := server.pipelineOf(c)
+		if name == "" {
+			if role == ROLE_VIEWER {
+				return
+			}
+			server.refuse(c, role, name)
+			return
+		}
+
+		acl := server.FindACL(name)
+		if acl == nil {
+			if request != nil && c.Request.Method == http.MethodPut && !server.pipelineExists(name) {
+				server.claim(c, name, user, groups)
+				return
+			}
+			server.refuse(c, role, name)
+			return
+		}
+
+		held := acl.Role(user, groups)
+		if roles[held] < roles[role] {
+			server.refuse(c, role, name)
+			return
+		}
+
+		// only pipeline admins may change credentials
+		if request != nil && c.Request.Method == http.MethodPut && held != ROLE_ADMIN && server.credentialsChanged(request.Key, request.Value) {
+			server.refuse(c, ROLE_ADMIN, name)
+		}
+	}
+}
+
+// visible : May the caller view the pipeline key found scanning the pipeline bucket
+//
+// Scans are not for a single pipeline so pass RequireRole. Users who are
+// not in the admin group only see the pipelines they hold a role on.
+func (server *Server) visible(c *gin.Context, bucket string, key string) bool {
+	if bucket != "pipeline" {
+		return true
+	}
+	user, groups := server.member(c)
+	if user == "" || contains(groups, ADMIN_GROUP) {
+		return true
+	}
+	acl := server.FindACL(key)
+	return acl != nil && roles[acl.Role(user, groups)] >= roles[ROLE_VIEWER]
+}
+
+// member : Get the ID and group names of the user making a request
+//
+// The ID is empty for tokens from the config
+func (server *Server) member(c *gin.Context) (string, []string) {
+	if _, ok := c.Get(AUTH_METHOD); ok {
+		return c.GetString(AUTH_USER), c.GetStringSlice(AUTH_GROUPS)
+	}
+	if user, ok := sessions.Default(c).Get("User").(User); ok {
+		return user.ID, groupNames(&user)
+	}
+	return "", nil
+}
+
+// pipelineOf : Get the name of the pipeline a request is for
+//
+// If the request stores a pipeline definition, the request is also returned
+func (server *Server) pipelineOf(c *gin.Context) (string, *pipelineRequest) {
+	if name := c.Param("pipeline"); name != "" {
+		return name, nil
+	}
+
+	if !strings.HasPrefix(c.Request.URL.Path, API_PREFIX) {
+		if cookie, err := c.Cookie("pipeline"); err == nil {
+			name, _ := url.QueryUnescape(cookie)
+			return name, nil
+		}
+		return "", nil
+	}
+
+	request := requestFields(c)
+	if request.Pipeline != "" {
+		return request.Pipeline, nil
+	}
+
+	request.Child = strings.Trim(request.Child, "/")
+	request.Key = strings.Trim(request.Key, "/")
+
+	// keys and child buckets are both named by the child of a created
+	// bucket. Otherwise, as in api.bind, a child without a key is a key.
+	if c.Request.Method != http.MethodPost && strings.HasPrefix(c.FullPath(), API_PREFIX+"/bucket") && request.Key == "" {
+		request.Key = request.Child
+		request.Child = ""
+	}
+
+	if request.Bucket == "pipeline" {
+		// scans of the pipeline bucket are filtered by visible
+		if !strings.HasPrefix(c.FullPath(), API_PREFIX+"/bucket") {
+			return "", nil
+		}
+		return request.Key, &request
+	}
+	return request.Child, nil
+}
+
+// requestFields : Get the fields of the path and JSON body naming what a request acts on
+//
+// The body is restored to be read again by the handler.
+func requestFields(c *gin.Context) pipelineRequest {
+	request := pipelineRequest{
+		Bucket: c.Param("bucket"),
+		Child:  c.Param("child"),
+		Key:    c.Param("key"),
+	}
+	if c.Request.Method == http.MethodPost || c.Request.Method == http.MethodPut {
+		data, err := ioutil.ReadAll(c.Request.Body)
+		if err != nil {
+			return request
+		}
+		c.Request.Body = ioutil.NopCloser(bytes.NewReader(data))
+		json.Unmarshal(data, &request)
+	}
+	return request
+}
+
+// pipelineExists : Has a pipeline been saved under name
+//
+// Pipelines are stored under the name given by the user whilst the other
+// buckets and ACLs use its bucket name, so names are compared as bucket names.
+func (server *Server) pipelineExists(name string) bool {
+	var (
+		bucketName string = pipeline.Sanitize(name, "_")
+		exists     bool
+	)
+	server.api.Db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket([]byte("pipeline")); b != nil {
+			return b.ForEach(func(k, v []byte) error {
+				exists = exists || pipeline.Sanitize(string(k), "_") == bucketName
+				return nil
+			})
+		}
+		return nil
+	})
+	return exists
+}
+
+// credentialsChanged : Does value change the credentials stored against the pipeline name
+func (server *Server) credentialsChanged(name string, value string) bool {
+	var existing []byte
+	server.api.Db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket([]byte("pipeline")); b != nil {
+			existing = b.Get([]byte(name))
+		}
+		return nil
+	})
+	return !reflect.DeepEqual(credentials(string(existing)), credentials(value))
+}
+
+// credentials : Get the credentials from a base64 encoded pipeline definition
+func credentials(value string) interface{} {
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil
+	}
+	var content map[string]interface{}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil
+	}
+	if creds, ok := content["credentials"].(map[string]interface{}); ok && len(creds) > 0 {
+		return creds
+	}
+	return nil
+}
+
+// claim : Make the user the owner of a new pipeline once it is saved
+//
+// The groups of the user, other than admin, are given the admin role.
+func (server *Server) claim(c *gin.Context, name string, user string, groups []string) {
+	c.Next()
+	if c.Request.Method != http.MethodPut || c.Writer.Status() >= http.StatusBadRequest || !server.pipelineExists(name) {
+		return
+	}
+	acl := PipelineACL{
+		Pipeline: name,
+		Owner:    user,
+		Groups:   make(map[string]string),
+	}
+	for _, group := range groups {
+		if group != ADMIN_GROUP {
+			acl.Groups[group] = ROLE_ADMIN
+		}
+	}
+	if err := server.SaveACL(&acl); err != nil {
+		logging.Entry(c.Request.Context()).Error("Failed to assign owner of ", name, " ", err)
+		return
+	}
+	logging.Entry(c.Request.Context()).Info("Pipeline ", name, " created by ", c.GetString(AUTH_PRINCIPAL))
+}
+
+// refuse : Refuse a request not holding role on a pipeline
+func (server *Server) refuse(c *gin.Context, role string, name string) {
+	logging.Entry(c.Request.Context()).Warn(c.GetString(AUTH_PRINCIPAL), " denied ", role, " role on pipeline '", name, "' for ", c.Request.URL.Path)
+	if !strings.HasPrefix(c.Request.URL.Path, API_PREFIX) {
+		server.Error(c, http.StatusForbidden, fmt.Errorf("You do not have permission to view this pipeline"))
+		c.Abort()
+		return
+	}
+	denied(c)
+}
+
+// groupNames : Get the names of the groups of a user
+func groupNames(user *User) []string {
+	names := make([]string, 0)
+	for _, group := range user.Groups {
+		names = append(names, group.Name)
+	}
+	return names
+}
+
+// contains : Is value in values
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ACL : Get who may use a pipeline
+//
+// GET /api/v1/acl/:pipeline
+//
+// Response codes:
+// - 200 OK
+// - 404 Not found - the pipeline has no ACL
+func (server *Server) ACL(c *gin.Context) {
+	acl := server.FindACL(c.Params.ByName("pipeline"))
+	if acl == nil {
+		reply(c, http.StatusNotFound, "No ACL for pipeline")
+		return
+	}
+	reply(c, http.StatusOK, acl)
+}
+
+// SetACL : Change who may use a pipeline
+//
+// PUT /api/v1/acl/:pipeline
+//
+// Request parameters:
+// - owner  - [optional] the ID of the user who owns the pipeline
+// - groups - the role held by each group, by group name
+//
+// Response codes:
+// - 200 OK
+// - 400 Bad request
+// - 404 Not found - no pipeline has been saved under the name
+// - 500 Internal server error
+func (server *Server) SetACL(c *gin.Context) {
+	if !server.pipelineExists(c.Params.ByName("pipeline")) {
+		reply(c, http.StatusNotFound, "No such pipeline")
+		return
+	}
+
+	request := PipelineACL{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		reply(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	for group, role := range request.Groups {
+		if _, ok := roles[role]; !ok {
+			reply(c, http.StatusBadRequest, fmt.Sprintf("Invalid role %s", role))
+			return
+		}
+		if existing, _ := server.FindGroup(group); existing == nil {
+			reply(c, http.StatusBadRequest, fmt.Sprintf("No such group %s", group))
+			return
+		}
+	}
+
+	acl := server.FindACL(c.Params.ByName("pipeline"))
+	if acl == nil {
+		acl = &PipelineACL{Pipeline: c.Params.ByName("pipeline")}
+	}
+	if request.Owner != "" {
+		acl.Owner = request.Owner
+	}
+	acl.Groups = request.Groups
+	if err := server.SaveACL(acl); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("ACL of pipeline ", acl.Pipeline, " changed by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusOK, acl)
+}
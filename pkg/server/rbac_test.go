@@ -0,0 +1,193 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/config"
+	"github.com/notapipeline/tiyo/pkg/server/api"
+)
+
+// caller : Who a test request is made as
+type caller struct {
+	method string
+	user   string
+	groups []string
+	scopes []string
+}
+
+// definition : Get a base64 encoded pipeline definition holding password
+func definition(password string) string {
+	return base64.StdEncoding.EncodeToString([]byte(`{"credentials": {"password": "` + password + `"}}`))
+}
+
+// newRBACServer : Create a server holding pipelines a and b with routes guarded by RequireRole
+//
+// Group team views a and group editors edit it. Pipeline b is owned by
+// another user.
+func newRBACServer(t *testing.T) (*Server, func(caller) *gin.Engine) {
+	server := newTestServer(t)
+
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "tiyo.db"), 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	server.api = &api.API{Db: &api.Database{DB: db}, Config: server.config}
+	server.api.Visible = server.visible
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte("pipeline"))
+		if err != nil {
+			return err
+		}
+		for _, name := range []string{"a", "b"} {
+			if err := b.Put([]byte(name), []byte(definition("secret"))); err != nil {
+				return err
+			}
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte("queue"))
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, acl := range []PipelineACL{
+		{Pipeline: "a", Owner: "owner", Groups: map[string]string{"team": ROLE_VIEWER, "editors": ROLE_EDITOR}},
+		{Pipeline: "b", Owner: "other", Groups: map[string]string{}},
+	} {
+		acl := acl
+		if err := server.SaveACL(&acl); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ok := func(c *gin.Context) { reply(c, http.StatusOK, "OK") }
+	engine := func(as caller) *gin.Engine {
+		gin.SetMode(gin.TestMode)
+		engine := gin.New()
+		group := engine.Group(API_PREFIX, func(c *gin.Context) {
+			c.Set(AUTH_METHOD, as.method)
+			c.Set(AUTH_PRINCIPAL, as.method+" "+as.user)
+			c.Set(AUTH_USER, as.user)
+			c.Set(AUTH_GROUPS, as.groups)
+			c.Set(AUTH_SCOPES, as.scopes)
+		})
+		group.GET("/bucket/:bucket/:child", server.RequireRole(ROLE_VIEWER), ok)
+		group.PUT("/bucket", server.RequireRole(ROLE_EDITOR), ok)
+		group.GET("/scan/:bucket", server.RequireRole(ROLE_VIEWER), server.api.PrefixScan)
+		group.GET("/scan/:bucket/:child", server.RequireRole(ROLE_VIEWER), server.api.PrefixScan)
+		group.GET("/logs/:pipeline", server.RequireRole(ROLE_VIEWER), ok)
+		group.POST("/execute", server.RequireRole(ROLE_OPERATOR), ok)
+		return engine
+	}
+	return server, engine
+}
+
+func TestRequireRole(t *testing.T) {
+	_, engine := newRBACServer(t)
+
+	var (
+		viewer  caller = caller{method: AUTH_SESSION, user: "viewer", groups: []string{"team"}}
+		editor  caller = caller{method: AUTH_SESSION, user: "editor", groups: []string{"editors"}}
+		admin   caller = caller{method: AUTH_SESSION, user: "admin", groups: []string{ADMIN_GROUP}}
+		machine caller = caller{method: AUTH_MACHINE}
+		token   caller = caller{method: AUTH_TOKEN, scopes: []string{config.SCOPE_READ, config.SCOPE_WRITE}}
+	)
+
+	tests := []struct {
+		name   string
+		as     caller
+		method string
+		path   string
+		body   string
+		code   int
+	}{
+		{name: "viewer reads own pipeline", as: viewer, method: http.MethodGet, path: "/bucket/pipeline/a", code: http.StatusOK},
+		{name: "viewer reads other pipeline", as: viewer, method: http.MethodGet, path: "/bucket/pipeline/b", code: http.StatusForbidden},
+		{name: "viewer reads logs of own pipeline", as: viewer, method: http.MethodGet, path: "/logs/a", code: http.StatusOK},
+		{name: "viewer reads logs of other pipeline", as: viewer, method: http.MethodGet, path: "/logs/b", code: http.StatusForbidden},
+		{name: "viewer changes pipeline", as: viewer, method: http.MethodPut, path: "/bucket", body: `{"bucket": "pipeline", "key": "a", "value": "{}"}`, code: http.StatusForbidden},
+		{name: "editor changes pipeline", as: editor, method: http.MethodPut, path: "/bucket", body: `{"bucket": "pipeline", "key": "a", "value": "` + definition("secret") + `"}`, code: http.StatusOK},
+		{name: "editor changes credentials", as: editor, method: http.MethodPut, path: "/bucket", body: `{"bucket": "pipeline", "key": "a", "value": "` + definition("changed") + `"}`, code: http.StatusForbidden},
+		{name: "editor executes pipeline", as: editor, method: http.MethodPost, path: "/execute", body: `{"pipeline": "a"}`, code: http.StatusForbidden},
+		{name: "editor changes pipeline without ACL", as: editor, method: http.MethodPut, path: "/bucket", body: `{"bucket": "pipeline", "key": "legacy", "value": "{}"}`, code: http.StatusOK},
+		{name: "admin reads other pipeline", as: admin, method: http.MethodGet, path: "/bucket/pipeline/b", code: http.StatusOK},
+		{name: "machine reads pipeline", as: machine, method: http.MethodGet, path: "/bucket/pipeline/b", code: http.StatusOK},
+		{name: "machine changes pipeline", as: machine, method: http.MethodPut, path: "/bucket", body: `{"bucket": "pipeline", "key": "a", "value": "{}"}`, code: http.StatusForbidden},
+		{name: "machine changes queue", as: machine, method: http.MethodPut, path: "/bucket", body: `{"bucket": "queue", "child": "a", "key": "1", "value": "{}"}`, code: http.StatusOK},
+		{name: "token without admin scope executes pipeline", as: token, method: http.MethodPost, path: "/execute", body: `{"pipeline": "a"}`, code: http.StatusForbidden},
+		{name: "anonymous reads pipeline", as: caller{method: AUTH_SESSION}, method: http.MethodGet, path: "/bucket/pipeline/a", code: http.StatusForbidden},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(test.method, API_PREFIX+test.path, strings.NewReader(test.body))
+			recorder := httptest.NewRecorder()
+			engine(test.as).ServeHTTP(recorder, request)
+			if recorder.Code != test.code {
+				t.Errorf("expected %d, got %d", test.code, recorder.Code)
+			}
+		})
+	}
+}
+
+func TestScanPipelines(t *testing.T) {
+	_, engine := newRBACServer(t)
+
+	tests := []struct {
+		name     string
+		as       caller
+		path     string
+		expected []string
+	}{
+		{name: "viewer of a", as: caller{method: AUTH_SESSION, user: "viewer", groups: []string{"team"}}, path: "/scan/pipeline", expected: []string{"a"}},
+		{name: "viewer of a scanning prefix b", as: caller{method: AUTH_SESSION, user: "viewer", groups: []string{"team"}}, path: "/scan/pipeline/b", expected: []string{}},
+		{name: "owner of b", as: caller{method: AUTH_SESSION, user: "other"}, path: "/scan/pipeline", expected: []string{"b"}},
+		{name: "user without roles", as: caller{method: AUTH_SESSION, user: "nobody", groups: []string{"strangers"}}, path: "/scan/pipeline", expected: []string{}},
+		{name: "admin", as: caller{method: AUTH_SESSION, user: "admin", groups: []string{ADMIN_GROUP}}, path: "/scan/pipeline", expected: []string{"a", "b"}},
+		{name: "config token", as: caller{method: AUTH_TOKEN, scopes: []string{config.SCOPE_READ}}, path: "/scan/pipeline", expected: []string{"a", "b"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, API_PREFIX+test.path, nil)
+			recorder := httptest.NewRecorder()
+			engine(test.as).ServeHTTP(recorder, request)
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("scan returned %d", recorder.Code)
+			}
+
+			response := struct {
+				Message api.ScanResult `json:"message"`
+			}{}
+			if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+				t.Fatal(err)
+			}
+			keys := make([]string, 0)
+			for key := range response.Message.Keys {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			if !reflect.DeepEqual(keys, test.expected) || response.Message.KeyLen != len(test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, keys)
+			}
+		})
+	}
+}
@@ -6,6 +6,11 @@
 
 package server
 
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/config"
+)
+
 func (server *Server) setupRoutes(bfs *BinFileSystem) {
 	server.router.Use(server.RequireAccount)
 
@@ -14,47 +19,136 @@ func (server *Server) setupRoutes(bfs *BinFileSystem) {
 
 	// page methods
 	server.router.GET("/", server.Index)
-	server.router.GET("/pipeline", server.Index)
+	server.router.GET("/pipeline", server.RequireRole(ROLE_VIEWER), server.Index)
 	server.router.GET("/scan", server.Index)
 	server.router.GET("/scan/:bucket", server.Index)
 	server.router.GET("/buckets", server.Index)
 	server.router.GET("/login", server.Signin)
 	server.router.POST("/login", server.Signin)
 	server.router.GET("/logout", server.Signout)
+	server.router.GET("/oidc/login", server.OIDCLogin)
+	server.router.GET("/oidc/callback", server.OIDCCallback)
+	server.router.GET("/enrol", server.Enrol)
+	server.router.POST("/enrol", server.Enrol)
+	server.router.GET("/invite", server.Invitation)
+	server.router.POST("/invite", server.Invitation)
+	server.router.GET("/admin", server.RequireAdmin, server.Admin)
 
 	// api methods
-	server.engine.GET("/api/v1/bucket", server.api.Buckets)
-	server.engine.GET("/api/v1/bucket/:bucket/:child", server.api.Get)
-	server.engine.GET("/api/v1/bucket/:bucket/:child/*key", server.api.Get)
+	//
+	// Every API method requires a session or token granted the scope
+	// named against it. Methods acting on a pipeline also require users
+	// to hold a role on that pipeline. Methods changing state are recorded
+	// in the audit log.
+	var (
+		api     *gin.RouterGroup = server.API(server.config)
+		read    gin.HandlerFunc  = RequireScope(config.SCOPE_READ)
+		write   gin.HandlerFunc  = RequireScope(config.SCOPE_WRITE)
+		queue   gin.HandlerFunc  = RequireScope(config.SCOPE_QUEUE)
+		admin   gin.HandlerFunc  = RequireScope(config.SCOPE_ADMIN)
+		viewer  gin.HandlerFunc  = server.RequireRole(ROLE_VIEWER)
+		edit    gin.HandlerFunc  = server.RequireRole(ROLE_EDITOR)
+		operate gin.HandlerFunc  = server.RequireRole(ROLE_OPERATOR)
+		own     gin.HandlerFunc  = server.RequireRole(ROLE_ADMIN)
+		audit                    = server.Audit
+	)
+
+	api.GET("/bucket", read, server.api.Buckets)
+	api.GET("/bucket/:bucket/:child", read, viewer, server.api.Get)
+	api.GET("/bucket/:bucket/:child/*key", read, viewer, server.api.Get)
+
+	api.PUT("/bucket", audit("bucket.put", server.bucketState), write, edit, server.api.Put)
+	api.POST("/bucket", audit("bucket.create", server.bucketState), write, edit, server.api.CreateBucket)
+
+	api.DELETE("/bucket/:bucket", audit("bucket.delete", server.bucketState), write, edit, server.api.DeleteBucket)
+	api.DELETE("/bucket/:bucket/:child", audit("bucket.delete", server.bucketState), write, edit, server.api.DeleteKey)
+	api.DELETE("/bucket/:bucket/:child/*key", audit("bucket.delete", server.bucketState), write, edit, server.api.DeleteKey)
+
+	api.GET("/containers", read, server.api.Containers)
+	api.GET("/collections/:collection", read, bfs.Collection)
+
+	api.GET("/scan/:bucket", read, viewer, server.api.PrefixScan)
+	api.GET("/scan/:bucket/:child", read, viewer, server.api.PrefixScan)
+	api.GET("/scan/:bucket/:child/*key", read, viewer, server.api.PrefixScan)
+
+	api.GET("/count/:bucket", read, viewer, server.api.KeyCount)
+	api.GET("/count/:bucket/*child", read, viewer, server.api.KeyCount)
+
+	api.GET("/popqueue/:pipeline/:key", queue, server.api.PopQueue)
+	api.POST("/perpetualqueue", queue, server.api.PerpetualQueue)
+	api.GET("/running/:pipeline", read, viewer, server.api.Running)
+	api.GET("/running/:pipeline/:id", read, viewer, server.api.Running)
+	api.POST("/cancel", audit("queue.cancel", nil), write, edit, server.api.Cancel)
+	api.POST("/complete", queue, server.api.Complete)
+	api.POST("/submit", audit("queue.submit", nil), write, edit, server.api.Submit)
+	api.GET("/submission/:pipeline/:id", read, viewer, server.api.GetSubmission)
+	api.POST("/events/:pipeline", queue, server.api.PostEvent)
+	api.GET("/events/:pipeline/:id", read, viewer, server.api.GetEvent)
+	api.POST("/logs", queue, server.api.PostLogs)
+	api.GET("/logs/:pipeline", read, viewer, server.api.Logs)
+	api.GET("/logs/:pipeline/:id", read, viewer, server.api.GetLog)
+	api.GET("/logs/:pipeline/:id/stream", read, viewer, server.api.StreamLog)
+	api.GET("/stats/:pipeline", read, viewer, server.api.Stats)
+	api.GET("/stats/:pipeline/:command", read, viewer, server.api.Stats)
+	api.GET("/analytics/:pipeline", read, viewer, server.api.Analytics)
+	api.GET("/samples/:pipeline", read, viewer, server.api.Samples)
+	api.GET("/samples/:pipeline/:sample", read, viewer, server.api.GetSample)
+
+	api.GET("/schedules/:pipeline", read, viewer, server.api.Schedules)
+	api.POST("/schedules/:pipeline", audit("schedule.save", nil), write, edit, server.api.SaveSchedule)
+	api.DELETE("/schedules/:pipeline/:id", audit("schedule.delete", nil), write, edit, server.api.DeleteSchedule)
+	api.POST("/schedules/:pipeline/:id/pause", audit("schedule.pause", nil), write, edit, server.api.PauseSchedule)
+	api.POST("/schedules/:pipeline/:id/resume", audit("schedule.resume", nil), write, edit, server.api.ResumeSchedule)
+	api.POST("/schedules/:pipeline/:id/trigger", audit("schedule.trigger", nil), write, edit, server.api.TriggerSchedule)
+
+	api.GET("/status/:pipeline", read, viewer, server.api.FlowStatus)
+	api.POST("/execute", audit("flow.execute", nil), write, operate, server.api.ExecuteFlow)
+	api.POST("/startflow", audit("flow.start", nil), write, operate, server.api.StartFlow)
+	api.POST("/stopflow", audit("flow.stop", nil), write, operate, server.api.StopFlow)
+	api.POST("/destroyflow", audit("flow.destroy", nil), write, own, server.api.DestroyFlow)
+	api.POST("/encrypt", write, server.api.Encrypt)
+	api.POST("/decrypt", audit("crypt.decrypt", nil), admin, server.api.Decrypt)
+
+	api.GET("/tokens", RequireSession, server.Tokens)
+	api.POST("/tokens", audit("token.create", nil), RequireSession, server.CreateToken)
+	api.DELETE("/tokens/:id", audit("token.revoke", nil), RequireSession, server.RevokeToken)
 
-	server.engine.PUT("/api/v1/bucket", server.api.Put)
-	server.engine.POST("/api/v1/bucket", server.api.CreateBucket)
+	api.GET("/sessions", RequireSession, server.Sessions)
+	api.DELETE("/sessions/:id", audit("session.revoke", nil), RequireSession, server.RevokeSession)
 
-	server.engine.DELETE("/api/v1/bucket/:bucket", server.api.DeleteBucket)
-	server.engine.DELETE("/api/v1/bucket/:bucket/:child", server.api.DeleteKey)
-	server.engine.DELETE("/api/v1/bucket/:bucket/:child/*key", server.api.DeleteKey)
+	api.GET("/acl/:pipeline", read, viewer, server.ACL)
+	api.PUT("/acl/:pipeline", audit("acl.set", server.aclState), write, own, server.SetACL)
 
-	server.engine.GET("/api/v1/containers", server.api.Containers)
-	server.engine.GET("/api/v1/collections/:collection", bfs.Collection)
+	api.GET("/machines", admin, server.Machines)
+	api.POST("/machines", audit("machine.enrol", nil), admin, server.EnrolMachine)
+	api.POST("/machines/:address/rotate", audit("machine.rotate", nil), admin, server.RotateMachine)
+	api.DELETE("/machines/:address", audit("machine.revoke", nil), admin, server.RevokeMachine)
+	api.GET("/machine", RequireMachine, server.MachineStatus)
+	api.POST("/machine/rotate", audit("machine.rotate", nil), RequireMachine, server.RotateMachine)
+	api.POST("/machine/decrypt", audit("crypt.decrypt", nil), RequireMachine, server.api.Decrypt)
 
-	server.engine.GET("/api/v1/scan/:bucket", server.api.PrefixScan)
-	server.engine.GET("/api/v1/scan/:bucket/:child", server.api.PrefixScan)
-	server.engine.GET("/api/v1/scan/:bucket/:child/*key", server.api.PrefixScan)
+	api.GET("/audit", admin, server.AuditLog)
+	api.GET("/audit/export", admin, server.ExportAudit)
+	api.GET("/audit/verify", admin, server.VerifyAudit)
 
-	server.engine.GET("/api/v1/count/:bucket", server.api.KeyCount)
-	server.engine.GET("/api/v1/count/:bucket/*child", server.api.KeyCount)
+	api.POST("/totp/reset", audit("user.totp", server.userState), RequireSession, admin, server.ResetUserTotp)
 
-	server.engine.GET("/api/v1/popqueue/:pipeline/:key", server.api.PopQueue)
-	server.engine.POST("/api/v1/perpetualqueue", server.api.PerpetualQueue)
+	api.GET("/users", RequireSession, admin, server.Users)
+	api.POST("/users", audit("user.invite", server.userState), RequireSession, admin, server.Invite)
+	api.GET("/users/:id", RequireSession, admin, server.GetUser)
+	api.PUT("/users/:id/password", audit("user.password", nil), RequireSession, admin, server.ChangePassword)
+	api.PUT("/users/:id/groups", audit("user.groups", server.userState), RequireSession, admin, server.AssignGroups)
+	api.PUT("/users/:id/disabled", audit("user.disable", server.userState), RequireSession, admin, server.SetDisabled)
+	api.PUT("/users/:id/unlock", audit("user.unlock", server.userState), RequireSession, admin, server.Unlock)
+	api.DELETE("/users/:id", audit("user.delete", server.userState), RequireSession, admin, server.RemoveUser)
+	api.GET("/users/:id/sessions", RequireSession, admin, server.UserSessions)
+	api.DELETE("/users/:id/sessions", audit("user.sessions", nil), RequireSession, admin, server.RevokeUserSessions)
 
-	server.engine.GET("/api/v1/status/:pipeline", server.api.FlowStatus)
-	server.engine.POST("/api/v1/execute", server.api.ExecuteFlow)
-	server.engine.POST("/api/v1/startflow", server.api.StartFlow)
-	server.engine.POST("/api/v1/stopflow", server.api.StopFlow)
-	server.engine.POST("/api/v1/destroyflow", server.api.DestroyFlow)
-	server.engine.POST("/api/v1/encrypt", server.api.Encrypt)
-	server.engine.POST("/api/v1/decrypt", server.api.Decrypt)
+	api.GET("/groups", RequireSession, admin, server.Groups)
+	api.POST("/groups", audit("group.save", server.groupState), RequireSession, admin, server.CreateGroup)
+	api.DELETE("/groups/:name", audit("group.delete", server.groupState), RequireSession, admin, server.RemoveGroup)
 
-	server.engine.POST("/addmachine", server.addmachine)
-	server.engine.GET("/hmac", server.hmac)
+	api.GET("/permissions", RequireSession, admin, server.Permissions)
+	api.POST("/permissions", audit("permission.create", nil), RequireSession, admin, server.CreatePermission)
+	api.DELETE("/permissions/:name", audit("permission.delete", nil), RequireSession, admin, server.RemovePermission)
 }
@@ -20,11 +20,12 @@ import (
 	"github.com/boltdb/bolt"
 	"github.com/gin-contrib/multitemplate"
 	"github.com/gin-contrib/sessions"
-	"github.com/gin-contrib/sessions/cookie"
 	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
 	"github.com/notapipeline/tiyo/pkg/config"
+	"github.com/notapipeline/tiyo/pkg/logging"
 	"github.com/notapipeline/tiyo/pkg/server/api"
+	"github.com/notapipeline/tiyo/pkg/tracing"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -47,8 +48,8 @@ type Server struct {
 	// Router for authenticated endpoints
 	router *gin.RouterGroup
 
-	// Cookie store for session data
-	securetoken cookie.Store
+	// Store for session data
+	securetoken *sessionStore
 
 	// The API handling requests
 	api *api.API
@@ -61,6 +62,14 @@ type Server struct {
 
 	// Users database
 	users *Lockable
+
+	// The number of users not yet found through the email index.
+	// Accessed atomically as it is read by concurrent logins.
+	legacyUsers int32
+
+	// When legacy email hashes were last searched, in unix nanoseconds.
+	// Accessed atomically.
+	legacySearch int64
 }
 
 // NewServer : Create a new Server instance
@@ -72,7 +81,7 @@ func NewServer() *Server {
 	}
 	gin.DisableConsoleColor()
 	// create log directory if it does not exist
-	var dirname string = "/var/log/tiyo"
+	var dirname string = logging.LOG_DIR
 	if fi, err := os.Stat(dirname); err != nil || !fi.IsDir() {
 		if !os.IsNotExist(err) && !fi.IsDir() {
 			return nil
@@ -88,9 +97,15 @@ func NewServer() *Server {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
-	logfile := filepath.Join(dirname, fmt.Sprintf("%s.log", config.Designate))
 	server.engine = gin.New()
-	server.engine.Use(Logger(logfile, mode), gin.Recovery())
+
+	// forwarding headers are set by the client so are never trusted for
+	// the address of a request
+	if err := server.engine.SetTrustedProxies(nil); err != nil {
+		log.Error("Failed to clear trusted proxies ", err)
+	}
+	server.engine.Use(logging.Middleware(), Logger(config.Designate, mode), gin.Recovery(), Metrics(), tracing.Middleware())
+	server.engine.GET("/metrics", MetricsHandler())
 
 	gob.Register(time.Time{})
 	gob.Register(User{})
@@ -110,6 +125,12 @@ func (server *Server) Init() {
 		return
 	}
 
+	if server.config.OIDC.Issuer != "" {
+		if err := server.config.ConfigureOIDC(); err != nil {
+			log.Error(err)
+		}
+	}
+
 	// Try to load from config file first
 	server.Dbname = server.config.Dbname
 	server.Address = server.config.Assemble.Host
@@ -153,14 +174,20 @@ func (server *Server) Init() {
 		return
 	}
 
-	server.securetoken = cookie.NewStore([]byte(config.SESSION_COOKIE_NAME))
-	server.securetoken.Options(sessions.Options{
-		MaxAge:   60 * 60 * 12,
-		Secure:   true,
-		HttpOnly: true,
-		Domain:   server.config.Assemble.Host,
-		Path:     "/",
-	})
+	if err := server.MigrateUsers(); err != nil {
+		log.Error("Failed to migrate users ", err)
+		return
+	}
+
+	var idle time.Duration
+	if server.config.Sessions.IdleTimeout > 0 {
+		idle = time.Duration(server.config.Sessions.IdleTimeout) * time.Minute
+	}
+	if server.securetoken, err = server.newSessionStore(idle); err != nil {
+		log.Error("Failed to setup sessions ", err)
+		return
+	}
+	server.securetoken.Options(server.sessionOptions(SESSION_MAX_AGE))
 
 	server.router = server.engine.Group("/")
 	server.router.Use(sessions.Sessions(config.SESSION_COOKIE_NAME, server.securetoken))
@@ -174,6 +201,7 @@ func (server *Server) Engine() *gin.Engine {
 // Run : runs the server component when activated via main
 func (server *Server) Run() int {
 	log.Info("starting server.apidb-browser..")
+	defer tracing.Init(server.config, config.Designate)()
 
 	var (
 		err error
@@ -185,6 +213,9 @@ func (server *Server) Run() int {
 		fmt.Println(err)
 		return 1
 	}
+	server.api.Visible = server.visible
+	go server.api.Scheduler()
+
 	bfs := GetBinFileSystem("assets/files")
 	server.engine.Use(static.Serve("/static", bfs))
 
@@ -194,6 +225,9 @@ func (server *Server) Run() int {
 	render.Add("index", LoadTemplates("index.tpl", "header.html", "footer.html"))
 	render.Add("configure", LoadTemplates("configure.tpl", "header.html", "footer.html"))
 	render.Add("login", LoadTemplates("login.tpl", "header.html", "footer.html"))
+	render.Add("enrol", LoadTemplates("enrol.tpl", "header.html", "footer.html"))
+	render.Add("invite", LoadTemplates("invite.tpl", "header.html", "footer.html"))
+	render.Add("admin", LoadTemplates("admin.tpl", "header.html", "footer.html"))
 	render.Add("error", LoadTemplates("error.tpl", "header.html", "footer.html"))
 	server.engine.HTMLRender = render
 
@@ -244,11 +278,25 @@ type login struct {
 	Otp      string `form:"otp,omitempty"`
 }
 
+// Configure : Create the admin group and first administrator
+//
+// Only available until the admin group exists.
 func (server *Server) Configure(c *gin.Context) {
+	if admin, _ := server.FindGroup(ADMIN_GROUP); admin != nil {
+		if c.Request.Method == http.MethodPost {
+			log.Warn("Refused attempt to reconfigure assemble from ", remoteAddress(c))
+			server.Error(c, http.StatusForbidden, fmt.Errorf("Assemble is already configured"))
+			return
+		}
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
 	if c.Request.Method == http.MethodPost {
 		request := login{}
 		if err := c.ShouldBind(&request); err != nil {
 			server.Error(c, 500, err)
+			return
 		}
 
 		if request.Password != request.Confirm {
@@ -292,42 +340,3 @@ func (server *Server) Configure(c *gin.Context) {
 		"Title": "TIYO - Kubernetes made easy",
 	})
 }
-
-var forbidden struct{ Forbidden string } = struct{ Forbidden string }{
-	Forbidden: "You have attempted to access a restricted endpoint, this interaction has been recorded",
-}
-
-func (s *Server) addmachine(c *gin.Context) {
-	var client string = c.ClientIP()
-	external, _ := externalIP()
-	if client != "127.0.0.1" && (external != "" && client != external) {
-		log.Warnf("Attempt to access addmachine endpoint by unknown client IP %s", client)
-		c.JSON(http.StatusForbidden, forbidden)
-		return
-	}
-
-	var address struct {
-		Address string `json:"address"`
-	}
-	if err := c.ShouldBind(&address); err != nil || address.Address == "" {
-		log.Errorf("Invalid JSON object - address not provided : %s : %+v", err, address)
-		c.JSON(http.StatusBadRequest, struct{ BadRequest string }{BadRequest: "Address is required"})
-		return
-	}
-
-	var key string = s.AddMachine(address.Address)
-	c.JSON(http.StatusOK, struct{ Key string }{Key: key})
-}
-
-func (s *Server) hmac(c *gin.Context) {
-	client := c.ClientIP()
-	hmac := s.getHmac(client)
-	if hmac == "" {
-		log.Warnf("Attempt to access HMAC endpoint by unknown client IP %s", client)
-		c.JSON(http.StatusForbidden, forbidden)
-		return
-	}
-	c.JSON(http.StatusOK, struct {
-		Hmac string `json:"hmac"`
-	}{Hmac: hmac})
-}
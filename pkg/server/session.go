@@ -15,6 +15,29 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// SESSION_MAX_AGE : Seconds a signed in session lasts
+const SESSION_MAX_AGE = 60 * 60 * 12
+
+// loginErrors : Messages shown on the login page for each error
+//
+// Failures of the email, password or code share one message so responses
+// do not reveal which accounts exist.
+var loginErrors map[string]string = map[string]string{
+	"invalid":   "Invalid email, password or code",
+	"throttled": "Too many failed attempts. Please try again later",
+	"disabled":  "This account has been disabled",
+	"sso":       "Single sign on failed",
+}
+
+// dummyHash : Compared against when no user is found so failures take as long
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+
+// Signin : Sign in with email, password and second factor
+//
+// Attempts are throttled per account and per address, doubling the
+// delay with each failure, and lock the account or address once too
+// many have been seen. Each attempt is counted before the password is
+// checked and returned once it is known to be correct.
 func (server *Server) Signin(c *gin.Context) {
 	if c.Request.Method == http.MethodPost {
 		request := login{}
@@ -23,39 +46,71 @@ func (server *Server) Signin(c *gin.Context) {
 			return
 		}
 
-		user, err := server.FindUser(request.Email)
+		var address string = remoteAddress(c)
+		wait, err := server.Attempt(request.Email, address)
 		if err != nil {
 			server.Error(c, 500, err)
 			return
 		}
+		if wait > 0 {
+			server.auditLogin(c, SSO_PASSWORD, request.Email, "", "throttled for "+wait.Round(time.Second).String())
+			c.Redirect(http.StatusFound, "/login?error=throttled")
+			return
+		}
+
+		user, _ := server.FindUser(request.Email)
 		if user == nil {
-			c.Redirect(http.StatusFound, "/login?error=notfound")
+			bcrypt.CompareHashAndPassword(dummyHash, []byte(request.Password))
+			server.auditLogin(c, SSO_PASSWORD, request.Email, "", "not registered")
+			c.Redirect(http.StatusFound, "/login?error=invalid")
 			return
 		}
 
 		if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password)); err != nil {
-			c.Redirect(http.StatusFound, "/login?error=invalidpassword")
+			server.auditLogin(c, SSO_PASSWORD, request.Email, user.ID, "invalid password")
+			c.Redirect(http.StatusFound, "/login?error=invalid")
+			return
+		}
+
+		if server.IsDisabled(user.ID) {
+			server.Release(request.Email, address, false)
+			server.auditLogin(c, SSO_PASSWORD, request.Email, user.ID, "disabled")
+			c.Redirect(http.StatusFound, "/login?error=disabled")
+			return
+		}
+
+		// users without a second factor must enrol before signing in
+		if user.TotpKey == "" {
+			server.Release(request.Email, address, false)
+			session := sessions.Default(c)
+			session.Set("EnrolEmail", user.Email)
+			if err := session.Save(); err != nil {
+				server.Error(c, 500, err)
+				return
+			}
+			c.Redirect(http.StatusFound, "/enrol")
 			return
 		}
 
-		expires := time.Now().Add(12 * time.Hour)
-		session := sessions.Default(c)
-		if session != nil {
-			session.Set("User", *user)
-			session.Set("NotBefore", time.Now())
-			session.Set("NotAfter", expires)
-			session.Options(sessions.Options{
-				MaxAge: 60 * 60 * 12,
-				Path:   "/",
-				Domain: server.config.Assemble.Host,
-			})
+		if !server.ValidateOtp(user, request.Otp) {
+			server.auditLogin(c, SSO_PASSWORD, request.Email, user.ID, "invalid code")
+			c.Redirect(http.StatusFound, "/login?error=invalid")
+			return
+		}
+		server.Release(request.Email, address, true)
+		server.auditLogin(c, SSO_PASSWORD, request.Email, user.ID, "")
+
+		if err := server.signinSession(user, c); err != nil {
+			server.Error(c, 500, err)
+			return
 		}
-		session.Save()
 		c.Redirect(http.StatusFound, "/")
 		return
 	}
 	c.HTML(200, "login", gin.H{
 		"Title": "TIYO - Log in to the cluster designer",
+		"OIDC":  server.config.OIDC.Provider != nil,
+		"Error": loginErrors[c.Query("error")],
 	})
 }
 
@@ -64,11 +119,7 @@ func (server *Server) Signout(c *gin.Context) {
 	session.Set("NotAfter", time.Now())
 	session.Set("User", nil)
 	session.Clear()
-	session.Options(sessions.Options{
-		MaxAge: -1,
-		Domain: server.config.Assemble.Host,
-		Path:   "/",
-	})
+	session.Options(server.sessionOptions(-1))
 	session.Save()
 
 	if server.config.SAML.SamlSP != nil {
@@ -87,18 +138,28 @@ func (server *Server) Signout(c *gin.Context) {
 }
 
 func (server *Server) signinSession(user *User, c *gin.Context) error {
-	expires := time.Now().Add(12 * time.Hour)
+	expires := time.Now().Add(SESSION_MAX_AGE * time.Second)
 	session := sessions.Default(c)
 	if session != nil {
 		session.Set("User", *user)
 		session.Set("NotBefore", time.Now())
 		session.Set("NotAfter", expires)
-		session.Options(sessions.Options{
-			MaxAge: 60 * 60 * 12,
-			Path:   "/",
-			Domain: server.config.Assemble.Host,
-		})
+		session.Options(server.sessionOptions(SESSION_MAX_AGE))
 	}
 	err := session.Save()
 	return err
 }
+
+// sessionOptions : Get the cookie options of a session living for maxAge seconds
+//
+// Sessions are always restricted to HTTPS and hidden from scripts. A
+// negative maxAge removes the cookie.
+func (server *Server) sessionOptions(maxAge int) sessions.Options {
+	return sessions.Options{
+		MaxAge:   maxAge,
+		Secure:   true,
+		HttpOnly: true,
+		Domain:   server.config.Assemble.Host,
+		Path:     "/",
+	}
+}
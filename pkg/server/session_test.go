@@ -0,0 +1,93 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"encoding/gob"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/config"
+)
+
+func TestSessionCookie(t *testing.T) {
+	gob.Register(time.Time{})
+	gob.Register(User{})
+
+	server := newTestServer(t)
+	server.config.Assemble.Host = "assemble.test"
+	server.config.SAML = &config.SAML{}
+	user := addTestUser(t, server, "user@example.com", false)
+	store, err := server.newSessionStore(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(sessions.Sessions(config.SESSION_COOKIE_NAME, store))
+	engine.GET("/signin", func(c *gin.Context) {
+		if err := server.signinSession(user, c); err != nil {
+			t.Fatal(err)
+		}
+	})
+	engine.GET("/signout", server.Signout)
+
+	tests := []struct {
+		name string
+		path string
+
+		// The session cookie is removed rather than set
+		removed bool
+
+		// The number of sessions the user holds afterwards
+		sessions int
+	}{
+		{name: "sign in", path: "/signin", sessions: 1},
+		{name: "sign out", path: "/signout", removed: true},
+	}
+
+	var cookie *http.Cookie
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, test.path, nil)
+			if cookie != nil {
+				request.AddCookie(cookie)
+			}
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, request)
+
+			cookie = nil
+			for _, set := range recorder.Result().Cookies() {
+				if set.Name == config.SESSION_COOKIE_NAME {
+					cookie = set
+				}
+			}
+			if cookie == nil {
+				t.Fatal("session cookie was not set")
+			}
+			if !cookie.Secure || !cookie.HttpOnly || cookie.Domain != "assemble.test" || cookie.Path != "/" {
+				t.Errorf("session cookie is not restricted: %s", cookie)
+			}
+			if removed := cookie.MaxAge < 0; removed != test.removed {
+				t.Errorf("expected cookie removed %v, got max age %d", test.removed, cookie.MaxAge)
+			}
+
+			sessions, err := server.ListSessions(user.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(sessions) != test.sessions {
+				t.Errorf("expected %d sessions, got %d", test.sessions, len(sessions))
+			}
+		})
+	}
+}
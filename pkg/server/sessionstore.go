@@ -0,0 +1,383 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/securecookie"
+	gsessions "github.com/gorilla/sessions"
+	log "github.com/sirupsen/logrus"
+)
+
+// SESSION_KEY : The key of the secrets table holding the session cookie keys
+const SESSION_KEY string = "session"
+
+// SESSION_LAST_SEEN : How often the last use of a session is written back to the database
+const SESSION_LAST_SEEN time.Duration = time.Minute
+
+// SessionRecord : A browser session held in the users database
+//
+// The cookie only carries the ID of the session, signed and encrypted
+// with keys generated for the install, so sessions cannot be forged and
+// are revoked by deleting their record.
+type SessionRecord struct {
+
+	// The unique ID of the session
+	ID string `json:"id"`
+
+	// The ID of the signed in user. Empty until the user signs in
+	User string `json:"user,omitempty"`
+
+	// The address the session was last used from
+	Address string `json:"address"`
+
+	// The user agent the session was last used from
+	UserAgent string `json:"userAgent"`
+
+	// When the session was created
+	Created time.Time `json:"created"`
+
+	// When the session was last used, to the nearest SESSION_LAST_SEEN
+	LastSeen time.Time `json:"lastSeen"`
+
+	// When the session ends regardless of use
+	Expires time.Time `json:"expires"`
+
+	// Is this the session making the request
+	Current bool `json:"current,omitempty"`
+
+	// The gob encoded session values
+	Data []byte `json:"data,omitempty"`
+}
+
+// sessionStore : A gin session store keeping sessions in the users database
+type sessionStore struct {
+	server  *Server
+	codecs  []securecookie.Codec
+	options *gsessions.Options
+
+	// Sessions unused for this long are ended. Zero to only end sessions on expiry
+	idle time.Duration
+}
+
+// newSessionStore : Create the session store, generating its keys on first use
+func (s *Server) newSessionStore(idle time.Duration) (*sessionStore, error) {
+	var key []byte = []byte(s.get(SESSION_KEY, SECRETS_T))
+	if len(key) != 64 {
+		key = make([]byte, 64)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		if err := s.replace(SESSION_KEY, SECRETS_T, string(key)); err != nil {
+			return nil, fmt.Errorf("Failed to store session key: %s", err)
+		}
+		log.Info("Generated new session key")
+	}
+
+	return &sessionStore{
+		server:  s,
+		codecs:  securecookie.CodecsFromPairs(key[:32], key[32:]),
+		options: &gsessions.Options{Path: "/"},
+		idle:    idle,
+	}, nil
+}
+
+// Get : Get the named session of a request, loading it once per request
+func (store *sessionStore) Get(r *http.Request, name string) (*gsessions.Session, error) {
+	return gsessions.GetRegistry(r).Get(store, name)
+}
+
+// New : Load the session named by the cookie or start a new one
+//
+// Sessions which have expired, been idle too long or been revoked are
+// replaced by a new session.
+func (store *sessionStore) New(r *http.Request, name string) (*gsessions.Session, error) {
+	session := gsessions.NewSession(store, name)
+	options := *store.options
+	session.Options = &options
+	session.IsNew = true
+
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return session, nil
+	}
+	var id string
+	if err := securecookie.DecodeMulti(name, cookie.Value, &id, store.codecs...); err != nil {
+		return session, nil
+	}
+
+	record := store.server.getSession(id)
+	if record == nil {
+		return session, nil
+	}
+	if time.Now().After(record.Expires) || (store.idle > 0 && time.Since(record.LastSeen) > store.idle) {
+		if err := store.server.DeleteSession(id); err != nil {
+			log.Warn("Failed to remove ended session ", err)
+		}
+		return session, nil
+	}
+	if err := (securecookie.GobEncoder{}).Deserialize(record.Data, &session.Values); err != nil {
+		return session, nil
+	}
+	session.ID = id
+	session.IsNew = false
+
+	if time.Since(record.LastSeen) > SESSION_LAST_SEEN {
+		record.LastSeen = time.Now()
+		record.Address = address(r)
+		record.UserAgent = r.UserAgent()
+		if err := store.server.saveSession(record); err != nil {
+			log.Warn("Failed to record use of session ", err)
+		}
+	}
+	return session, nil
+}
+
+// Save : Store the session and send its ID in the cookie
+//
+// The session is given a new ID when a user signs in, and is deleted
+// when its MaxAge is negative.
+func (store *sessionStore) Save(r *http.Request, w http.ResponseWriter, session *gsessions.Session) error {
+	if session.Options.MaxAge < 0 {
+		if session.ID != "" {
+			if err := store.server.DeleteSession(session.ID); err != nil {
+				return err
+			}
+		}
+		http.SetCookie(w, gsessions.NewCookie(session.Name(), "", session.Options))
+		return nil
+	}
+
+	var user string
+	if u, ok := session.Values["User"].(User); ok {
+		user = u.ID
+	}
+
+	var record *SessionRecord
+	if session.ID != "" {
+		record = store.server.getSession(session.ID)
+	}
+	if record != nil && record.User != user {
+		if err := store.server.DeleteSession(record.ID); err != nil {
+			return err
+		}
+		record = nil
+	}
+	if record == nil {
+		id, err := randomString()
+		if err != nil {
+			return err
+		}
+		session.ID = id
+		record = &SessionRecord{
+			ID:      id,
+			Created: time.Now(),
+		}
+	}
+
+	data, err := securecookie.GobEncoder{}.Serialize(session.Values)
+	if err != nil {
+		return err
+	}
+	record.User = user
+	record.Data = data
+	record.Address = address(r)
+	record.UserAgent = r.UserAgent()
+	record.LastSeen = time.Now()
+	record.Expires = time.Now().Add(time.Duration(session.Options.MaxAge) * time.Second)
+	if err := store.server.saveSession(record); err != nil {
+		return err
+	}
+
+	encoded, err := securecookie.EncodeMulti(session.Name(), session.ID, store.codecs...)
+	if err != nil {
+		return err
+	}
+	http.SetCookie(w, gsessions.NewCookie(session.Name(), encoded, session.Options))
+	return nil
+}
+
+// Options : Set the cookie options of new sessions
+func (store *sessionStore) Options(options sessions.Options) {
+	store.options = options.ToGorillaOptions()
+	for _, codec := range store.codecs {
+		if c, ok := codec.(*securecookie.SecureCookie); ok {
+			c.MaxAge(options.MaxAge)
+		}
+	}
+}
+
+// address : Get the address a request was sent from
+func address(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func (s *Server) getSession(id string) *SessionRecord {
+	var value string = s.get(id, SESSIONS_T)
+	if value == "" {
+		return nil
+	}
+	record := SessionRecord{}
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		log.Error("Invalid session ", err)
+		return nil
+	}
+	return &record
+}
+
+func (s *Server) saveSession(record *SessionRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return s.replace(record.ID, SESSIONS_T, string(data))
+}
+
+// DeleteSession : Revoke a session
+func (s *Server) DeleteSession(id string) error {
+	return s.delete(id, SESSIONS_T)
+}
+
+// ListSessions : Get the sessions of the user with id, or every signed in session if id is empty
+//
+// Sessions which have ended are removed.
+func (s *Server) ListSessions(id string) ([]SessionRecord, error) {
+	var idle time.Duration
+	if s.securetoken != nil {
+		idle = s.securetoken.idle
+	}
+
+	records := make([]SessionRecord, 0)
+	ended := make([]string, 0)
+	err := s.users.Db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(SESSIONS_T)).ForEach(func(k, v []byte) error {
+			record := SessionRecord{}
+			if err := json.Unmarshal(v, &record); err != nil {
+				return err
+			}
+			if time.Now().After(record.Expires) || (idle > 0 && time.Since(record.LastSeen) > idle) {
+				ended = append(ended, record.ID)
+				return nil
+			}
+			if record.User != "" && (id == "" || record.User == id) {
+				record.Data = nil
+				records = append(records, record)
+			}
+			return nil
+		})
+	})
+	for _, id := range ended {
+		if err := s.DeleteSession(id); err != nil {
+			log.Warn("Failed to remove ended session ", err)
+		}
+	}
+	return records, err
+}
+
+// DeleteSessions : Revoke every session of the user with id
+func (s *Server) DeleteSessions(id string) error {
+	records, err := s.ListSessions(id)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if err := s.DeleteSession(record.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Sessions : List the sessions of the signed in user
+//
+// GET /api/v1/sessions
+//
+// Response codes:
+// - 200 OK
+// - 500 Internal server error
+func (server *Server) Sessions(c *gin.Context) {
+	records, err := server.ListSessions(c.GetString(AUTH_USER))
+	if err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	var current string = sessions.Default(c).ID()
+	for i := range records {
+		records[i].Current = records[i].ID == current
+	}
+	reply(c, http.StatusOK, records)
+}
+
+// UserSessions : List the sessions of a user
+//
+// GET /api/v1/users/:id/sessions
+//
+// Response codes:
+// - 200 OK
+// - 500 Internal server error
+func (server *Server) UserSessions(c *gin.Context) {
+	records, err := server.ListSessions(c.Params.ByName("id"))
+	if err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	reply(c, http.StatusOK, records)
+}
+
+// RevokeSession : End a session
+//
+// DELETE /api/v1/sessions/:id
+//
+// Users may end their own sessions. Members of the admin group may end any session.
+//
+// Response codes:
+// - 202 Accepted
+// - 404 Not found
+// - 500 Internal server error
+func (server *Server) RevokeSession(c *gin.Context) {
+	record := server.getSession(c.Params.ByName("id"))
+	if record == nil || record.User == "" || (record.User != c.GetString(AUTH_USER) && !contains(c.GetStringSlice(AUTH_GROUPS), ADMIN_GROUP)) {
+		reply(c, http.StatusNotFound, "No such session")
+		return
+	}
+	if err := server.DeleteSession(record.ID); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("Session of user ", record.User, " revoked by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusAccepted, "Session revoked")
+}
+
+// RevokeUserSessions : End every session of a user
+//
+// DELETE /api/v1/users/:id/sessions
+//
+// Response codes:
+// - 202 Accepted
+// - 500 Internal server error
+func (server *Server) RevokeUserSessions(c *gin.Context) {
+	var id string = c.Params.ByName("id")
+	if err := server.DeleteSessions(id); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("Sessions of user ", id, " revoked by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusAccepted, "Sessions revoked")
+}
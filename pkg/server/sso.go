@@ -0,0 +1,129 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/logging"
+	log "github.com/sirupsen/logrus"
+)
+
+// Identity providers recorded against users created through SSO
+const (
+	SSO_SAML string = "saml"
+	SSO_OIDC string = "oidc"
+)
+
+// SSO_PASSWORD : Recorded as the provider of logins with a password
+const SSO_PASSWORD string = "password"
+
+// ssoSignin : Sign in a user vouched for by an identity provider
+//
+// values are the group values sent by the provider, mapped to local groups
+// by the sso section of the config. Unknown groups are ignored.
+//
+// Unregistered users are created when provisioning is enabled and are then
+// kept in the groups sent by the provider on each sign in. The groups of
+// users registered locally are left alone and only apply to the session.
+//
+// On success the user is sent to next.
+func (server *Server) ssoSignin(c *gin.Context, provider string, email string, values []string, next string) {
+	groups := make([]Group, 0)
+	for _, name := range server.config.SSO.MapGroups(values) {
+		group, err := server.FindGroup(name)
+		if err != nil || group == nil {
+			log.Debugf("%s: Ignoring unknown group %s for %s", provider, name, email)
+			continue
+		}
+		groups = append(groups, *group)
+	}
+
+	user, _ := server.FindUser(email)
+	switch {
+	case user == nil && !server.config.SSO.Provision:
+		server.auditLogin(c, provider, email, "", "not registered")
+		c.Redirect(http.StatusFound, "/login?error=sso")
+		return
+
+	case user == nil:
+		user = &User{
+			Email:  email,
+			Groups: groups,
+		}
+		if err := server.AddUser(user); err != nil {
+			server.Error(c, http.StatusInternalServerError, err)
+			return
+		}
+		if err := server.replace(user.ID, SSO_T, provider); err != nil {
+			server.Error(c, http.StatusInternalServerError, err)
+			return
+		}
+		log.Info(provider, ": Created user ", user.ID, " for ", email)
+
+	case server.IsDisabled(user.ID):
+		server.auditLogin(c, provider, email, user.ID, "disabled")
+		c.Redirect(http.StatusFound, "/login?error=disabled")
+		return
+
+	case server.get(user.ID, SSO_T) != "":
+		if err := server.SetGroups(user, groups); err != nil {
+			log.Warn(provider, ": Failed to update groups of user ", user.ID, " ", err)
+		}
+	}
+
+	session := &User{
+		ID:     user.ID,
+		Email:  email,
+		Groups: groups,
+	}
+	if err := server.signinSession(session, c); err != nil {
+		server.Error(c, http.StatusInternalServerError, err)
+		return
+	}
+	server.auditLogin(c, provider, email, user.ID, "")
+	c.Redirect(http.StatusFound, next)
+}
+
+// auditLogin : Record a sign in with a password or through an identity provider
+//
+// The login is written to the audit log as well as logged. reason is empty
+// for successful sign ins
+func (server *Server) auditLogin(c *gin.Context, provider string, email string, id string, reason string) {
+	entry := logging.Entry(c.Request.Context()).WithFields(log.Fields{
+		"audit":    "login",
+		"provider": provider,
+		"email":    email,
+		"user":     id,
+		"address":  remoteAddress(c),
+	})
+	record := AuditEntry{
+		Time:    time.Now(),
+		Actor:   email,
+		User:    id,
+		Address: remoteAddress(c),
+		Action:  "login",
+		Target:  provider,
+		After:   "signed in",
+		Status:  http.StatusOK,
+	}
+	if reason != "" {
+		record.After = reason
+		record.Status = http.StatusUnauthorized
+	}
+	if err := server.AppendAudit(&record); err != nil {
+		entry.Error("Failed to record login in the audit log ", err)
+	}
+
+	if reason != "" {
+		entry.WithField("reason", reason).Warn("Login refused")
+		return
+	}
+	entry.Info("Login")
+}
@@ -0,0 +1,243 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// The delay after the first failed login, doubling with each failure
+	LOGIN_DELAY time.Duration = time.Second
+
+	// The longest delay between failed logins
+	LOGIN_DELAY_MAX time.Duration = time.Minute
+
+	// Failures are forgotten once none have been seen for this long
+	LOGIN_WINDOW time.Duration = time.Hour
+
+	// Failures against one account before it is locked
+	LOCKOUT_ACCOUNT int = 10
+
+	// Failures from one address before it is locked
+	LOCKOUT_ADDRESS int = 50
+
+	// How long an account or address stays locked
+	LOCKOUT_DURATION time.Duration = 15 * time.Minute
+)
+
+// loginAttempts : Failed logins against an account or from an address
+type loginAttempts struct {
+
+	// The number of failures within LOGIN_WINDOW of each other
+	Failures int `json:"failures"`
+
+	// When the last failure was seen
+	Last time.Time `json:"last"`
+
+	// Logins are refused until this time
+	Locked time.Time `json:"locked,omitempty"`
+}
+
+// wait : How long until another login may be tried
+func (attempts *loginAttempts) wait() time.Duration {
+	if wait := time.Until(attempts.Locked); wait > 0 {
+		return wait
+	}
+	if attempts.Failures == 0 || time.Since(attempts.Last) > LOGIN_WINDOW {
+		return 0
+	}
+	var delay time.Duration = LOGIN_DELAY_MAX
+	if attempts.Failures <= 7 {
+		delay = LOGIN_DELAY << uint(attempts.Failures-1)
+	}
+	if delay > LOGIN_DELAY_MAX {
+		delay = LOGIN_DELAY_MAX
+	}
+	return time.Until(attempts.Last.Add(delay))
+}
+
+// accountKey : Get the key failed logins against email are counted under
+//
+// Failures are counted for unknown emails too so responses do not reveal
+// which accounts exist.
+func (s *Server) accountKey(email string) string {
+	return "account:" + s.blindIndex(email)
+}
+
+// addressKey : Get the key failed logins from address are counted under
+func addressKey(address string) string {
+	return "address:" + address
+}
+
+func (s *Server) getAttempts(key string) *loginAttempts {
+	attempts := loginAttempts{}
+	if value := s.get(key, ATTEMPTS_T); value != "" {
+		if err := json.Unmarshal([]byte(value), &attempts); err != nil {
+			log.Error("Invalid login attempts for ", key, " ", err)
+		}
+	}
+	return &attempts
+}
+
+// updateAttempts : Change the attempts stored under keys in one transaction
+//
+// update is handed every record before any is written and returns false
+// to leave them all unchanged. Records left with no failures are removed.
+func (s *Server) updateAttempts(keys []string, update func(map[string]*loginAttempts) bool) error {
+	s.users.Lock()
+	defer s.users.Unlock()
+	return s.users.Db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(ATTEMPTS_T))
+		records := make(map[string]*loginAttempts)
+		for _, key := range keys {
+			attempts := loginAttempts{}
+			if value := b.Get([]byte(key)); value != nil {
+				if err := json.Unmarshal(value, &attempts); err != nil {
+					log.Error("Invalid login attempts for ", key, " ", err)
+				}
+			}
+			records[key] = &attempts
+		}
+		if !update(records) {
+			return nil
+		}
+
+		for key, attempts := range records {
+			if attempts.Failures <= 0 && time.Now().After(attempts.Locked) {
+				if err := b.Delete([]byte(key)); err != nil {
+					return err
+				}
+				continue
+			}
+			data, err := json.Marshal(attempts)
+			if err != nil {
+				return err
+			}
+			if err := b.Put([]byte(key), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// limits : Get the failures allowed against email and from address before
+// each is locked, keyed by where they are counted
+func (s *Server) limits(email string, address string) map[string]int {
+	return map[string]int{
+		s.accountKey(email): LOCKOUT_ACCOUNT,
+		addressKey(address): LOCKOUT_ADDRESS,
+	}
+}
+
+// Attempt : Reserve a login for email from address
+//
+// The login is counted as a failure before any credential is checked so
+// concurrent requests cannot all pass the throttle before one records a
+// failure. Returns how long to wait when the login may not be tried yet,
+// in which case nothing is counted. The account is locked after
+// LOCKOUT_ACCOUNT failures and the address after LOCKOUT_ADDRESS failures.
+func (s *Server) Attempt(email string, address string) (time.Duration, error) {
+	var (
+		limits map[string]int = s.limits(email, address)
+		keys   []string
+		wait   time.Duration
+	)
+	for key := range limits {
+		keys = append(keys, key)
+	}
+
+	err := s.updateAttempts(keys, func(records map[string]*loginAttempts) bool {
+		for _, attempts := range records {
+			if w := attempts.wait(); w > wait {
+				wait = w
+			}
+		}
+		if wait > 0 {
+			return false
+		}
+
+		for key, attempts := range records {
+			if time.Since(attempts.Last) > LOGIN_WINDOW {
+				attempts.Failures = 0
+			}
+			attempts.Failures++
+			attempts.Last = time.Now()
+			if attempts.Failures%limits[key] == 0 {
+				attempts.Locked = time.Now().Add(LOCKOUT_DURATION)
+				log.Warnf("Locked %s after %d failed logins", key, attempts.Failures)
+			}
+		}
+		return true
+	})
+	return wait, err
+}
+
+// Release : Return a login reserved by Attempt which did not fail
+//
+// Used once the password is known to be correct, so the attempt is not
+// counted against the account or address, and any lock it caused is
+// lifted. When succeeded is true the failures against the account are
+// forgotten too.
+func (s *Server) Release(email string, address string, succeeded bool) {
+	var (
+		limits map[string]int = s.limits(email, address)
+		keys   []string
+	)
+	for key := range limits {
+		keys = append(keys, key)
+	}
+
+	if err := s.updateAttempts(keys, func(records map[string]*loginAttempts) bool {
+		for key, attempts := range records {
+			if attempts.Failures > 0 && attempts.Failures%limits[key] == 0 {
+				attempts.Locked = time.Time{}
+			}
+			attempts.Failures--
+		}
+		if succeeded {
+			records[s.accountKey(email)].Failures = 0
+		}
+		return true
+	}); err != nil {
+		log.Error("Failed to release login attempt ", err)
+	}
+}
+
+// IsLocked : Is the account for email locked
+func (s *Server) IsLocked(email string) bool {
+	return email != "" && time.Now().Before(s.getAttempts(s.accountKey(email)).Locked)
+}
+
+// Unlock : Unlock a user and forget their failed logins
+//
+// PUT /api/v1/users/:id/unlock
+//
+// Response codes:
+// - 202 Accepted
+// - 404 Not found
+// - 500 Internal server error
+func (server *Server) Unlock(c *gin.Context) {
+	user, err := server.FindUserByID(c.Params.ByName("id"))
+	if err != nil || user.Email == "" {
+		reply(c, http.StatusNotFound, "No such user")
+		return
+	}
+	if err := server.delete(server.accountKey(user.Email), ATTEMPTS_T); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Info("User ", user.ID, " unlocked by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusAccepted, "User unlocked")
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAttempt(t *testing.T) {
+	const (
+		email   string = "user@example.com"
+		address string = "192.0.2.1"
+	)
+
+	var (
+		now     time.Time = time.Now()
+		elapsed time.Time = now.Add(-2 * LOGIN_DELAY_MAX)
+	)
+
+	tests := []struct {
+		name string
+
+		// The failures recorded before the login
+		account loginAttempts
+		address loginAttempts
+
+		// What follows the attempt. One of "fail", "release" or "succeed".
+		outcome string
+
+		wait            bool
+		accountFailures int
+		addressFailures int
+		accountLocked   bool
+		addressLocked   bool
+	}{
+		{name: "first login", outcome: "fail", accountFailures: 1, addressFailures: 1},
+		{name: "within delay", account: loginAttempts{Failures: 1, Last: now}, outcome: "fail", wait: true, accountFailures: 1},
+		{name: "after delay", account: loginAttempts{Failures: 1, Last: elapsed}, outcome: "fail", accountFailures: 2, addressFailures: 1},
+		{name: "after window", account: loginAttempts{Failures: LOCKOUT_ACCOUNT - 1, Last: now.Add(-2 * LOGIN_WINDOW)}, outcome: "fail", accountFailures: 1, addressFailures: 1},
+		{name: "account limit", account: loginAttempts{Failures: LOCKOUT_ACCOUNT - 1, Last: elapsed}, outcome: "fail", accountFailures: LOCKOUT_ACCOUNT, addressFailures: 1, accountLocked: true},
+		{name: "address limit", address: loginAttempts{Failures: LOCKOUT_ADDRESS - 1, Last: elapsed}, outcome: "fail", accountFailures: 1, addressFailures: LOCKOUT_ADDRESS, addressLocked: true},
+		{name: "locked again after limit", account: loginAttempts{Failures: 2*LOCKOUT_ACCOUNT - 1, Last: elapsed}, outcome: "fail", accountFailures: 2 * LOCKOUT_ACCOUNT, addressFailures: 1, accountLocked: true},
+		{name: "locked account", account: loginAttempts{Failures: LOCKOUT_ACCOUNT, Last: elapsed, Locked: now.Add(LOCKOUT_DURATION)}, outcome: "fail", wait: true, accountFailures: LOCKOUT_ACCOUNT, accountLocked: true},
+		{name: "lock expired", account: loginAttempts{Failures: LOCKOUT_ACCOUNT, Last: elapsed, Locked: elapsed}, outcome: "fail", accountFailures: LOCKOUT_ACCOUNT + 1, addressFailures: 1},
+		{name: "released", account: loginAttempts{Failures: 3, Last: elapsed}, address: loginAttempts{Failures: 5, Last: elapsed}, outcome: "release", accountFailures: 3, addressFailures: 5},
+		{name: "released lock", account: loginAttempts{Failures: LOCKOUT_ACCOUNT - 1, Last: elapsed}, outcome: "release", accountFailures: LOCKOUT_ACCOUNT - 1},
+		{name: "succeeded", account: loginAttempts{Failures: 3, Last: elapsed}, address: loginAttempts{Failures: 5, Last: elapsed}, outcome: "succeed", addressFailures: 5},
+		{name: "succeeded on first login", outcome: "succeed"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t)
+			var (
+				account string = server.accountKey(email)
+				from    string = addressKey(address)
+			)
+			if err := server.updateAttempts([]string{account, from}, func(records map[string]*loginAttempts) bool {
+				*records[account] = test.account
+				*records[from] = test.address
+				return true
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			wait, err := server.Attempt(email, address)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (wait > 0) != test.wait {
+				t.Fatalf("expected wait %v, got %s", test.wait, wait)
+			}
+			if wait == 0 && test.outcome != "fail" {
+				server.Release(email, address, test.outcome == "succeed")
+			}
+
+			if attempts := server.getAttempts(account); attempts.Failures != test.accountFailures || time.Now().Before(attempts.Locked) != test.accountLocked {
+				t.Errorf("expected account failures %d locked %v, got %d locked until %s", test.accountFailures, test.accountLocked, attempts.Failures, attempts.Locked)
+			}
+			if attempts := server.getAttempts(from); attempts.Failures != test.addressFailures || time.Now().Before(attempts.Locked) != test.addressLocked {
+				t.Errorf("expected address failures %d locked %v, got %d locked until %s", test.addressFailures, test.addressLocked, attempts.Failures, attempts.Locked)
+			}
+			if server.IsLocked(email) != test.accountLocked {
+				t.Errorf("expected IsLocked %v", test.accountLocked)
+			}
+		})
+	}
+}
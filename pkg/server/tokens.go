@@ -0,0 +1,311 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/notapipeline/tiyo/pkg/config"
+	"github.com/notapipeline/tiyo/pkg/server/api"
+	log "github.com/sirupsen/logrus"
+)
+
+// TOKEN_PREFIX : Marks a secret as a personal API token
+const TOKEN_PREFIX string = "tiyo_"
+
+// TOKEN_LAST_USED : How often the last use of a token is written back to the database
+const TOKEN_LAST_USED time.Duration = time.Minute
+
+// APIToken : A personal API token owned by a user
+//
+// The secret is only returned when the token is created. The database
+// holds a SHA-256 hash of it which, as the secret is 32 random bytes, is
+// safe to check on every request where bcrypt would not be.
+type APIToken struct {
+
+	// The unique ID of the token
+	ID string `json:"id"`
+
+	// A name to recognise the token by
+	Name string `json:"name"`
+
+	// The ID of the user who owns the token
+	Owner string `json:"owner"`
+
+	// The scopes granted to the token
+	Scopes []string `json:"scopes"`
+
+	// If not empty, the token may only be used against these pipelines
+	Pipelines []string `json:"pipelines,omitempty"`
+
+	// When the token was created
+	Created time.Time `json:"created"`
+
+	// When the token stops working. Zero if the token does not expire.
+	Expires time.Time `json:"expires,omitempty"`
+
+	// When the token was last used, to the nearest TOKEN_LAST_USED
+	LastUsed time.Time `json:"lastUsed,omitempty"`
+
+	// The hex encoded SHA-256 hash of the secret
+	Hash string `json:"hash,omitempty"`
+}
+
+// tokenRequest : The request sent to create a token
+type tokenRequest struct {
+	Name      string    `json:"name"`
+	Scopes    []string  `json:"scopes"`
+	Pipelines []string  `json:"pipelines"`
+	Expires   time.Time `json:"expires"`
+}
+
+// Expired : Has the token passed its expiry
+func (token *APIToken) Expired() bool {
+	return !token.Expires.IsZero() && time.Now().After(token.Expires)
+}
+
+// AddAPIToken : Create a token for owner and get the secret to hand to the user
+func (s *Server) AddAPIToken(token *APIToken) (string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	token.ID = uuid.New().String()
+	token.Created = time.Now()
+	var secret string = TOKEN_PREFIX + token.ID + "." + base64.RawURLEncoding.EncodeToString(random)
+	token.Hash = hashToken(secret)
+
+	if err := s.saveAPIToken(token); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// FindAPIToken : Find the token matching secret
+//
+// Returns nil if the secret does not match a token or the token has expired
+func (s *Server) FindAPIToken(secret string) *APIToken {
+	if !strings.HasPrefix(secret, TOKEN_PREFIX) {
+		return nil
+	}
+	var id string = strings.SplitN(strings.TrimPrefix(secret, TOKEN_PREFIX), ".", 2)[0]
+	token := s.getAPIToken(id)
+	if token == nil || token.Expired() {
+		return nil
+	}
+	if subtle.ConstantTimeCompare([]byte(token.Hash), []byte(hashToken(secret))) != 1 {
+		return nil
+	}
+
+	if time.Since(token.LastUsed) > TOKEN_LAST_USED {
+		token.LastUsed = time.Now()
+		if err := s.saveAPIToken(token); err != nil {
+			log.Warn("Failed to record use of token ", token.ID, " ", err)
+		}
+	}
+	return token
+}
+
+// ListAPITokens : Get the tokens owned by owner or every token if owner is empty
+func (s *Server) ListAPITokens(owner string) ([]APIToken, error) {
+	tokens := make([]APIToken, 0)
+	err := s.users.Db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(API_TOKENS_T)).ForEach(func(k, v []byte) error {
+			token := APIToken{}
+			if err := json.Unmarshal(v, &token); err != nil {
+				return err
+			}
+			if owner == "" || token.Owner == owner {
+				token.Hash = ""
+				tokens = append(tokens, token)
+			}
+			return nil
+		})
+	})
+	return tokens, err
+}
+
+// DeleteAPIToken : Revoke a token
+func (s *Server) DeleteAPIToken(id string) error {
+	return s.delete(id, API_TOKENS_T)
+}
+
+func (s *Server) getAPIToken(id string) *APIToken {
+	var value string = s.get(id, API_TOKENS_T)
+	if value == "" {
+		return nil
+	}
+	token := APIToken{}
+	if err := json.Unmarshal([]byte(value), &token); err != nil {
+		log.Error("Invalid token ", id, " ", err)
+		return nil
+	}
+	return &token
+}
+
+func (s *Server) saveAPIToken(token *APIToken) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	return s.replace(token.ID, API_TOKENS_T, string(data))
+}
+
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateToken : Create a personal API token for the signed in user
+//
+// POST /api/v1/tokens
+//
+// Request parameters:
+// - name      - a name for the token
+// - scopes    - the scopes granted. Each must be held by the user
+// - pipelines - [optional] the pipelines the token may be used against
+// - expires   - [optional] RFC3339 time the token stops working
+//
+// Response codes:
+// - 201 Created - message holds the token and its secret which is not shown again
+// - 400 Bad request
+// - 500 Internal server error
+func (s *Server) CreateToken(c *gin.Context) {
+	result := api.NewResult()
+	result.Result = "Error"
+
+	request := tokenRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		result.Code = http.StatusBadRequest
+		result.Message = fmt.Sprintf("Invalid request - %s", err)
+		c.JSON(result.Code, result)
+		return
+	}
+
+	var message string
+	if !validString.MatchString(request.Name) {
+		message = "name is required"
+	} else if len(request.Scopes) == 0 {
+		message = "at least one scope is required"
+	} else if !request.Expires.IsZero() && request.Expires.Before(time.Now()) {
+		message = "expires must be in the future"
+	}
+	for _, scope := range request.Scopes {
+		if !HasScope(c, scope) {
+			message = fmt.Sprintf("scope %s cannot be granted", scope)
+		}
+	}
+	if message != "" {
+		result.Code = http.StatusBadRequest
+		result.Message = message
+		c.JSON(result.Code, result)
+		return
+	}
+
+	token := APIToken{
+		Name:      request.Name,
+		Owner:     c.GetString(AUTH_USER),
+		Scopes:    request.Scopes,
+		Pipelines: request.Pipelines,
+		Expires:   request.Expires,
+	}
+	secret, err := s.AddAPIToken(&token)
+	if err != nil {
+		result.Code = http.StatusInternalServerError
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+	log.Info("Created token ", token.ID, " (", token.Name, ") for ", c.GetString(AUTH_PRINCIPAL))
+
+	token.Hash = ""
+	result.Code = http.StatusCreated
+	result.Result = "OK"
+	result.Message = struct {
+		APIToken
+		Secret string `json:"secret"`
+	}{token, secret}
+	c.JSON(result.Code, result)
+}
+
+// Tokens : List the personal API tokens of the signed in user
+//
+// GET /api/v1/tokens
+//
+// Members of the admin group may add `?all=true` to list every token.
+//
+// Response codes:
+// - 200 OK
+// - 500 Internal server error
+func (s *Server) Tokens(c *gin.Context) {
+	result := api.NewResult()
+	var owner string = c.GetString(AUTH_USER)
+	if c.Query("all") == "true" && HasScope(c, config.SCOPE_ADMIN) {
+		owner = ""
+	}
+
+	tokens, err := s.ListAPITokens(owner)
+	if err != nil {
+		result.Code = http.StatusInternalServerError
+		result.Result = "Error"
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+	result.Code = http.StatusOK
+	result.Result = "OK"
+	result.Message = tokens
+	c.JSON(result.Code, result)
+}
+
+// RevokeToken : Revoke a personal API token
+//
+// DELETE /api/v1/tokens/:id
+//
+// Users may revoke their own tokens. Members of the admin group may revoke any token.
+//
+// Response codes:
+// - 202 Accepted
+// - 404 Not found
+// - 500 Internal server error
+func (s *Server) RevokeToken(c *gin.Context) {
+	result := api.NewResult()
+	result.Result = "Error"
+
+	token := s.getAPIToken(c.Params.ByName("id"))
+	if token == nil || (token.Owner != c.GetString(AUTH_USER) && !HasScope(c, config.SCOPE_ADMIN)) {
+		result.Code = http.StatusNotFound
+		result.Message = "No such token"
+		c.JSON(result.Code, result)
+		return
+	}
+
+	if err := s.DeleteAPIToken(token.ID); err != nil {
+		result.Code = http.StatusInternalServerError
+		result.Message = err.Error()
+		c.JSON(result.Code, result)
+		return
+	}
+	log.Info("Revoked token ", token.ID, " (", token.Name, ") by ", c.GetString(AUTH_PRINCIPAL))
+
+	result.Code = http.StatusAccepted
+	result.Result = "OK"
+	c.JSON(result.Code, result)
+}
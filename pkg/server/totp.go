@@ -0,0 +1,285 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"image/png"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/server/api"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// RECOVERY_CODES : The number of recovery codes issued on enrolment
+const RECOVERY_CODES int = 10
+
+// TOTP_PERIOD : The number of seconds each one time password is valid for
+const TOTP_PERIOD uint = 30
+
+// TOTP_SKEW : The number of periods either side of now a code is accepted from
+const TOTP_SKEW int = 1
+
+// SetTotp : Store the TOTP secret of a user, encrypted with the assemble passphrase
+func (s *Server) SetTotp(user *User, secret string) error {
+	val, err := api.EncryptData([]byte(secret), s.config.GetPassphrase("assemble"))
+	if err != nil {
+		return err
+	}
+	if err := s.replace(user.ID, TOTP_T, base64.StdEncoding.EncodeToString(val)); err != nil {
+		return fmt.Errorf("Failed to store totp: %s", err)
+	}
+	user.TotpKey = secret
+	return nil
+}
+
+// NewRecoveryCodes : Replace the recovery codes of a user
+//
+// The codes are returned to be shown once and stored hashed
+func (s *Server) NewRecoveryCodes(user *User) ([]string, error) {
+	codes := make([]string, 0)
+	hashes := make([]string, 0)
+	for i := 0; i < RECOVERY_CODES; i++ {
+		random := make([]byte, 5)
+		if _, err := rand.Read(random); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(base32.StdEncoding.EncodeToString(random))
+		code = code[:4] + "-" + code[4:]
+		hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, string(hash))
+	}
+
+	if err := s.replace(user.ID, RECOVERY_T, strings.Join(hashes, ",")); err != nil {
+		return nil, fmt.Errorf("Failed to store recovery codes: %s", err)
+	}
+	return codes, nil
+}
+
+// ValidateOtp : Check a one time password or recovery code for a user
+//
+// A recovery code is removed once used
+func (s *Server) ValidateOtp(user *User, code string) bool {
+	code = strings.TrimSpace(code)
+	if code == "" || user.TotpKey == "" {
+		return false
+	}
+	if s.validateTotp(user.ID, user.TotpKey, code) {
+		return true
+	}
+	return s.useRecoveryCode(user, strings.ToLower(code))
+}
+
+// validateTotp : Check a one time password against secret
+//
+// The time step of the last code accepted for the user is stored and codes
+// from that step or earlier are refused so a code cannot be used twice.
+func (s *Server) validateTotp(id string, secret string, code string) bool {
+	var (
+		now  time.Time = time.Now()
+		step int64     = -1
+	)
+	for skew := -TOTP_SKEW; skew <= TOTP_SKEW; skew++ {
+		at := now.Add(time.Duration(skew*int(TOTP_PERIOD)) * time.Second)
+		expected, err := totp.GenerateCodeCustom(secret, at, totp.ValidateOpts{
+			Period:    TOTP_PERIOD,
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err == nil && subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			step = at.Unix() / int64(TOTP_PERIOD)
+		}
+	}
+	if step < 0 {
+		return false
+	}
+
+	var accepted bool
+	s.users.Lock()
+	defer s.users.Unlock()
+	if err := s.users.Db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(TOTP_STEP_T))
+		if last, err := strconv.ParseInt(string(b.Get([]byte(id))), 10, 64); err == nil && step <= last {
+			return nil
+		}
+		accepted = true
+		return b.Put([]byte(id), []byte(strconv.FormatInt(step, 10)))
+	}); err != nil {
+		log.Error("Failed to record one time password for ", id, " ", err)
+		return false
+	}
+	if !accepted {
+		log.Warn("Refused reused one time password for user ", id)
+	}
+	return accepted
+}
+
+func (s *Server) useRecoveryCode(user *User, code string) bool {
+	var hashes []string = strings.Split(s.get(user.ID, RECOVERY_T), ",")
+	for i, hash := range hashes {
+		if hash == "" || bcrypt.CompareHashAndPassword([]byte(hash), []byte(code)) != nil {
+			continue
+		}
+		hashes = append(hashes[:i], hashes[i+1:]...)
+		if err := s.replace(user.ID, RECOVERY_T, strings.Join(hashes, ",")); err != nil {
+			log.Error("Failed to remove used recovery code ", err)
+			return false
+		}
+		log.Warnf("Recovery code used for user %s, %d remain", user.ID, len(hashes))
+		return true
+	}
+	return false
+}
+
+// qrCode : Get the QR code of a key as a data URI
+func qrCode(key *otp.Key) string {
+	image, err := key.Image(200, 200)
+	if err != nil {
+		log.Error("Failed to generate QR code ", err)
+		return ""
+	}
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, image); err != nil {
+		log.Error("Failed to encode QR code ", err)
+		return ""
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buffer.Bytes())
+}
+
+// Enrol : Enrol a user signing in without a second factor
+//
+// GET  /enrol - show a new secret as a QR code and otpauth URI
+// POST /enrol - confirm the secret with a first code then show the recovery codes
+//
+// Only reachable after a correct password on /login
+func (server *Server) Enrol(c *gin.Context) {
+	session := sessions.Default(c)
+	email, ok := session.Get("EnrolEmail").(string)
+	if !ok || email == "" {
+		c.Redirect(http.StatusFound, "/login")
+		return
+	}
+
+	if c.Request.Method == http.MethodPost {
+		user, err := server.FindUser(email)
+		if err != nil || user == nil {
+			server.Error(c, http.StatusInternalServerError, fmt.Errorf("Unable to find user for enrolment"))
+			return
+		}
+
+		secret, _ := session.Get("TotpSecret").(string)
+		request := login{}
+		if err := c.ShouldBind(&request); err != nil || secret == "" || !server.validateTotp(user.ID, secret, strings.TrimSpace(request.Otp)) {
+			c.Redirect(http.StatusFound, "/enrol?error=invalidotp")
+			return
+		}
+
+		if err := server.SetTotp(user, secret); err != nil {
+			server.Error(c, http.StatusInternalServerError, err)
+			return
+		}
+		codes, err := server.NewRecoveryCodes(user)
+		if err != nil {
+			server.Error(c, http.StatusInternalServerError, err)
+			return
+		}
+		log.Info("User ", user.ID, " enrolled a second factor")
+
+		session.Delete("EnrolEmail")
+		session.Delete("TotpSecret")
+		if err := server.signinSession(user, c); err != nil {
+			server.Error(c, http.StatusInternalServerError, err)
+			return
+		}
+		c.HTML(http.StatusOK, "enrol", gin.H{
+			"Title":    "TIYO - Recovery codes",
+			"Recovery": codes,
+		})
+		return
+	}
+
+	key, err := server.config.GenerateTOTP(email)
+	if err != nil {
+		server.Error(c, http.StatusInternalServerError, err)
+		return
+	}
+	session.Set("TotpSecret", key.Secret())
+	if err := session.Save(); err != nil {
+		server.Error(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.HTML(http.StatusOK, "enrol", gin.H{
+		"Title":  "TIYO - Set up two factor authentication",
+		"QRCode": template.URL(qrCode(key)),
+		"URL":    key.URL(),
+		"Secret": key.Secret(),
+	})
+}
+
+// ResetUserTotp : Clear the second factor of a user
+//
+// POST /api/v1/totp/reset
+//
+// Request parameters:
+// - email - the email of the user to reset
+//
+// The secret and recovery codes of the user are removed and their sessions
+// ended. The user is asked to enrol a new second factor at their next
+// sign in. Requires a session with the admin scope.
+//
+// Response codes:
+// - 202 Accepted
+// - 400 Bad request
+// - 404 Not found
+// - 500 Internal server error
+func (server *Server) ResetUserTotp(c *gin.Context) {
+	var request struct {
+		Email string `json:"email"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil || !validEmail.MatchString(request.Email) {
+		reply(c, http.StatusBadRequest, "A valid email is required")
+		return
+	}
+
+	user, _ := server.FindUser(request.Email)
+	if user == nil {
+		reply(c, http.StatusNotFound, "No such user")
+		return
+	}
+
+	for _, table := range []string{TOTP_T, RECOVERY_T, TOTP_STEP_T} {
+		if err := server.delete(user.ID, table); err != nil {
+			reply(c, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	if err := server.DeleteSessions(user.ID); err != nil {
+		reply(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	log.Warn("Second factor of user ", user.ID, " reset by ", c.GetString(AUTH_PRINCIPAL))
+	reply(c, http.StatusAccepted, "Second factor cleared. The user will enrol a new one at their next sign in")
+}
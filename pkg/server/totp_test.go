@@ -0,0 +1,64 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+)
+
+func TestValidateOtp(t *testing.T) {
+	const secret string = "JBSWY3DPEHPK3PXP"
+
+	server := newTestServer(t)
+	user := addTestUser(t, server, "user@example.com", false)
+	if err := server.SetTotp(user, secret); err != nil {
+		t.Fatal(err)
+	}
+	codes, err := server.NewRecoveryCodes(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := func(offset time.Duration) string {
+		value, err := totp.GenerateCode(secret, time.Now().Add(offset))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return value
+	}
+	var period time.Duration = time.Duration(TOTP_PERIOD) * time.Second
+
+	// cases run in order against the same user
+	tests := []struct {
+		name  string
+		code  string
+		valid bool
+	}{
+		{name: "empty", code: ""},
+		{name: "wrong code", code: "000000x"},
+		{name: "previous step", code: code(-period), valid: true},
+		{name: "current step", code: code(0), valid: true},
+		{name: "current step reused", code: code(0)},
+		{name: "previous step after current", code: code(-period)},
+		{name: "outside skew", code: code(time.Duration(TOTP_SKEW+1) * period)},
+		{name: "recovery code", code: codes[0], valid: true},
+		{name: "recovery code reused", code: codes[0]},
+		{name: "recovery code in upper case", code: " " + strings.ToUpper(codes[1]) + " ", valid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if valid := server.ValidateOtp(user, test.code); valid != test.valid {
+				t.Errorf("expected %v, got %v", test.valid, valid)
+			}
+		})
+	}
+}
@@ -8,10 +8,14 @@ package server
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/boltdb/bolt"
@@ -26,12 +30,25 @@ const (
 	USERGROUPS_T   = "groups"
 	PASSW_T        = "passwords"
 	TOTP_T         = "totp"
+	TOTP_STEP_T    = "totpstep"
 	GROUP_T        = "group"
 	GROUP_PERMS_T  = "groupperms"
 	ACL_T          = "acl"
 	PERM_T         = "permissions"
 	MACHINE_TOKENS = "machines"
 	MACHINE_HMAC   = "hmac"
+	API_TOKENS_T   = "apitokens"
+	RECOVERY_T     = "recovery"
+	EMAIL_T        = "emails"
+	DISABLED_T     = "disabled"
+	INVITES_T      = "invites"
+	EMAIL_INDEX_T  = "emailindex"
+	SSO_T          = "sso"
+	SESSIONS_T     = "sessions"
+	SECRETS_T      = "secrets"
+	ATTEMPTS_T     = "attempts"
+	AUDIT_T        = "audit"
+	AUDIT_HEAD_T   = "audithead"
 )
 
 type Lockable struct {
@@ -48,18 +65,30 @@ type User struct {
 }
 
 type Group struct {
-	ID          string
-	Name        string
-	Permissions []Permission
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
 }
 
 type Permission struct {
-	ID         string
-	Permission string
+	ID         string `json:"id"`
+	Permission string `json:"permission"`
+}
+
+// Account : A user as shown to administrators
+type Account struct {
+	ID       string   `json:"id"`
+	Email    string   `json:"email"`
+	Groups   []string `json:"groups"`
+	Disabled bool     `json:"disabled"`
+	Totp     bool     `json:"totp"`
+	Invited  bool     `json:"invited"`
+	Locked   bool     `json:"locked"`
 }
 
 var tables []string = []string{
-	USERS_T, USERGROUPS_T, PASSW_T, TOTP_T, GROUP_PERMS_T, GROUP_T, PERM_T, MACHINE_TOKENS, MACHINE_HMAC,
+	USERS_T, USERGROUPS_T, PASSW_T, TOTP_T, TOTP_STEP_T, GROUP_PERMS_T, GROUP_T, PERM_T, MACHINE_TOKENS, MACHINE_HMAC, API_TOKENS_T, RECOVERY_T,
+	EMAIL_T, DISABLED_T, INVITES_T, EMAIL_INDEX_T, SSO_T, SESSIONS_T, SECRETS_T, ATTEMPTS_T, AUDIT_T, AUDIT_HEAD_T, ACL_T,
 }
 
 func (s *Server) CreateTables() error {
@@ -91,11 +120,20 @@ func (s *Server) AddUser(user *User) error {
 		return fmt.Errorf("Failed to add or update user: %s", err)
 	}
 
+	err = s.replace(u.Email, EMAIL_INDEX_T, user.ID)
+	if err != nil {
+		return fmt.Errorf("AddUser index failed: %s", err)
+	}
+
 	err = s.replace(user.ID, PASSW_T, u.Password)
 	if err != nil {
 		return fmt.Errorf("AddUser password failed: %s", err)
 	}
 
+	if err = s.setEmail(user); err != nil {
+		return fmt.Errorf("AddUser email failed: %s", err)
+	}
+
 	err = s.replace(user.ID, TOTP_T, u.TotpKey)
 	if err != nil {
 		return fmt.Errorf("AddUser totp failed: %s", err)
@@ -115,16 +153,62 @@ func (s *Server) AddUser(user *User) error {
 	return nil
 }
 
+// LEGACY_SEARCH_INTERVAL : The least time between searches of the legacy email hashes
+//
+// Each search costs one bcrypt comparison per legacy user so searches are
+// limited across the server, whatever the number of clients.
+const LEGACY_SEARCH_INTERVAL time.Duration = time.Second
+
+// FindUser : Find a user by email
+//
+// Users are found through the blind index of their email. Users added
+// before the index whose email could not be indexed at startup are
+// found by comparing the email against each legacy hash, and are
+// indexed once found. Such searches are limited to one each
+// LEGACY_SEARCH_INTERVAL, so a legacy user may need to retry signing in
+// whilst others are failing to.
 func (s *Server) FindUser(email string) (*User, error) {
-	var user User = User{
-		Email: email,
+	var id string = s.get(s.blindIndex(email), EMAIL_INDEX_T)
+	var legacy bool
+	if id == "" && atomic.LoadInt32(&s.legacyUsers) > 0 && s.claimLegacySearch() {
+		id = s.findHashedValue(email, USERS_T)
+		legacy = id != ""
 	}
-
-	user.ID = s.findHashedValue(email, USERS_T)
-	if user.ID == "" {
+	if id == "" {
 		return nil, fmt.Errorf("FindUser - Failed to find user with: %s", email)
 	}
 
+	user, err := s.FindUserByID(id)
+	if err != nil {
+		return nil, err
+	}
+	user.Email = email
+	if legacy {
+		if err := s.indexUser(user); err != nil {
+			log.Warn("Failed to index user ", user.ID, " ", err)
+		}
+		if s.userEmail(user.ID) == "" {
+			if err := s.setEmail(user); err != nil {
+				log.Warn("Failed to record email of user ", user.ID, " ", err)
+			}
+		}
+	}
+	return user, nil
+}
+
+// FindUserByID : Find a user by ID
+//
+// The email is only set if it has been recorded since the user was added
+// or last signed in.
+func (s *Server) FindUserByID(id string) (*User, error) {
+	var user User = User{
+		ID:    id,
+		Email: s.userEmail(id),
+	}
+	if s.get(id, USERS_T) == "" {
+		return nil, fmt.Errorf("User %s is not registered", id)
+	}
+
 	user.Password = string(s.get(user.ID, PASSW_T))
 
 	inter := s.get(user.ID, TOTP_T)
@@ -137,61 +221,152 @@ func (s *Server) FindUser(email string) (*User, error) {
 	g := strings.Split(string(groupsString), ",")
 
 	for _, n := range g {
-		log.Infof("Looking for User Group with ID %s", n)
-		group, _ := s.FindGroupByID(n)
+		if n == "" {
+			continue
+		}
+		log.Debugf("Looking for User Group with ID %s", n)
+		group, err := s.FindGroupByID(n)
+		if err != nil || group == nil {
+			log.Warnf("User %s belongs to unknown group %s", id, n)
+			continue
+		}
 		groups = append(groups, *group)
 	}
 
 	user.Groups = groups
-
-	if user.ID == "" {
-		return nil, fmt.Errorf("User %s is not registered", email)
-	}
 	return &user, nil
 }
 
 func (s *Server) DeleteUser(user *User) error {
-	var (
-		id []byte
-		u  User = s.encryptUser(user)
-	)
+	var id []byte
+	if user.ID != "" {
+		id = []byte(user.ID)
+	} else if user.Email != "" {
+		id = []byte(s.get(s.blindIndex(user.Email), EMAIL_INDEX_T))
+	}
+	if len(id) == 0 {
+		return nil
+	}
+	var index string = s.get(string(id), USERS_T)
+
+	tokens, err := s.ListAPITokens(string(id))
+	if err != nil {
+		return err
+	}
+	if err := s.DeleteSessions(string(id)); err != nil {
+		return err
+	}
 
 	s.users.Lock()
 	defer s.users.Unlock()
-	if err := s.users.Db.View(func(tx *bolt.Tx) error {
-		b := tx.Bucket([]byte(USERS_T))
-		c := b.Cursor()
-		for k, v := c.First(); k != nil; k, v = c.Next() {
-			if 0 == bytes.Compare(v, []byte(u.Email)) {
-				id = k
+	return s.users.Db.Update(func(tx *bolt.Tx) error {
+		for _, table := range []string{USERGROUPS_T, TOTP_T, TOTP_STEP_T, PASSW_T, RECOVERY_T, EMAIL_T, DISABLED_T, SSO_T, USERS_T} {
+			if err := tx.Bucket([]byte(table)).Delete(id); err != nil {
+				return err
 			}
 		}
+		for _, token := range tokens {
+			if err := tx.Bucket([]byte(API_TOKENS_T)).Delete([]byte(token.ID)); err != nil {
+				return err
+			}
+		}
+		if index != "" && !isBcrypt(index) {
+			return tx.Bucket([]byte(EMAIL_INDEX_T)).Delete([]byte(index))
+		}
 		return nil
-	}); err != nil {
-		return err
-	}
-
-	if id != nil {
-		if err := s.users.Db.Update(func(tx *bolt.Tx) error {
-			b := tx.Bucket([]byte(USERGROUPS_T))
-			b.Delete(id)
+	})
+}
 
-			b = tx.Bucket([]byte(TOTP_T))
-			b.Delete(id)
+// blindIndex : Get the key an email is indexed under
+//
+// The key is a HMAC-SHA256 of the normalised email under the assemble
+// passphrase, so emails can be looked up without being stored in the
+// clear. Changing the passphrase invalidates the index.
+func (s *Server) blindIndex(email string) string {
+	mac := hmac.New(sha256.New, []byte(s.config.GetPassphrase("assemble")))
+	mac.Write([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(mac.Sum(nil))
+}
 
-			b = tx.Bucket([]byte(PASSW_T))
-			b.Delete(id)
+// indexUser : Replace the legacy email hash of a user with its blind index
+func (s *Server) indexUser(user *User) error {
+	var index string = s.blindIndex(user.Email)
+	s.users.Lock()
+	defer s.users.Unlock()
+	err := s.users.Db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket([]byte(EMAIL_INDEX_T)).Put([]byte(index), []byte(user.ID)); err != nil {
+			return err
+		}
+		return tx.Bucket([]byte(USERS_T)).Put([]byte(user.ID), []byte(index))
+	})
+	// decremented whilst holding the lock so it never drops below zero
+	if err == nil && atomic.LoadInt32(&s.legacyUsers) > 0 {
+		atomic.AddInt32(&s.legacyUsers, -1)
+	}
+	return err
+}
 
-			b = tx.Bucket([]byte(USERS_T))
-			b.Delete(id)
+// MigrateUsers : Index users stored before emails were indexed
+//
+// Users whose email was recorded when they last signed in are indexed
+// immediately. Users from before emails were recorded only have a bcrypt
+// hash of their email, which cannot be reversed into the index, so are
+// indexed the next time they sign in and until then are found by the
+// slower, rate limited, comparison of legacy hashes.
+func (s *Server) MigrateUsers() error {
+	legacy := make([]string, 0)
+	if err := s.users.Db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(USERS_T)).ForEach(func(k, v []byte) error {
+			if isBcrypt(string(v)) {
+				legacy = append(legacy, string(k))
+			}
 			return nil
-		}); err != nil {
-			return err
+		})
+	}); err != nil {
+		return err
+	}
+
+	atomic.StoreInt32(&s.legacyUsers, int32(len(legacy)))
+	for _, id := range legacy {
+		user := User{ID: id, Email: s.userEmail(id)}
+		if user.Email == "" {
+			continue
+		}
+		if err := s.indexUser(&user); err != nil {
+			return fmt.Errorf("Failed to index user %s: %s", id, err)
 		}
 	}
+
+	var remaining int32 = atomic.LoadInt32(&s.legacyUsers)
+	if len(legacy) > 0 {
+		log.Infof("Indexed %d of %d users", int32(len(legacy))-remaining, len(legacy))
+	}
+	if remaining > 0 {
+		log.Warnf("%d users will be indexed when they next sign in", remaining)
+	}
 	return nil
 }
 
+// claimLegacySearch : May legacy email hashes be searched now
+//
+// Returns false if another search started within LEGACY_SEARCH_INTERVAL
+func (s *Server) claimLegacySearch() bool {
+	var (
+		last int64 = atomic.LoadInt64(&s.legacySearch)
+		now  int64 = time.Now().UnixNano()
+	)
+	if now-last < int64(LEGACY_SEARCH_INTERVAL) {
+		log.Debug("Skipped search of legacy users, last search ", time.Duration(now-last), " ago")
+		return false
+	}
+	return atomic.CompareAndSwapInt64(&s.legacySearch, last, now)
+}
+
+// isBcrypt : Is value a bcrypt hash rather than a blind index
+func isBcrypt(value string) bool {
+	return strings.HasPrefix(value, "$2")
+}
+
 func (s *Server) AddGroup(group *Group) error {
 	if group.ID == "" {
 		group.ID = uuid.New().String()
@@ -242,11 +417,12 @@ func (s *Server) FindGroupByID(id string) (*Group, error) {
 		var permissions []Permission = make([]Permission, 0)
 		b = tx.Bucket([]byte(PERM_T))
 		for _, p := range perms {
-			permission := b.Get([]byte(p))
-			permissions = append(permissions, Permission{
-				ID:         string(permission),
-				Permission: string(id),
-			})
+			if permission := b.Get([]byte(p)); permission != nil {
+				permissions = append(permissions, Permission{
+					ID:         p,
+					Permission: string(permission),
+				})
+			}
 		}
 		group.Permissions = permissions
 
@@ -288,11 +464,12 @@ func (s *Server) FindGroup(name string) (*Group, error) {
 		var permissions []Permission = make([]Permission, 0)
 		b = tx.Bucket([]byte(PERM_T))
 		for _, p := range perms {
-			permission := b.Get([]byte(p))
-			permissions = append(permissions, Permission{
-				ID:         string(permission),
-				Permission: string(id),
-			})
+			if permission := b.Get([]byte(p)); permission != nil {
+				permissions = append(permissions, Permission{
+					ID:         p,
+					Permission: string(permission),
+				})
+			}
 		}
 		group.Permissions = permissions
 
@@ -396,73 +573,6 @@ func (s *Server) DeletePermission(name string) error {
 	return nil
 }
 
-func (s *Server) FindMachine(address string) string {
-	var key string = ""
-	s.users.Db.View(func(tx *bolt.Tx) error {
-		b := tx.Bucket([]byte(MACHINE_TOKENS))
-		key = string(b.Get([]byte(address)))
-		return nil
-	})
-	return key
-}
-
-func (s *Server) AddMachine(address string) string {
-	var (
-		key string
-		err error
-	)
-	if key = s.FindMachine(address); key == "" {
-		log.Infof("Generating key for %s", address)
-		var (
-			token      string = `{"token": "%s", "validFor": "%s", "expires": "%s"}`
-			passphrase string = ""
-			expiry     string = fmt.Sprintf("%s", time.Now().AddDate(1, 0, 0))
-			pBytes     []byte
-			hmac       []byte
-		)
-
-		// Flow machines need the passphrase to decrypt data
-		// this is about the safest way i can think of to transmit it
-		//
-		// - First encrypt the passphrase with itself
-		// - Calculate the hmac and store it - Flow will retrieve this via IP restricted API call
-		// - Repeat the hmac to > encrypted passphrase length (hmachmachmac)
-		// - Slice hmac to encrypted passphrase length
-		// - XOR encryoted passphrase and slice hmac
-		// - wrap into JSON
-		// - base64 encode
-		pBytes, _ = api.EncryptData([]byte(s.config.Assemble.Passphrase), s.config.Assemble.Passphrase)
-		hmac = api.Hmac([]byte(s.config.Assemble.Passphrase), pBytes)
-		s.replace(address, MACHINE_HMAC, string(hmac))
-		if len(pBytes) > len(hmac) {
-			for {
-				hmac = bytes.Repeat(hmac, 1)
-				if len(hmac) > len(pBytes) {
-					break
-				}
-			}
-		}
-		hmac = hmac[:len(pBytes)]
-		for i := range pBytes {
-			pBytes[i] = pBytes[i] ^ hmac[i]
-		}
-		passphrase = string(pBytes)
-
-		token = fmt.Sprintf(token, passphrase, address, expiry)
-		key = base64.StdEncoding.EncodeToString([]byte(token))
-		if err = s.replace(address, MACHINE_TOKENS, key); err != nil {
-			log.Errorf("Failed to add or update machine instance %s, %s", address, err)
-			key = ""
-		}
-	}
-	log.Info("returning %s", key)
-	return key
-}
-
-func (s *Server) getHmac(ip string) string {
-	return s.get(ip, MACHINE_HMAC)
-}
-
 func (s *Server) get(id, where string) string {
 	var val string
 	if err := s.users.Db.View(func(tx *bolt.Tx) error {
@@ -500,6 +610,9 @@ func (s *Server) findHashedValue(what, where string) string {
 		b := tx.Bucket([]byte(where))
 		c := b.Cursor()
 		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if !isBcrypt(string(v)) {
+				continue
+			}
 			if err := bcrypt.CompareHashAndPassword(v, []byte(what)); err == nil {
 				id = k
 				break
@@ -548,13 +661,155 @@ func (s *Server) encryptUser(user *User) User {
 		Groups: user.Groups,
 	}
 
-	bytes, _ := bcrypt.GenerateFromPassword([]byte(user.Email), bcrypt.DefaultCost)
-	u.Email = string(bytes)
+	u.Email = s.blindIndex(user.Email)
 
-	bytes, _ = bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
-	u.Password = string(bytes)
+	// invited users have no password until they accept the invitation
+	if user.Password != "" {
+		bytes, _ := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+		u.Password = string(bytes)
+	}
 
 	val, _ := api.EncryptData([]byte(user.TotpKey), s.config.GetPassphrase("assemble"))
 	u.TotpKey = base64.StdEncoding.EncodeToString(val)
 	return u
 }
+
+// setEmail : Record the email of a user, encrypted with the assemble passphrase
+//
+// The users table only holds the blind index of the email which cannot be
+// shown to administrators.
+func (s *Server) setEmail(user *User) error {
+	val, err := api.EncryptData([]byte(user.Email), s.config.GetPassphrase("assemble"))
+	if err != nil {
+		return err
+	}
+	return s.replace(user.ID, EMAIL_T, base64.StdEncoding.EncodeToString(val))
+}
+
+func (s *Server) userEmail(id string) string {
+	data, err := base64.StdEncoding.DecodeString(s.get(id, EMAIL_T))
+	if err != nil || len(data) == 0 {
+		return ""
+	}
+	email, _ := api.DecryptData(data, s.config.GetPassphrase("assemble"))
+	return string(email)
+}
+
+// SetPassword : Replace the password of a user
+func (s *Server) SetPassword(user *User, password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	if err := s.replace(user.ID, PASSW_T, string(hash)); err != nil {
+		return fmt.Errorf("Failed to set password: %s", err)
+	}
+	user.Password = string(hash)
+	return nil
+}
+
+// SetGroups : Replace the groups a user belongs to
+func (s *Server) SetGroups(user *User, groups []Group) error {
+	var ids []string = make([]string, 0)
+	for _, group := range groups {
+		ids = append(ids, group.ID)
+	}
+	if err := s.replace(user.ID, USERGROUPS_T, strings.Join(ids, ",")); err != nil {
+		return fmt.Errorf("Failed to set groups: %s", err)
+	}
+	user.Groups = groups
+	return nil
+}
+
+// DisableUser : Stop or allow a user signing in
+func (s *Server) DisableUser(user *User, disabled bool) error {
+	if !disabled {
+		return s.delete(user.ID, DISABLED_T)
+	}
+	if err := s.replace(user.ID, DISABLED_T, time.Now().Format(time.RFC3339)); err != nil {
+		return err
+	}
+	return s.DeleteSessions(user.ID)
+}
+
+// IsDisabled : Has the user with id been disabled
+func (s *Server) IsDisabled(id string) bool {
+	return s.get(id, DISABLED_T) != ""
+}
+
+// ListUsers : Get every user
+func (s *Server) ListUsers() ([]Account, error) {
+	var ids []string = make([]string, 0)
+	if err := s.users.Db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(USERS_T)).ForEach(func(k, v []byte) error {
+			ids = append(ids, string(k))
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	accounts := make([]Account, 0)
+	for _, id := range ids {
+		user, err := s.FindUserByID(id)
+		if err != nil {
+			log.Warn("Skipping user ", id, " ", err)
+			continue
+		}
+		accounts = append(accounts, s.account(user))
+	}
+	return accounts, nil
+}
+
+// account : Get the administrator view of a user
+func (s *Server) account(user *User) Account {
+	account := Account{
+		ID:       user.ID,
+		Email:    user.Email,
+		Groups:   make([]string, 0),
+		Disabled: s.IsDisabled(user.ID),
+		Totp:     user.TotpKey != "",
+		Invited:  user.Password == "",
+		Locked:   s.IsLocked(user.Email),
+	}
+	for _, group := range user.Groups {
+		account.Groups = append(account.Groups, group.Name)
+	}
+	return account
+}
+
+// ListGroups : Get every group with its permissions
+func (s *Server) ListGroups() ([]Group, error) {
+	var ids []string = make([]string, 0)
+	if err := s.users.Db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(GROUP_T)).ForEach(func(k, v []byte) error {
+			ids = append(ids, string(k))
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	groups := make([]Group, 0)
+	for _, id := range ids {
+		if group, err := s.FindGroupByID(id); err == nil && group != nil {
+			groups = append(groups, *group)
+		}
+	}
+	return groups, nil
+}
+
+// ListPermissions : Get every permission
+func (s *Server) ListPermissions() ([]Permission, error) {
+	permissions := make([]Permission, 0)
+	err := s.users.Db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(PERM_T)).ForEach(func(k, v []byte) error {
+			permissions = append(permissions, Permission{
+				ID:         string(k),
+				Permission: string(v),
+			})
+			return nil
+		})
+	})
+	return permissions, err
+}
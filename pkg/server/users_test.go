@@ -0,0 +1,83 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package server
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// addLegacyUser : Store a user as assemble did before emails were indexed
+func addLegacyUser(t *testing.T, server *Server, id string, email string) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(email), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for table, value := range map[string]string{USERS_T: string(hash), PASSW_T: "", TOTP_T: "", USERGROUPS_T: ""} {
+		if err := server.replace(id, table, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestMigrateUsers(t *testing.T) {
+	server := newTestServer(t)
+	addLegacyUser(t, server, "legacy", "legacy@example.com")
+	addLegacyUser(t, server, "recorded", "recorded@example.com")
+	if err := server.setEmail(&User{ID: "recorded", Email: "recorded@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	indexed := addTestUser(t, server, "indexed@example.com", false)
+
+	if err := server.MigrateUsers(); err != nil {
+		t.Fatal(err)
+	}
+	if remaining := atomic.LoadInt32(&server.legacyUsers); remaining != 1 {
+		t.Fatalf("expected 1 user left to index, got %d", remaining)
+	}
+
+	tests := []struct {
+		name  string
+		email string
+
+		// Let a search of legacy hashes start immediately
+		reset bool
+
+		id string
+	}{
+		{name: "indexed user", email: "indexed@example.com", id: indexed.ID},
+		{name: "user with recorded email", email: "recorded@example.com", id: "recorded"},
+		{name: "unknown user", email: "unknown@example.com", reset: true},
+		{name: "legacy user within search interval", email: "legacy@example.com"},
+		{name: "legacy user", email: "legacy@example.com", reset: true, id: "legacy"},
+		{name: "legacy user once indexed", email: "legacy@example.com", id: "legacy"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.reset {
+				atomic.StoreInt64(&server.legacySearch, 0)
+			}
+			user, err := server.FindUser(test.email)
+			if test.id == "" {
+				if user != nil {
+					t.Errorf("expected no user, got %s", user.ID)
+				}
+				return
+			}
+			if err != nil || user.ID != test.id {
+				t.Errorf("expected user %s, got %v %v", test.id, user, err)
+			}
+		})
+	}
+
+	if remaining := atomic.LoadInt32(&server.legacyUsers); remaining != 0 {
+		t.Errorf("expected every user to be indexed, %d left", remaining)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/crewjam/saml/samlsp"
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/client"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -32,11 +33,10 @@ func (server *Server) RequireAccount(c *gin.Context) {
 		return
 	}
 
-	if token := c.Request.Header.Get("X-Auth-Token"); token != "" {
-		ip := c.ClientIP()
-		key := server.FindMachine(ip)
-		if token != key {
-			c.JSON(http.StatusForbidden, struct {
+	if c.GetHeader(client.HEADER_SIGNATURE) != "" {
+		if _, err := server.VerifyMachine(c); err != nil {
+			log.Warn("Refused signed request from ", remoteAddress(c), " for ", c.Request.URL.Path, " - ", err)
+			c.AbortWithStatusJSON(http.StatusForbidden, struct {
 				Forbidden string
 			}{
 				Forbidden: "The resource you are trying to access is refused by policy",
@@ -46,7 +46,7 @@ func (server *Server) RequireAccount(c *gin.Context) {
 	}
 
 	section := strings.Trim(strings.Split(c.Request.RequestURI, "?")[0], "/")
-	if section == "login" || section == "logout" {
+	if section == "login" || section == "logout" || section == "enrol" || section == "invite" || strings.HasPrefix(section, "oidc/") {
 		return
 	}
 
@@ -59,6 +59,12 @@ func (server *Server) RequireAccount(c *gin.Context) {
 
 	// user has valid session
 	if err := server.ValidateSession(current); err == nil {
+		if user, ok := current.Get("User").(User); ok && server.IsDisabled(user.ID) {
+			current.Clear()
+			current.Save()
+			c.Redirect(http.StatusFound, "/login?error=disabled")
+			c.Abort()
+		}
 		return
 	}
 
@@ -84,18 +90,9 @@ func (server *Server) RequireAccount(c *gin.Context) {
 					return
 				}
 
-				var jwtGroups []string = jwtSessionClaims.Attributes["Groups"]
-				groups := make([]Group, 0)
-				for _, g := range jwtGroups {
-					group, _ := server.FindGroup(g)
-					groups = append(groups, *group)
-				}
-
-				user := &User{
-					Email:  email,
-					Groups: groups,
-				}
-				server.signinSession(user, c)
+				var jwtGroups []string = jwtSessionClaims.Attributes[server.config.SAML.GroupsAttribute]
+				server.ssoSignin(c, SSO_SAML, email, jwtGroups, c.Request.URL.RequestURI())
+				c.Abort()
 				return
 			}
 		}
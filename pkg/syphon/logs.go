@@ -0,0 +1,232 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package syphon
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/notapipeline/tiyo/pkg/logging"
+	"github.com/notapipeline/tiyo/pkg/server/api"
+)
+
+// LOG_FLUSH : The number of seconds between shipping output to flow
+const LOG_FLUSH = 2
+
+// LOG_CHUNK_SIZE : The maximum number of bytes held in a single chunk
+const LOG_CHUNK_SIZE = 32 * 1024
+
+// LOG_RETRIES : The number of attempts made to ship the final batch of output
+const LOG_RETRIES = 3
+
+// LOG_BACKLOG : The maximum number of bytes held whilst flow is unreachable
+//
+// Once exceeded, the oldest unsent chunks are dropped
+const LOG_BACKLOG = 4 * 1024 * 1024
+
+// Output streams shipped by syphon
+const (
+	STREAM_STDOUT = "stdout"
+	STREAM_STDERR = "stderr"
+)
+
+// shipper : Collects command output into chunks and ships them to flow
+type shipper struct {
+	sync.Mutex
+
+	// The syphon instance used to reach flow
+	syphon *Syphon
+
+	// The trace context of the executing item
+	ctx context.Context
+
+	// The queue item the output belongs to
+	item *api.QueueItem
+
+	// The sequence number of the next chunk
+	sequence int
+
+	// Chunks waiting to be shipped
+	pending []api.LogChunk
+
+	// The number of bytes held in pending
+	size int
+
+	// Signalled when pending exceeds a single chunk
+	flush chan bool
+
+	// Closed to stop the shipper
+	stop chan bool
+
+	// Closed once the final batch has been shipped
+	done chan bool
+}
+
+// streamWriter : An io.Writer feeding a single stream into a shipper
+type streamWriter struct {
+	shipper *shipper
+	stream  string
+}
+
+// newShipper : Create a log shipper for a queue item
+func (syphon *Syphon) newShipper(ctx context.Context, item *api.QueueItem) *shipper {
+	return &shipper{
+		syphon:  syphon,
+		ctx:     ctx,
+		item:    item,
+		pending: make([]api.LogChunk, 0),
+		flush:   make(chan bool, 1),
+		stop:    make(chan bool),
+		done:    make(chan bool),
+	}
+}
+
+// writer : Get a writer for the given stream
+func (shipper *shipper) writer(stream string) io.Writer {
+	return &streamWriter{
+		shipper: shipper,
+		stream:  stream,
+	}
+}
+
+// Write : Append output to the current chunk of the stream
+func (writer *streamWriter) Write(data []byte) (int, error) {
+	writer.shipper.append(writer.stream, data)
+	return len(data), nil
+}
+
+// append : Add output to the pending chunks
+//
+// Output is appended to the last chunk if it belongs to the same stream
+// and the chunk has space, otherwise a new chunk is started. Writes which
+// do not fit are split across as many chunks as needed.
+func (shipper *shipper) append(stream string, data []byte) {
+	var now int64 = time.Now().UnixNano()
+	shipper.Lock()
+	defer shipper.Unlock()
+
+	for len(data) > 0 {
+		var last int = len(shipper.pending) - 1
+		if last >= 0 && shipper.pending[last].Stream == stream && len(shipper.pending[last].Data) < LOG_CHUNK_SIZE {
+			n := chunkLength(data, LOG_CHUNK_SIZE-len(shipper.pending[last].Data))
+			if n > 0 {
+				shipper.pending[last].Data += string(data[:n])
+				shipper.pending[last].End = now
+				shipper.size += n
+				data = data[n:]
+				continue
+			}
+		}
+
+		n := chunkLength(data, LOG_CHUNK_SIZE)
+		shipper.pending = append(shipper.pending, api.LogChunk{
+			ID:       shipper.item.ID,
+			Attempt:  shipper.item.Attempt,
+			Sequence: shipper.sequence,
+			Stream:   stream,
+			Start:    now,
+			End:      now,
+			Data:     string(data[:n]),
+		})
+		shipper.sequence++
+		shipper.size += n
+		data = data[n:]
+	}
+
+	for shipper.size > LOG_BACKLOG && len(shipper.pending) > 1 {
+		logging.Entry(shipper.ctx).Warn("Dropping log chunk ", shipper.pending[0].Sequence, " for ", shipper.item.ID, " - backlog full")
+		shipper.size -= len(shipper.pending[0].Data)
+		shipper.pending = shipper.pending[1:]
+	}
+
+	if shipper.size > LOG_CHUNK_SIZE {
+		select {
+		case shipper.flush <- true:
+		default:
+		}
+	}
+}
+
+// run : Ship pending output every LOG_FLUSH seconds until stopped
+func (shipper *shipper) run() {
+	defer close(shipper.done)
+	ticker := time.NewTicker(LOG_FLUSH * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-shipper.stop:
+			for retry := 0; retry < LOG_RETRIES && !shipper.ship(true); retry++ {
+				time.Sleep(time.Second)
+			}
+			return
+		case <-shipper.flush:
+		case <-ticker.C:
+		}
+		shipper.ship(false)
+	}
+}
+
+// close : Stop the shipper and wait for the final batch to be sent
+func (shipper *shipper) close() {
+	close(shipper.stop)
+	<-shipper.done
+}
+
+// ship : Send all pending chunks to flow
+//
+// On failure the chunks are returned to the front of the pending list
+// to be retried on the next flush. Returns true if the chunks were sent.
+func (shipper *shipper) ship(final bool) bool {
+	shipper.Lock()
+	var (
+		chunks []api.LogChunk = shipper.pending
+		size   int            = shipper.size
+	)
+	shipper.pending = make([]api.LogChunk, 0)
+	shipper.size = 0
+	shipper.Unlock()
+
+	if shipper.item.ID == "" || (len(chunks) == 0 && !final) {
+		return true
+	}
+
+	_, err := shipper.syphon.flow.Logs(shipper.ctx, map[string]interface{}{
+		"pod":       shipper.syphon.hostname,
+		"container": shipper.syphon.config.AppName,
+		"id":        shipper.item.ID,
+		"final":     final,
+		"chunks":    chunks,
+	})
+	if err == nil {
+		return true
+	}
+	logging.Entry(shipper.ctx).Error("Failed to ship logs for ", shipper.item.ID, " ", err)
+
+	shipper.Lock()
+	shipper.pending = append(chunks, shipper.pending...)
+	shipper.size += size
+	shipper.Unlock()
+	return false
+}
+
+// chunkLength : Get how many bytes of data fit into space
+//
+// The length is moved back to the start of a UTF-8 sequence so multi-byte
+// characters are not split between chunks.
+func chunkLength(data []byte, space int) int {
+	if len(data) <= space {
+		return len(data)
+	}
+	var n int = space
+	for n > 0 && n > space-utf8.UTFMax && !utf8.RuneStart(data[n]) {
+		n--
+	}
+	return n
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package syphon
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+)
+
+// Metrics exported by syphon
+var (
+	taskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "tiyo",
+		Subsystem: "syphon",
+		Name:      "task_duration_seconds",
+		Help:      "Time taken to execute queue items",
+		Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600, 900, 1800, 3600},
+	}, []string{"command", "status"})
+
+	taskExitCodes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tiyo",
+		Subsystem: "syphon",
+		Name:      "task_exit_codes_total",
+		Help:      "Exit codes of executed queue items",
+	}, []string{"command", "exitcode"})
+
+	taskLast = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "tiyo",
+		Subsystem: "syphon",
+		Name:      "last_task_timestamp_seconds",
+		Help:      "When the most recent queue item finished executing",
+	})
+
+	taskBusy = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "tiyo",
+		Subsystem: "syphon",
+		Name:      "busy",
+		Help:      "1 whilst a queue item is executing, otherwise 0",
+	})
+)
+
+// serveMetrics : Serve prometheus metrics if a metrics port is configured
+func (syphon *Syphon) serveMetrics() {
+	if syphon.config.Metrics.Syphon == 0 {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	var address string = fmt.Sprintf(":%d", syphon.config.Metrics.Syphon)
+	log.Info("Serving metrics on ", address)
+	go func() {
+		if err := http.ListenAndServe(address, mux); err != nil {
+			log.Error("Metrics server failed ", err)
+		}
+	}()
+}
+
+// recordTask : Record the outcome of an executed queue item
+func recordTask(command string, status string, exitCode int, duration time.Duration) {
+	taskDuration.WithLabelValues(command, status).Observe(duration.Seconds())
+	taskExitCodes.WithLabelValues(command, strconv.Itoa(exitCode)).Inc()
+	taskLast.SetToCurrentTime()
+}
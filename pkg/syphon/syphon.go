@@ -20,10 +20,8 @@
 package syphon
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"os"
 	"os/signal"
@@ -31,17 +29,25 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/notapipeline/tiyo/pkg/client"
 	"github.com/notapipeline/tiyo/pkg/config"
-	"github.com/notapipeline/tiyo/pkg/pipeline"
+	"github.com/notapipeline/tiyo/pkg/logging"
 	"github.com/notapipeline/tiyo/pkg/server/api"
+	"github.com/notapipeline/tiyo/pkg/tracing"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// HEARTBEAT : The number of seconds between checks for cancellation whilst executing
+const HEARTBEAT = 5
+
 // Syphon is the command executor embedded inside docker containers
 type Syphon struct {
 	config   *config.Config
-	client   *http.Client
-	server   string
+	flow     *client.Flow
 	hostname string
 	self     string
 }
@@ -54,10 +60,7 @@ func NewSyphon() *Syphon {
 	if err != nil {
 		log.Panic(err)
 	}
-	syphon.client = &http.Client{
-		Timeout: config.TIMEOUT,
-	}
-	syphon.server = syphon.config.FlowServer()
+	syphon.flow = client.NewFlow(syphon.config)
 	hostname, err := os.Hostname()
 	if err != nil {
 		log.Fatal("Cannot obtain hostname from system ", err)
@@ -78,78 +81,93 @@ func NewSyphon() *Syphon {
 // If status is 'ready', a command will be returned when
 // one is available. Otherwise, nil is returned
 func (syphon *Syphon) register(status string) *api.QueueItem {
-	content := make(map[string]string)
-	content["pod"] = syphon.hostname
-	content["container"] = syphon.config.AppName
-	content["status"] = status
-	data, _ := json.Marshal(content)
-
-	request, err := http.NewRequest(
-		http.MethodPost,
-		syphon.server+"/api/v1/register",
-		bytes.NewBuffer(data))
-
-	if err != nil {
-		log.Error(err)
-		return nil
-	}
-	request.Header.Set("Content-Type", "application/json; charset=utf-8")
-	request.Header.Set("Connection", "close")
-	request.Close = true
-
-	response, err := syphon.client.Do(request)
-	if err != nil {
+	command := api.QueueItem{}
+	code, err := syphon.flow.Register(context.Background(), syphon.pod(), status, &command)
+	if failure, ok := err.(*client.Error); ok {
+		code = failure.Code
+	} else if err != nil {
 		log.Error(err)
 		return nil
 	}
 
-	log.Info("Received response with status code ", response.StatusCode, " for status ", status)
+	log.Info("Received response with status code ", code, " for status ", status)
 	var message string = ""
-	if response.StatusCode == http.StatusAccepted || response.StatusCode == http.StatusNoContent {
+	if code == http.StatusAccepted || code == http.StatusNoContent {
 		// Flow has accepted our update but has no command to return
 		message = "No command returned. Sleeping for 10 seconds before checking again"
 		if status == "Busy" {
 			// dont log if we're only updating status
 			message = ""
 		}
-	} else if response.StatusCode == 404 {
+	} else if code == http.StatusNotFound {
 		// The queue has not been loaded
 		message = "No queue or no queue active - sleeping for 10 seconds before checking again"
+	} else if err != nil {
+		log.Error(err)
+		return nil
 	}
 
 	if message != "" || status == "Busy" {
-		response.Body.Close()
 		if message != "" {
 			log.Info(message)
 		}
 		return nil
 	}
+	return &command
+}
 
-	var body []byte
-	defer response.Body.Close()
-	body, err = ioutil.ReadAll(response.Body)
-	if err != nil {
-		log.Error(err)
-		return nil
-	}
-
-	command := api.QueueItem{}
-	result := api.Result{
-		Message: &command,
+// pod : Identify this executor to flow
+func (syphon *Syphon) pod() client.Pod {
+	return client.Pod{
+		Pod:       syphon.hostname,
+		Container: syphon.config.AppName,
 	}
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		log.Error(err)
-		return nil
-	}
-	return &command
 }
 
 // requeue : push a failed task back to the queue
 func (syphon *Syphon) requeue(queueItem *api.QueueItem) {}
 
-// log : push logs back to the flow server
-func (syphon *Syphon) log(code int, command *pipeline.Command) {}
+// heartbeat : Check with flow if the executing item has been cancelled
+//
+// Runs until stop is closed, signalling the command to terminate if flow
+// reports the item as cancelled.
+func (syphon *Syphon) heartbeat(ctx context.Context, queueItem *api.QueueItem, stop chan bool) {
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(HEARTBEAT * time.Second):
+		}
+
+		cancelled, err := syphon.flow.Heartbeat(ctx, syphon.pod(), queueItem.ID)
+		if err != nil {
+			logging.Entry(ctx).Error(err)
+			continue
+		}
+		if cancelled {
+			logging.Entry(ctx).Warn("Queue item ", queueItem.ID, " has been cancelled")
+			queueItem.Command.Cancel <- true
+			return
+		}
+	}
+}
+
+// complete : report the final status, exit code and resource usage of the queue item back to flow
+func (syphon *Syphon) complete(ctx context.Context, queueItem *api.QueueItem, status string, exitCode int) {
+	if queueItem.ID == "" {
+		return
+	}
+	if _, err := syphon.flow.Complete(ctx, api.CompleteRequest{
+		Pod:       syphon.hostname,
+		Container: syphon.config.AppName,
+		ID:        queueItem.ID,
+		Status:    status,
+		ExitCode:  exitCode,
+		Usage:     queueItem.Command.Usage,
+	}); err != nil {
+		logging.Entry(ctx).Error("Failed to report status ", status, " for ", queueItem.ID, " ", err)
+	}
+}
 
 // execute : trigger the queued command
 func (syphon *Syphon) execute(queueItem *api.QueueItem) {
@@ -158,24 +176,67 @@ func (syphon *Syphon) execute(queueItem *api.QueueItem) {
 	command.AddEnvVar("PIPELINE_DIR", queueItem.PipelineFolder)
 
 	var baseDir string = filepath.Join(syphon.config.SequenceBaseDir, queueItem.PipelineFolder, queueItem.SubFolder)
-	log.Info("Received filename ", filepath.Join(baseDir, queueItem.Filename), " with command ", command)
-
 	var libraryDir string = filepath.Join(syphon.config.SequenceBaseDir, "library")
 
+	// continue the trace begun when the item was queued
+	ctx, span := tracing.Start(tracing.Extract(context.Background(), queueItem.Trace), "execute "+command.Name,
+		trace.WithAttributes(
+			attribute.String("tiyo.item", queueItem.ID),
+			attribute.String("tiyo.file", filepath.Join(queueItem.SubFolder, queueItem.Filename)),
+			attribute.String("tiyo.pod", syphon.hostname)))
+	defer span.End()
+
+	// calls made for this item share a request ID
+	ctx = logging.With(logging.WithRequestID(ctx, uuid.New().String()), log.Fields{
+		logging.FIELD_PIPELINE: queueItem.PipelineFolder,
+		logging.FIELD_COMMAND:  command.Name,
+		logging.FIELD_ITEM:     queueItem.ID,
+	})
+	logger := logging.Entry(ctx)
+	logger.Info("Received filename ", filepath.Join(baseDir, queueItem.Filename), " with command ", command)
+
+	command.Cancel = make(chan bool, 1)
+	stop := make(chan bool)
+	go syphon.heartbeat(ctx, queueItem, stop)
+
+	shipper := syphon.newShipper(ctx, queueItem)
+	command.OutputWriter = shipper.writer(STREAM_STDOUT)
+	command.ErrorWriter = shipper.writer(STREAM_STDERR)
+	go shipper.run()
+
+	taskBusy.Set(1)
+	start := time.Now()
 	var exitCode int = command.Execute(baseDir, syphon.self, queueItem.Filename, queueItem.Event, libraryDir)
-	if exitCode != 0 {
+	var elapsed time.Duration = time.Since(start)
+	taskBusy.Set(0)
+	close(stop)
+	shipper.close()
+
+	var status string = api.STATE_COMPLETE
+	if command.Cancelled {
+		// cancelled items are neither failed nor requeued
+		logger.Warn("Command ", command.Name, " was cancelled")
+		status = api.STATE_CANCELLED
+	} else if exitCode != 0 {
 		// if exitcode is not 0, add the command back to the queue
 		// requeue should send logs back with the command
 		syphon.requeue(queueItem)
-	} else {
-		// write command logs back to the log bucket
-		syphon.log(exitCode, command)
+		status = api.STATE_FAILED
+		span.SetStatus(codes.Error, fmt.Sprintf("exit code %d", exitCode))
 	}
+	span.SetAttributes(attribute.String("tiyo.status", status), attribute.Int("tiyo.exitcode", exitCode))
+	syphon.complete(ctx, queueItem, status, exitCode)
+	recordTask(command.Name, status, exitCode, elapsed)
 
 	// if no end-time, command timed out.
 	if command.EndTime != 0 {
 		h, m, s := time.Unix(0, command.EndTime-command.StartTime).Clock()
-		log.Info(fmt.Sprintf("Command completed in %d:%d:%d", h, m, s))
+		logger.Info(fmt.Sprintf("Command completed in %d:%d:%d", h, m, s))
+	}
+
+	if usage := command.Usage; usage != nil {
+		logger.Info(fmt.Sprintf("Command used %.2fs user, %.2fs system CPU, peak %.2f cores, peak %d bytes memory",
+			usage.UserCPU, usage.SystemCPU, usage.PeakCPU, usage.PeakRSS))
 	}
 }
 
@@ -193,6 +254,8 @@ func (syphon *Syphon) Init() {}
 // output of the command back to the flow server on completion.
 func (syphon *Syphon) Run() int {
 	log.Info("Starting tiyo syphon - ", syphon.self)
+	defer tracing.Init(syphon.config, config.Designate)()
+	syphon.serveMetrics()
 	sigc := make(chan os.Signal, 1)
 	done := make(chan bool)
 	signal.Notify(sigc, os.Interrupt)
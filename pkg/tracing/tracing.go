@@ -0,0 +1,177 @@
+// Copyright 2021 The Tiyo authors
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package tracing : OpenTelemetry tracing shared by the tiyo components
+//
+// W3C trace context is propagated on every HTTP call made between fill,
+// assemble, flow and syphon, and is carried on queue items so a single
+// file can be followed from the moment fill sees it until syphon has
+// finished executing each command against it.
+//
+// Spans are only recorded and exported when a collector endpoint is
+// configured. Without one, no new traces are started and spans carry the
+// context of their parent unchanged, so only trace context received from
+// an upstream component is forwarded. Nothing is propagated for work which
+// did not arrive with a trace context.
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/notapipeline/tiyo/pkg/config"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TRACER : The name of the tracer used by all tiyo components
+const TRACER = "github.com/notapipeline/tiyo"
+
+// Carrier : Trace context held outside of an HTTP request, for example on a queue item
+type Carrier map[string]string
+
+func init() {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// Init : Start exporting spans for the given component
+//
+// Returns a function which flushes any pending spans and should be
+// called when the component exits.
+func Init(c *config.Config, service string) func() {
+	if c.Tracing.Endpoint == "" {
+		return func() {}
+	}
+
+	options := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(c.Tracing.Endpoint),
+	}
+	if c.Tracing.Insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		log.Error("Failed to create trace exporter ", err)
+		return func() {}
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL, semconv.ServiceNameKey.String("tiyo-"+service))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.Tracing.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	log.Info("Exporting traces to ", c.Tracing.Endpoint)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), config.TIMEOUT)
+		defer cancel()
+		if err := provider.Shutdown(ctx); err != nil {
+			log.Error("Failed to flush traces ", err)
+		}
+	}
+}
+
+// Start : Start a new span as a child of any span held in ctx
+func Start(ctx context.Context, name string, attributes ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(TRACER).Start(ctx, name, attributes...)
+}
+
+// Inject : Get the trace context held in ctx
+//
+// The carrier is empty if ctx holds no span
+func Inject(ctx context.Context) Carrier {
+	carrier := make(Carrier)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(carrier))
+	return carrier
+}
+
+// Extract : Add the trace context held in carrier to ctx
+func Extract(ctx context.Context, carrier Carrier) context.Context {
+	if len(carrier) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
+
+// Middleware : Start a server span for each request, continuing any trace the caller sent
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.URL.Path == "/metrics" {
+			c.Next()
+			return
+		}
+
+		var route string = c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		ctx := otel.GetTextMapPropagator().Extract(
+			c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		ctx, span := Start(ctx, c.Request.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(semconv.HTTPServerAttributesFromHTTPRequest(config.Designate, route, c.Request)...))
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		var status int = c.Writer.Status()
+		span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(status)...)
+		span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(status, trace.SpanKindServer))
+	}
+}
+
+// transport : An http.RoundTripper which records a client span and sends trace context
+type transport struct {
+	base http.RoundTripper
+}
+
+// Transport : Wrap base so requests carry the trace context of their own context
+//
+// If base is nil, http.DefaultTransport is used at the time of each request
+// so changes made to its TLS configuration still apply.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	return &transport{base: base}
+}
+
+// RoundTrip : Send the request inside a client span
+func (transport *transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	var base http.RoundTripper = transport.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	ctx, span := Start(request.Context(), "HTTP "+request.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPClientAttributesFromHTTPRequest(request)...))
+	defer span.End()
+
+	request = request.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(request.Header))
+
+	response, err := base.RoundTrip(request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return response, err
+	}
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(response.StatusCode)...)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(response.StatusCode, trace.SpanKindClient))
+	return response, nil
+}